@@ -330,14 +330,21 @@ var typeMap = map[string]types.TypeID{
 	"xs:double":          types.FloatID,
 	"xs:float":           types.FloatID,
 	"xs:base64Binary":    types.BinaryID,
+	"xs:integer":         types.BigIntID,
+	"xs:decimal":         types.DecimalID,
+	"xs:duration":        types.DurationID,
+	"xs:uuid":            types.UUIDID,
+	"xs:float32vector":   types.VFloatID,
 	"geo:geojson":        types.GeoID,
 	"http://www.w3.org/2001/XMLSchema#string":          types.StringID,
 	"http://www.w3.org/2001/XMLSchema#dateTime":        types.DateTimeID,
 	"http://www.w3.org/2001/XMLSchema#date":            types.DateTimeID,
 	"http://www.w3.org/2001/XMLSchema#int":             types.IntID,
 	"http://www.w3.org/2001/XMLSchema#positiveInteger": types.IntID,
-	"http://www.w3.org/2001/XMLSchema#integer":         types.IntID,
+	"http://www.w3.org/2001/XMLSchema#integer":         types.BigIntID,
 	"http://www.w3.org/2001/XMLSchema#boolean":         types.BoolID,
+	"http://www.w3.org/2001/XMLSchema#decimal":         types.DecimalID,
+	"http://www.w3.org/2001/XMLSchema#duration":        types.DurationID,
 	"http://www.w3.org/2001/XMLSchema#double":          types.FloatID,
 	"http://www.w3.org/2001/XMLSchema#float":           types.FloatID,
 	"http://www.w3.org/2001/XMLSchema#gYear":           types.DateTimeID,
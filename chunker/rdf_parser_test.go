@@ -152,6 +152,24 @@ var testNQuads = []struct {
 			ObjectValue: &api.Value{Val: &api.Value_IntVal{IntVal: 13}},
 		},
 	},
+	{
+		input: `_:alice <balance> "123456789012345678901234567890"^^<xs:integer> .`,
+		nq: api.NQuad{
+			Subject:   "_:alice",
+			Predicate: "balance",
+			ObjectValue: &api.Value{Val: &api.Value_StrVal{
+				StrVal: "123456789012345678901234567890"}},
+		},
+	},
+	{
+		input: `_:alice <balance> "123456789012345678901234567890"^^<http://www.w3.org/2001/XMLSchema#integer> .`,
+		nq: api.NQuad{
+			Subject:   "_:alice",
+			Predicate: "balance",
+			ObjectValue: &api.Value{Val: &api.Value_StrVal{
+				StrVal: "123456789012345678901234567890"}},
+		},
+	},
 	{
 		input: `_:alice <secret> "password1"^^<xs:password> .`,
 		nq: api.NQuad{
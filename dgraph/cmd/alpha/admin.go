@@ -19,6 +19,7 @@ package alpha
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net"
@@ -102,7 +103,19 @@ func exportHandler(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	}
-	if err := worker.ExportOverNetwork(context.Background(), format); err != nil {
+	// Unless a namespace is given, the predicates and types of all the namespaces are exported.
+	var ns uint64
+	allNamespaces := true
+	if nsStr := r.Form.Get("namespace"); nsStr != "" {
+		var err error
+		if ns, err = strconv.ParseUint(nsStr, 0, 64); err != nil {
+			x.SetHttpStatus(w, http.StatusBadRequest, "Invalid namespace.")
+			return
+		}
+		allNamespaces = false
+	}
+	if err := worker.ExportOverNetwork(context.Background(), format, ns,
+		allNamespaces); err != nil {
 		x.SetStatus(w, err.Error(), "Export failed.")
 		return
 	}
@@ -154,6 +167,37 @@ func memoryLimitGetHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// migrationsHandler reports the status of the predicate migrations started through, or run on
+// this server.
+func migrationsHandler(w http.ResponseWriter, r *http.Request) {
+	if !handlerInit(w, r, http.MethodGet) {
+		return
+	}
+
+	data, err := json.Marshal(worker.Migrations())
+	if err != nil {
+		x.SetStatus(w, err.Error(), "Unable to marshal the migrations.")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	x.Check2(w.Write(data))
+}
+
+// indexesHandler reports the status of the index builds run in the background on this server.
+func indexesHandler(w http.ResponseWriter, r *http.Request) {
+	if !handlerInit(w, r, http.MethodGet) {
+		return
+	}
+
+	data, err := json.Marshal(worker.IndexBuilds())
+	if err != nil {
+		x.SetStatus(w, err.Error(), "Unable to marshal the index builds.")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	x.Check2(w.Write(data))
+}
+
 func ipInIPWhitelistRanges(ipString string) bool {
 	ip := net.ParseIP(ipString)
 
@@ -22,6 +22,7 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/dgraph-io/dgraph/ee/backup"
@@ -69,6 +70,16 @@ func processHttpBackupRequest(ctx context.Context, r *http.Request) error {
 	sessionToken := r.FormValue("session_token")
 	anonymous := r.FormValue("anonymous") == "true"
 	forceFull := r.FormValue("force_full") == "true"
+	// Unless a namespace is given, the predicates of all the namespaces are backed up.
+	var ns uint64
+	allNamespaces := true
+	if nsStr := r.FormValue("namespace"); nsStr != "" {
+		var err error
+		if ns, err = strconv.ParseUint(nsStr, 0, 64); err != nil {
+			return errors.Wrapf(err, "invalid namespace %q", nsStr)
+		}
+		allNamespaces = false
+	}
 
 	if err := x.HealthCheck(); err != nil {
 		glog.Errorf("Backup canceled, not ready to accept requests: %s", err)
@@ -122,7 +133,9 @@ func processHttpBackupRequest(ctx context.Context, r *http.Request) error {
 		groups = append(groups, gid)
 		predMap[gid] = make([]string, 0)
 		for pred := range group.Tablets {
-			predMap[gid] = append(predMap[gid], pred)
+			if allNamespaces || x.ParseNamespace(pred) == ns {
+				predMap[gid] = append(predMap[gid], pred)
+			}
 		}
 	}
 
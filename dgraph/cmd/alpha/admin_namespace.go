@@ -0,0 +1,82 @@
+// +build !oss
+
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package alpha
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func init() {
+	http.HandleFunc("/admin/namespace", namespaceHandler)
+}
+
+// namespaceHandler creates a namespace on POST requests, along with its Groot account using the
+// given password, and deletes the namespace given by the id parameter on DELETE requests. Only
+// the Groot of the galaxy namespace can manage namespaces.
+func namespaceHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost, http.MethodDelete:
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !handlerInit(w, r, r.Method) {
+		return
+	}
+	if !worker.EnterpriseEnabled() {
+		x.SetStatus(w, "You must enable enterprise features first. "+
+			"Supply the appropriate license file to Dgraph Zero using the HTTP endpoint.",
+			"Namespace request failed.")
+		return
+	}
+
+	ctx := attachAccessJwt(context.Background(), r)
+	var msg string
+	switch r.Method {
+	case http.MethodPost:
+		ns, err := (&edgraph.Server{}).CreateNamespace(ctx, r.FormValue("password"))
+		if err != nil {
+			x.SetStatus(w, err.Error(), "Creating the namespace failed.")
+			return
+		}
+		msg = fmt.Sprintf(`{"code": "Success", "message": "Created namespace.", `+
+			`"namespace": %d}`, ns)
+	case http.MethodDelete:
+		ns, err := strconv.ParseUint(r.FormValue("id"), 0, 64)
+		if err != nil {
+			x.SetHttpStatus(w, http.StatusBadRequest, "Invalid namespace.")
+			return
+		}
+		if err := (&edgraph.Server{}).DeleteNamespace(ctx, ns); err != nil {
+			x.SetStatus(w, err.Error(), "Deleting the namespace failed.")
+			return
+		}
+		msg = fmt.Sprintf(`{"code": "Success", "message": "Deleted namespace %d."}`, ns)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	x.Check2(w.Write([]byte(msg)))
+}
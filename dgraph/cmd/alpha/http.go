@@ -33,6 +33,8 @@ import (
 	"github.com/dgraph-io/dgo/v2/protos/api"
 	"github.com/dgraph-io/dgraph/edgraph"
 	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql"
+	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/query"
 	"github.com/dgraph-io/dgraph/worker"
 	"github.com/dgraph-io/dgraph/x"
@@ -169,6 +171,11 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
+	isProfile, err := parseBool(r, "profile")
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
 	queryTimeout, err := parseDuration(r, "timeout")
 	if err != nil {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
@@ -208,7 +215,14 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := context.WithValue(context.Background(), query.DebugKey, isDebugMode)
+	ctx = context.WithValue(ctx, query.ExplainKey, r.URL.Query().Get("explain"))
+	var profile *query.Profile
+	if isProfile {
+		profile = &query.Profile{}
+		ctx = context.WithValue(ctx, query.ProfileKey, profile)
+	}
 	ctx = attachAccessJwt(ctx, r)
+	ctx = attachAsOf(ctx, r)
 
 	if queryTimeout != 0 {
 		var cancel context.CancelFunc
@@ -257,6 +271,9 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		Latency: resp.Latency,
 		Metrics: resp.Metrics,
 	}
+	if profile != nil {
+		e.Profile = profile.Nodes
+	}
 	js, err := json.Marshal(e)
 	if err != nil {
 		x.SetStatusWithData(w, x.Error, err.Error())
@@ -284,6 +301,106 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// graphqlHandler serves GraphQL requests using the schema derived from the types
+// defined in Dgraph. Requests are translated into GraphQL+- and processed by
+// edgraph.Server like any other query or mutation.
+func graphqlHandler(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == http.MethodOptions {
+		return
+	}
+
+	var req graphql.Request
+	switch r.Method {
+	case http.MethodGet:
+		req.Query = r.URL.Query().Get("query")
+		req.OperationName = r.URL.Query().Get("operationName")
+		if vars := r.URL.Query().Get("variables"); vars != "" {
+			if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+				x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+				return
+			}
+		}
+	case http.MethodPost:
+		body := readRequest(w, r)
+		if body == nil {
+			return
+		}
+		contentType := r.Header.Get("Content-Type")
+		if idx := strings.Index(contentType, ";"); idx >= 0 {
+			contentType = contentType[:idx]
+		}
+		switch strings.ToLower(strings.TrimSpace(contentType)) {
+		case "application/json":
+			dec := json.NewDecoder(bytes.NewReader(body))
+			dec.UseNumber()
+			if err := dec.Decode(&req); err != nil {
+				jsonErr := convertJSONError(string(body), err)
+				x.SetStatus(w, x.ErrorInvalidRequest, jsonErr.Error())
+				return
+			}
+		case "application/graphql":
+			req.Query = string(body)
+		default:
+			x.SetStatus(w, x.ErrorInvalidRequest, "Unsupported Content-Type. "+
+				"Supported content types are application/json, application/graphql")
+			return
+		}
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	ctx := attachAccessJwt(context.Background(), r)
+	schema, err := graphqlSchema(ctx)
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+
+	js, err := json.Marshal(graphql.Resolve(ctx, schema, &edgraph.Server{}, &req))
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := writeResponse(w, r, js); err != nil {
+		glog.Errorln("Unable to write response: ", err)
+	}
+}
+
+// graphqlSchemaHandler returns the GraphQL schema in the schema definition language.
+func graphqlSchemaHandler(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	schema, err := graphqlSchema(attachAccessJwt(context.Background(), r))
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	if _, err := writeResponse(w, r, []byte(schema.String())); err != nil {
+		glog.Errorln("Unable to write response: ", err)
+	}
+}
+
+// graphqlSchema derives the GraphQL schema from the types and predicates currently
+// defined in the namespace of the user making the request.
+func graphqlSchema(ctx context.Context) (*graphql.Schema, error) {
+	preds, types, err := query.GetNamespaceSchema(edgraph.AttachNamespace(ctx),
+		&pb.SchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return graphql.NewSchema(types, preds), nil
+}
+
 func mutationHandler(w http.ResponseWriter, r *http.Request) {
 	if commonHandler(w, r) {
 		return
@@ -507,14 +624,16 @@ func handleCommit(startTs uint64, reqText []byte) (map[string]interface{}, error
 		tc.Preds = reqMap["preds"]
 	}
 
-	cts, err := worker.CommitOverNetwork(context.Background(), tc)
+	// The commit goes through the server, which checks the type constraints of the nodes
+	// modified by the transaction first.
+	tctx, err := (&edgraph.Server{}).CommitOrAbort(context.Background(), tc)
 	if err != nil {
 		return nil, err
 	}
 
 	resp := &api.Response{}
 	resp.Txn = tc
-	resp.Txn.CommitTs = cts
+	resp.Txn.CommitTs = tctx.CommitTs
 	e := query.Extensions{
 		Txn: resp.Txn,
 	}
@@ -542,6 +661,26 @@ func attachAccessJwt(ctx context.Context, r *http.Request) context.Context {
 	return ctx
 }
 
+// attachAsOf passes the as_of and as_of_time parameters of the request on as metadata, the way
+// gRPC clients send them, to run the query as of an earlier timestamp or time.
+func attachAsOf(ctx context.Context, r *http.Request) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	}
+	found := false
+	for _, key := range []string{"as_of", "as_of_time"} {
+		if val := r.URL.Query().Get(key); val != "" {
+			md.Append(key, val)
+			found = true
+		}
+	}
+	if !found {
+		return ctx
+	}
+	return metadata.NewIncomingContext(ctx, md)
+}
+
 func alterHandler(w http.ResponseWriter, r *http.Request) {
 	if commonHandler(w, r) {
 		return
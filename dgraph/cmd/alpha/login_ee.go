@@ -29,6 +29,7 @@ import (
 	"github.com/dgraph-io/dgraph/edgraph"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/golang/glog"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 )
 
@@ -51,13 +52,21 @@ func loginHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	body := readRequest(w, r)
-	loginReq := api.LoginRequest{}
+	// Users log into the namespace given in the request, gRPC clients give it as metadata.
+	loginReq := struct {
+		api.LoginRequest
+		Namespace uint64 `json:"namespace"`
+	}{}
 	if err := json.Unmarshal(body, &loginReq); err != nil {
 		x.SetStatusWithData(w, x.Error, err.Error())
 		return
 	}
+	if loginReq.Namespace != x.GalaxyNamespace {
+		ctx = metadata.NewIncomingContext(ctx,
+			metadata.Pairs("namespace", strconv.FormatUint(loginReq.Namespace, 10)))
+	}
 
-	resp, err := (&edgraph.Server{}).Login(ctx, &loginReq)
+	resp, err := (&edgraph.Server{}).Login(ctx, &loginReq.LoginRequest)
 	if err != nil {
 		x.SetStatusWithData(w, x.ErrorInvalidRequest, err.Error())
 		return
@@ -37,6 +37,7 @@ import (
 	"github.com/dgraph-io/dgo/v2/protos/api"
 	"github.com/dgraph-io/dgraph/edgraph"
 	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/worker"
@@ -126,6 +127,14 @@ they form a Raft group and provide synchronous replication.
 		"A comma separated list of IP ranges you wish to whitelist for performing admin "+
 			"actions (i.e., --whitelist 127.0.0.1:127.0.0.3,0.0.0.7:0.0.0.9)")
 	flag.String("export", "export", "Folder in which to store exports.")
+	flag.Bool("cdc", false, "Record the changes made by committed transactions, so they "+
+		"can be streamed to change data capture clients like dgraph cdc.")
+	flag.Duration("cdc_retention", 7*24*time.Hour, "How long to keep the changes recorded for "+
+		"change data capture. Clients streaming from an older timestamp miss the changes "+
+		"dropped since. 0 keeps them forever.")
+	flag.Duration("history_retention", 0, "How long to keep the older versions of the data, "+
+		"so they can be read by queries run as_of an earlier time. 0 only keeps the versions "+
+		"needed by pending transactions.")
 	flag.Int("pending_proposals", 256,
 		"Number of pending mutation proposals. Useful for rate limiting.")
 	flag.String("my", "",
@@ -326,6 +335,7 @@ func serveGRPC(l net.Listener, tlsCfg *tls.Config, wg *sync.WaitGroup) {
 
 	s := grpc.NewServer(opt...)
 	api.RegisterDgraphServer(s, &edgraph.Server{})
+	pb.RegisterCDCServer(s, &edgraph.Server{})
 	hapi.RegisterHealthServer(s, health.NewServer())
 	err := s.Serve(l)
 	glog.Errorf("GRPC listener canceled: %v\n", err)
@@ -384,6 +394,8 @@ func setupServer() {
 	http.HandleFunc("/mutate/", mutationHandler)
 	http.HandleFunc("/commit", commitHandler)
 	http.HandleFunc("/alter", alterHandler)
+	http.HandleFunc("/graphql", graphqlHandler)
+	http.HandleFunc("/graphql/schema", graphqlSchemaHandler)
 	http.HandleFunc("/health", healthCheck)
 
 	// TODO: Figure out what this is for?
@@ -393,6 +405,8 @@ func setupServer() {
 	http.HandleFunc("/admin/draining", drainingHandler)
 	http.HandleFunc("/admin/export", exportHandler)
 	http.HandleFunc("/admin/config/lru_mb", memoryLimitHandler)
+	http.HandleFunc("/admin/migrations", migrationsHandler)
+	http.HandleFunc("/admin/indexes", indexesHandler)
 
 	// Add OpenCensus z-pages.
 	zpages.Handle(http.DefaultServeMux, "/z")
@@ -487,6 +501,9 @@ func run() {
 		AclEnabled:          secretFile != "",
 		SnapshotAfter:       Alpha.Conf.GetInt("snapshot_after"),
 		AbortOlderThan:      abortDur,
+		CDC:                 Alpha.Conf.GetBool("cdc"),
+		CDCRetention:        Alpha.Conf.GetDuration("cdc_retention"),
+		HistoryRetention:    Alpha.Conf.GetDuration("history_retention"),
 	}
 
 	setupCustomTokenizers()
@@ -0,0 +1,172 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package cdc builds a tool that subscribes to the changes committed in a Dgraph cluster and
+// writes them out as JSON lines, one per changed N-Quad. Alphas must be run with --cdc.
+package cdc
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/metadata"
+)
+
+// CDC is the sub-command invoked when calling "dgraph cdc".
+var CDC x.SubCommand
+
+func init() {
+	CDC.Cmd = &cobra.Command{
+		Use:   "cdc",
+		Short: "Stream the changes committed in Dgraph as JSON lines",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := run(CDC.Conf); err != nil {
+				glog.Fatalf("%+v", err)
+			}
+		},
+	}
+	CDC.EnvPrefix = "DGRAPH_CDC"
+
+	flag := CDC.Cmd.Flags()
+	flag.String("alpha", "localhost:9080", "Address of Dgraph Alpha.")
+	flag.Uint64("since_ts", 0,
+		"Only stream the transactions committed after this timestamp.")
+	flag.StringP("out", "o", "", "File to append the changes to. Defaults to stdout.")
+	flag.Int("retries", 10,
+		"How many times in a row to retry connecting to Dgraph Alpha, before giving up.")
+	flag.String("user", "", "Username if login is required.")
+	flag.String("password", "", "Password of the user.")
+	// TLS configuration
+	x.RegisterClientTLSFlags(flag)
+}
+
+// change is the JSON line written for every changed N-Quad.
+type change struct {
+	CommitTs  uint64 `json:"commit_ts"`
+	Op        string `json:"op"`
+	NQuad     string `json:"nquad"`
+	Namespace uint64 `json:"namespace"`
+}
+
+func run(conf *viper.Viper) error {
+	var w io.Writer = os.Stdout
+	if out := conf.GetString("out"); out != "" {
+		f, err := os.OpenFile(out, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return errors.Wrapf(err, "while opening output file")
+		}
+		defer f.Close()
+		w = f
+	}
+	bw := bufio.NewWriter(w)
+	defer bw.Flush()
+
+	sinceTs := cast.ToUint64(conf.GetString("since_ts"))
+	retries := conf.GetInt("retries")
+	for failures := 0; ; {
+		lastTs, err := subscribe(conf, sinceTs, bw)
+		if lastTs > sinceTs {
+			// Some transactions went through, so resume after them.
+			sinceTs = lastTs
+			failures = 0
+		}
+		failures++
+		if failures > retries {
+			return errors.Wrapf(err, "giving up after %d retries", retries)
+		}
+		fmt.Fprintf(os.Stderr, "Stream broke after ts %d: %v. Retrying...\n", sinceTs, err)
+		time.Sleep(time.Second)
+	}
+}
+
+// subscribe streams the changes committed after sinceTs to w, until an error happens. It
+// returns the commit timestamp of the last transaction fully written.
+func subscribe(conf *viper.Viper, sinceTs uint64, w *bufio.Writer) (uint64, error) {
+	tlsCfg, err := x.LoadClientTLSConfig(conf)
+	if err != nil {
+		return sinceTs, errors.Wrapf(err, "while loading TLS configuration")
+	}
+	conn, err := x.SetupConnection(conf.GetString("alpha"), tlsCfg, false)
+	if err != nil {
+		return sinceTs, errors.Wrapf(err, "while connecting to Dgraph Alpha")
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if user := conf.GetString("user"); user != "" {
+		if ctx, err = login(ctx, api.NewDgraphClient(conn), user,
+			conf.GetString("password")); err != nil {
+			return sinceTs, err
+		}
+	}
+
+	stream, err := pb.NewCDCClient(conn).Subscribe(ctx, &pb.CDCRequest{SinceTs: sinceTs})
+	if err != nil {
+		return sinceTs, err
+	}
+	enc := json.NewEncoder(w)
+	for {
+		txn, err := stream.Recv()
+		if err != nil {
+			return sinceTs, err
+		}
+		for _, event := range txn.Events {
+			c := change{
+				CommitTs:  txn.CommitTs,
+				Op:        strings.ToLower(event.Op.String()),
+				NQuad:     event.Nquad,
+				Namespace: event.Namespace,
+			}
+			if err := enc.Encode(c); err != nil {
+				return sinceTs, err
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return sinceTs, err
+		}
+		sinceTs = txn.CommitTs
+	}
+}
+
+// login logs the user in and returns a context carrying the access JWT.
+func login(ctx context.Context, dc api.DgraphClient, user, password string) (
+	context.Context, error) {
+
+	resp, err := dc.Login(ctx, &api.LoginRequest{Userid: user, Password: password})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while logging in")
+	}
+	var jwt api.Jwt
+	if err := jwt.Unmarshal(resp.Json); err != nil {
+		return nil, errors.Wrapf(err, "while reading the access JWT")
+	}
+	return metadata.AppendToOutgoingContext(ctx, "accessJwt", jwt.AccessJwt), nil
+}
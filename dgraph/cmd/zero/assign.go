@@ -17,6 +17,8 @@
 package zero
 
 import (
+	"time"
+
 	otrace "go.opencensus.io/trace"
 	"golang.org/x/net/context"
 
@@ -80,6 +82,12 @@ func (s *Server) lease(ctx context.Context, num *pb.Num, txn bool) (*pb.Assigned
 	s.leaseLock.Lock()
 	defer s.leaseLock.Unlock()
 
+	if !txn {
+		if err := s.leaseForNamespace(num.Namespace, num.Val, time.Now()); err != nil {
+			return &emptyAssignedIds, err
+		}
+	}
+
 	if txn {
 		if num.Val == 0 && num.ReadOnly {
 			// If we're only asking for a readonly timestamp, we can potentially
@@ -153,6 +161,24 @@ func (s *Server) lease(ctx context.Context, num *pb.Num, txn bool) (*pb.Assigned
 	return out, nil
 }
 
+// leaseForNamespace counts the uids leased for the namespace ns, failing if it would get more
+// than --uid_lease_limit uids within a minute. It must be called with leaseLock held.
+func (s *Server) leaseForNamespace(ns, val uint64, now time.Time) error {
+	if ns == x.GalaxyNamespace || opts.uidLeaseLimit == 0 {
+		return nil
+	}
+	if s.nsLeased == nil || now.Sub(s.nsLeaseStart) >= time.Minute {
+		s.nsLeased = make(map[uint64]uint64)
+		s.nsLeaseStart = now
+	}
+	if s.nsLeased[ns]+val > opts.uidLeaseLimit {
+		return errors.Errorf("Namespace %d can't lease more than %d uids per minute",
+			ns, opts.uidLeaseLimit)
+	}
+	s.nsLeased[ns] += val
+	return nil
+}
+
 // AssignUids is used to assign new uids by communicating with the leader of the RAFT group
 // responsible for handing out uids.
 func (s *Server) AssignUids(ctx context.Context, num *pb.Num) (*pb.AssignedIds, error) {
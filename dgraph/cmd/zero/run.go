@@ -52,6 +52,7 @@ type options struct {
 	peer              string
 	w                 string
 	rebalanceInterval time.Duration
+	uidLeaseLimit     uint64
 }
 
 var opts options
@@ -86,6 +87,8 @@ instances to achieve high-availability.
 	flag.String("peer", "", "Address of another dgraphzero server.")
 	flag.StringP("wal", "w", "zw", "Directory storing WAL.")
 	flag.Duration("rebalance_interval", 8*time.Minute, "Interval for trying a predicate move.")
+	flag.Uint64("uid_lease_limit", 0, "The maximum number of uids which can be leased for a "+
+		"namespace, other than the galaxy namespace, every minute. Zero means no limit.")
 	flag.Bool("telemetry", true, "Send anonymous telemetry data to Dgraph devs.")
 
 	// OpenCensus flags.
@@ -168,6 +171,7 @@ func run() {
 		peer:              Zero.Conf.GetString("peer"),
 		w:                 Zero.Conf.GetString("wal"),
 		rebalanceInterval: Zero.Conf.GetDuration("rebalance_interval"),
+		uidLeaseLimit:     uint64(Zero.Conf.GetInt64("uid_lease_limit")),
 	}
 
 	if opts.numReplicas < 0 || opts.numReplicas%2 == 0 {
@@ -61,6 +61,10 @@ type Server struct {
 	readOnlyTs  uint64
 	leaseLock   sync.Mutex // protects nextLeaseId, nextTxnTs and corresponding proposals.
 
+	// The number of uids leased for every namespace since nsLeaseStart. Protected by leaseLock.
+	nsLeased     map[uint64]uint64
+	nsLeaseStart time.Time
+
 	// groupMap    map[uint32]*Group
 	nextGroup      uint32
 	leaderChangeCh chan struct{}
@@ -371,9 +375,13 @@ func (s *Server) createProposals(dst *pb.Group) ([]*pb.ZeroProposal, error) {
 			continue
 		}
 
-		s := float64(srcTablet.Space)
-		d := float64(dstTablet.Space)
-		if dstTablet.Remove || (s == 0 && d > 0) || (s > 0 && math.Abs(d/s-1) > 0.1) {
+		if dstTablet.NumUids == 0 && len(dstTablet.IndexStats) == 0 {
+			// The update only carries the size of the tablet, keep its statistics.
+			dstTablet.NumUids = srcTablet.NumUids
+			dstTablet.IndexStats = srcTablet.IndexStats
+		}
+		if dstTablet.Remove || sizeChanged(srcTablet.Space, dstTablet.Space) ||
+			statsChanged(srcTablet, dstTablet) {
 			dstTablet.Force = false
 			proposal := &pb.ZeroProposal{
 				Tablet: dstTablet,
@@ -384,6 +392,29 @@ func (s *Server) createProposals(dst *pb.Group) ([]*pb.ZeroProposal, error) {
 	return res, nil
 }
 
+// sizeChanged returns true if the size of a tablet changed by more than 10%.
+func sizeChanged(src, dst int64) bool {
+	s := float64(src)
+	d := float64(dst)
+	return (s == 0 && d > 0) || (s > 0 && math.Abs(d/s-1) > 0.1)
+}
+
+// statsChanged returns true if the statistics of a tablet changed enough to be worth a proposal.
+func statsChanged(src, dst *pb.Tablet) bool {
+	if len(src.IndexStats) != len(dst.IndexStats) ||
+		sizeChanged(int64(src.NumUids), int64(dst.NumUids)) {
+		return true
+	}
+	for i, stats := range dst.IndexStats {
+		if stats.Tokenizer != src.IndexStats[i].Tokenizer ||
+			sizeChanged(int64(src.IndexStats[i].NumKeys), int64(stats.NumKeys)) ||
+			sizeChanged(int64(src.IndexStats[i].NumUids), int64(stats.NumUids)) {
+			return true
+		}
+	}
+	return false
+}
+
 // removeNode removes the given node from the given group.
 // It's the user's responsibility to ensure that node doesn't come back again
 // before calling the api.
@@ -19,6 +19,7 @@ package zero
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/stretchr/testify/require"
@@ -35,3 +36,18 @@ func TestRemoveNode(t *testing.T) {
 	err = server.removeNode(context.TODO(), 1, 2)
 	require.Error(t, err)
 }
+
+func TestLeaseForNamespace(t *testing.T) {
+	defer func(limit uint64) { opts.uidLeaseLimit = limit }(opts.uidLeaseLimit)
+	opts.uidLeaseLimit = 100
+
+	server := &Server{}
+	now := time.Now()
+	require.NoError(t, server.leaseForNamespace(1, 60, now))
+	require.Error(t, server.leaseForNamespace(1, 60, now.Add(time.Second)))
+	// Every namespace has its own limit, and the galaxy namespace has none.
+	require.NoError(t, server.leaseForNamespace(2, 60, now))
+	require.NoError(t, server.leaseForNamespace(0, 1000, now))
+	// The limit applies to every minute.
+	require.NoError(t, server.leaseForNamespace(1, 60, now.Add(time.Minute)))
+}
@@ -45,6 +45,10 @@ func ResetAcl() {
 	// do nothing
 }
 
+func resetNamespaceAcl(ns uint64) {
+	// do nothing
+}
+
 // ResetAcls is an empty method since ACL is only supported in the enterprise version.
 func RefreshAcls(closer *y.Closer) {
 	// do nothing
@@ -64,3 +68,25 @@ func authorizeQuery(ctx context.Context, parsedReq *gql.Result) error {
 	// always allow access
 	return nil
 }
+
+func authorizeCDC(ctx context.Context) error {
+	return nil
+}
+
+// AttachNamespace returns the context as is, since all the requests run in the galaxy namespace
+// without the acl feature.
+func AttachNamespace(ctx context.Context) context.Context {
+	return ctx
+}
+
+// CreateNamespace rejects all requests since namespaces are only supported in the enterprise
+// version.
+func (s *Server) CreateNamespace(ctx context.Context, password string) (uint64, error) {
+	return 0, x.ErrNotSupported
+}
+
+// DeleteNamespace rejects all requests since namespaces are only supported in the enterprise
+// version.
+func (s *Server) DeleteNamespace(ctx context.Context, ns uint64) error {
+	return x.ErrNotSupported
+}
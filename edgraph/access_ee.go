@@ -16,6 +16,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -26,6 +27,8 @@ import (
 	"github.com/dgraph-io/dgo/v2/protos/api"
 	"github.com/dgraph-io/dgraph/ee/acl"
 	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/query"
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/worker"
 	"github.com/dgraph-io/dgraph/x"
@@ -64,7 +67,7 @@ func (s *Server) Login(ctx context.Context,
 		}, "client ip for login")
 	}
 
-	user, err := s.authenticateLogin(ctx, request)
+	user, ns, err := s.authenticateLogin(ctx, request)
 	if err != nil {
 		errMsg := fmt.Sprintf("Authentication from address %s failed: %v", addr, err)
 		glog.Errorf(errMsg)
@@ -72,14 +75,14 @@ func (s *Server) Login(ctx context.Context,
 	}
 
 	resp := &api.Response{}
-	accessJwt, err := getAccessJwt(user.UserID, user.Groups)
+	accessJwt, err := getAccessJwt(user.UserID, user.Groups, ns)
 	if err != nil {
 		errMsg := fmt.Sprintf("unable to get access jwt (userid=%s,addr=%s):%v",
 			user.UserID, addr, err)
 		glog.Errorf(errMsg)
 		return nil, errors.Errorf(errMsg)
 	}
-	refreshJwt, err := getRefreshJwt(user.UserID)
+	refreshJwt, err := getRefreshJwt(user.UserID, ns)
 	if err != nil {
 		errMsg := fmt.Sprintf("unable to get refresh jwt (userid=%s,addr=%s):%v",
 			user.UserID, addr, err)
@@ -105,58 +108,88 @@ func (s *Server) Login(ctx context.Context,
 
 // authenticateLogin authenticates the login request using either the refresh token if present, or
 // the <userId, password> pair. If authentication passes, it queries the user's uid and associated
-// groups from DB and returns the user object
+// groups from DB and returns the user object along with the namespace the user belongs to.
+// Users logging in with a password pick their namespace with the namespace metadata of the
+// request, the galaxy namespace being the default.
 func (s *Server) authenticateLogin(ctx context.Context, request *api.LoginRequest) (*acl.User,
-	error) {
+	uint64, error) {
 	if err := validateLoginRequest(request); err != nil {
-		return nil, errors.Wrapf(err, "invalid login request")
+		return nil, 0, errors.Wrapf(err, "invalid login request")
 	}
 
 	var user *acl.User
 	if len(request.RefreshToken) > 0 {
 		userData, err := validateToken(request.RefreshToken)
 		if err != nil {
-			return nil, errors.Wrapf(err, "unable to authenticate the refresh token %v",
+			return nil, 0, errors.Wrapf(err, "unable to authenticate the refresh token %v",
 				request.RefreshToken)
 		}
 
-		userId := userData[0]
+		userId := userData.userId
+		ctx = x.AttachNamespace(ctx, userData.namespace)
 		user, err = authorizeUser(ctx, userId, "")
 		if err != nil {
-			return nil, errors.Wrapf(err, "while querying user with id %v", userId)
+			return nil, 0, errors.Wrapf(err, "while querying user with id %v", userId)
 		}
 
 		if user == nil {
-			return nil, errors.Errorf("unable to authenticate through refresh token: "+
+			return nil, 0, errors.Errorf("unable to authenticate through refresh token: "+
 				"user not found for id %v", userId)
 		}
 
 		glog.Infof("Authenticated user %s through refresh token", userId)
-		return user, nil
+		return user, userData.namespace, nil
+	}
+
+	ns, err := loginNamespace(ctx)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	// authorize the user using password
-	var err error
-	user, err = authorizeUser(ctx, request.Userid, request.Password)
+	user, err = authorizeUser(x.AttachNamespace(ctx, ns), request.Userid, request.Password)
 	if err != nil {
-		return nil, errors.Wrapf(err, "while querying user with id %v",
+		return nil, 0, errors.Wrapf(err, "while querying user with id %v",
 			request.Userid)
 	}
 
 	if user == nil {
-		return nil, errors.Errorf("unable to authenticate through password: "+
+		return nil, 0, errors.Errorf("unable to authenticate through password: "+
 			"user not found for id %v", request.Userid)
 	}
 	if !user.PasswordMatch {
-		return nil, errors.Errorf("password mismatch for user: %v", request.Userid)
+		return nil, 0, errors.Errorf("password mismatch for user: %v", request.Userid)
 	}
-	return user, nil
+	return user, ns, nil
+}
+
+// loginNamespace returns the namespace given by the namespace metadata of the login request.
+func loginNamespace(ctx context.Context) (uint64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return x.GalaxyNamespace, nil
+	}
+	vals := md.Get("namespace")
+	if len(vals) == 0 || vals[0] == "" {
+		return x.GalaxyNamespace, nil
+	}
+	ns, err := strconv.ParseUint(vals[0], 0, 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid namespace %q", vals[0])
+	}
+	return ns, nil
+}
+
+// userData holds the information encoded in the jwt of a logged in user.
+type userData struct {
+	namespace uint64
+	userId    string
+	groupIds  []string
 }
 
 // validateToken verifies the signature and expiration of the jwt, and if validation passes,
-// returns a slice of strings, where the first element is the extracted userId
-// and the rest are groupIds encoded in the jwt.
-func validateToken(jwtStr string) ([]string, error) {
+// returns the namespace, userId and groupIds encoded in the jwt.
+func validateToken(jwtStr string) (*userData, error) {
 	token, err := jwt.Parse(jwtStr, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, errors.Errorf("unexpected signing method: %v", token.Header["alg"])
@@ -199,7 +232,16 @@ func validateToken(jwtStr string) ([]string, error) {
 			groupIds = append(groupIds, groupId)
 		}
 	}
-	return append([]string{userId}, groupIds...), nil
+	// The tokens issued before namespaces existed belong to the galaxy namespace.
+	ns := x.GalaxyNamespace
+	if val, ok := claims["namespace"]; ok {
+		nsVal, ok := val.(float64)
+		if !ok {
+			return nil, errors.Errorf("namespace in claims is not a number:%v", val)
+		}
+		ns = uint64(nsVal)
+	}
+	return &userData{namespace: ns, userId: userId, groupIds: groupIds}, nil
 }
 
 // validateLoginRequest validates that the login request has either the refresh token or the
@@ -223,12 +265,13 @@ func validateLoginRequest(request *api.LoginRequest) error {
 	return nil
 }
 
-// getAccessJwt constructs an access jwt with the given user id, groupIds,
+// getAccessJwt constructs an access jwt with the given user id, groupIds, namespace
 // and expiration TTL specified by Config.AccessJwtTtl
-func getAccessJwt(userId string, groups []acl.Group) (string, error) {
+func getAccessJwt(userId string, groups []acl.Group, ns uint64) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"userid": userId,
-		"groups": acl.GetGroupIDs(groups),
+		"userid":    userId,
+		"groups":    acl.GetGroupIDs(groups),
+		"namespace": ns,
 		// set the jwt exp according to the ttl
 		"exp": time.Now().Add(Config.AccessJwtTtl).Unix(),
 	})
@@ -240,12 +283,13 @@ func getAccessJwt(userId string, groups []acl.Group) (string, error) {
 	return jwtString, nil
 }
 
-// getRefreshJwt constructs a refresh jwt with the given user id, and expiration ttl specified by
-// Config.RefreshJwtTtl
-func getRefreshJwt(userId string) (string, error) {
+// getRefreshJwt constructs a refresh jwt with the given user id, namespace, and expiration ttl
+// specified by Config.RefreshJwtTtl
+func getRefreshJwt(userId string, ns uint64) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"userid": userId,
-		"exp":    time.Now().Add(Config.RefreshJwtTtl).Unix(),
+		"userid":    userId,
+		"namespace": ns,
+		"exp":       time.Now().Add(Config.RefreshJwtTtl).Unix(),
 	})
 
 	jwtString, err := token.SignedString(Config.HmacSecret)
@@ -305,16 +349,16 @@ func RefreshAcls(closer *y.Closer) {
 	ticker := time.NewTicker(Config.AclRefreshInterval)
 	defer ticker.Stop()
 
-	// retrieve the full data set of ACLs from the corresponding alpha server, and update the
-	// aclCachePtr
-	retrieveAcls := func() error {
-		glog.V(3).Infof("Refreshing ACLs")
+	// retrieve the full data set of ACLs of the namespace from the corresponding alpha server,
+	// and update the aclCachePtr
+	retrieveAcls := func(ns uint64) error {
+		glog.V(3).Infof("Refreshing ACLs of namespace %d", ns)
 		queryRequest := api.Request{
 			Query:    queryAcls,
 			ReadOnly: true,
 		}
 
-		ctx := context.Background()
+		ctx := x.AttachNamespace(context.Background(), ns)
 		var err error
 		queryResp, err := (&Server{}).doQuery(ctx, &queryRequest, NoAuthorize)
 		if err != nil {
@@ -325,8 +369,8 @@ func RefreshAcls(closer *y.Closer) {
 			return err
 		}
 
-		aclCachePtr.update(groups)
-		glog.V(3).Infof("Updated the ACL cache")
+		aclCachePtr.update(ns, groups)
+		glog.V(3).Infof("Updated the ACL cache of namespace %d", ns)
 		return nil
 	}
 
@@ -335,8 +379,10 @@ func RefreshAcls(closer *y.Closer) {
 		case <-closer.HasBeenClosed():
 			return
 		case <-ticker.C:
-			if err := retrieveAcls(); err != nil {
-				glog.Errorf("Error while retrieving acls:%v", err)
+			for _, ns := range worker.Namespaces() {
+				if err := retrieveAcls(ns); err != nil {
+					glog.Errorf("Error while retrieving acls of namespace %d:%v", ns, err)
+				}
 			}
 		}
 	}
@@ -353,60 +399,20 @@ const queryAcls = `
 
 // ResetAcl clears the aclCachePtr and upserts the Groot account.
 func ResetAcl() {
+	resetNamespaceAcl(x.GalaxyNamespace)
+}
+
+// resetNamespaceAcl upserts the Groot account of the namespace ns.
+func resetNamespaceAcl(ns uint64) {
 	if len(Config.HmacSecret) == 0 {
 		// The acl feature is not turned on.
 		return
 	}
 
-	upsertGroot := func(ctx context.Context) error {
-		queryVars := map[string]string{
-			"$userid":   x.GrootId,
-			"$password": "",
-		}
-		queryRequest := api.Request{
-			Query: queryUser,
-			Vars:  queryVars,
-		}
-
-		queryResp, err := (&Server{}).doQuery(ctx, &queryRequest, NoAuthorize)
-		if err != nil {
-			return errors.Wrapf(err, "while querying user with id %s", x.GrootId)
-		}
-		startTs := queryResp.GetTxn().StartTs
-
-		rootUser, err := acl.UnmarshalUser(queryResp, "user")
-		if err != nil {
-			return errors.Wrapf(err, "while unmarshaling the root user")
-		}
-		if rootUser != nil {
-			glog.Infof("The groot account already exists, no need to insert again")
-			return nil
-		}
-
-		// Insert Groot.
-		createUserNQuads := acl.CreateUserNQuads(x.GrootId, "password")
-		req := &api.Request{
-			StartTs:   startTs,
-			CommitNow: true,
-			Mutations: []*api.Mutation{
-				{
-					Set: createUserNQuads,
-				},
-			},
-		}
-
-		_, err = (&Server{}).doQuery(context.Background(), req, NoAuthorize)
-		if err != nil {
-			return err
-		}
-		glog.Infof("Successfully upserted the groot account")
-		return nil
-	}
-
 	for {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 		defer cancel()
-		if err := upsertGroot(ctx); err != nil {
+		if err := upsertGroot(x.AttachNamespace(ctx, ns), "password"); err != nil {
 			glog.Infof("Unable to upsert the groot account. Error: %v", err)
 			time.Sleep(100 * time.Millisecond)
 		} else {
@@ -415,10 +421,58 @@ func ResetAcl() {
 	}
 }
 
+// upsertGroot creates the Groot account of the namespace attached to the context, with the
+// given password, unless it already exists.
+func upsertGroot(ctx context.Context, password string) error {
+	queryVars := map[string]string{
+		"$userid":   x.GrootId,
+		"$password": "",
+	}
+	queryRequest := api.Request{
+		Query: queryUser,
+		Vars:  queryVars,
+	}
+
+	queryResp, err := (&Server{}).doQuery(ctx, &queryRequest, NoAuthorize)
+	if err != nil {
+		return errors.Wrapf(err, "while querying user with id %s", x.GrootId)
+	}
+	startTs := queryResp.GetTxn().StartTs
+
+	rootUser, err := acl.UnmarshalUser(queryResp, "user")
+	if err != nil {
+		return errors.Wrapf(err, "while unmarshaling the root user")
+	}
+	if rootUser != nil {
+		glog.Infof("The groot account already exists, no need to insert again")
+		return nil
+	}
+
+	// Insert Groot.
+	createUserNQuads := acl.CreateUserNQuads(x.GrootId, password)
+	req := &api.Request{
+		StartTs:   startTs,
+		CommitNow: true,
+		Mutations: []*api.Mutation{
+			{
+				Set: createUserNQuads,
+			},
+		},
+	}
+
+	ns := x.ExtractNamespace(ctx)
+	_, err = (&Server{}).doQuery(x.AttachNamespace(context.Background(), ns), req, NoAuthorize)
+	if err != nil {
+		return err
+	}
+	glog.Infof("Successfully upserted the groot account of namespace %d", ns)
+	return nil
+}
+
 var errNoJwt = errors.New("no accessJwt available")
 
-// extract the userId, groupIds from the accessJwt in the context
-func extractUserAndGroups(ctx context.Context) ([]string, error) {
+// extract the namespace, userId, groupIds from the accessJwt in the context
+func extractUserAndGroups(ctx context.Context) (*userData, error) {
 	// extract the jwt and unmarshal the jwt to get the list of groups
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -432,9 +486,11 @@ func extractUserAndGroups(ctx context.Context) ([]string, error) {
 	return validateToken(accessJwt[0])
 }
 
-func authorizePreds(userId string, groupIds, preds []string, aclOp *acl.Operation) error {
+func authorizePreds(ns uint64, userId string, groupIds, preds []string,
+	aclOp *acl.Operation) error {
 	for _, pred := range preds {
-		if err := aclCachePtr.authorizePredicate(groupIds, pred, aclOp); err != nil {
+		err := aclCachePtr.authorizePredicate(groupIds, x.NamespaceAttr(ns, pred), aclOp)
+		if err != nil {
 			logAccess(&accessEntry{
 				userId:    userId,
 				groups:    groupIds,
@@ -483,8 +539,15 @@ func authorizeAlter(ctx context.Context, op *api.Operation) error {
 		for _, u := range update.Preds {
 			preds = append(preds, u.Predicate)
 		}
+		for _, m := range update.Migrations {
+			preds = append(preds, m.From, m.Schema.Predicate)
+		}
+		for _, index := range update.Indexes {
+			preds = append(preds, index.Predicates...)
+		}
 	}
 
+	var ns uint64
 	var userId string
 	var groupIds []string
 
@@ -499,8 +562,9 @@ func authorizeAlter(ctx context.Context, op *api.Operation) error {
 		} else if err != nil {
 			return status.Error(codes.Unauthenticated, err.Error())
 		} else {
-			userId = userData[0]
-			groupIds = userData[1:]
+			ns = userData.namespace
+			userId = userData.userId
+			groupIds = userData.groupIds
 
 			if userId == x.GrootId {
 				return nil
@@ -513,7 +577,7 @@ func authorizeAlter(ctx context.Context, op *api.Operation) error {
 				"only Groot is allowed to drop all data, but the current user is %s", userId)
 		}
 
-		return authorizePreds(userId, groupIds, preds, acl.Modify)
+		return authorizePreds(ns, userId, groupIds, preds, acl.Modify)
 	}
 
 	err := doAuthorizeAlter()
@@ -531,6 +595,114 @@ func authorizeAlter(ctx context.Context, op *api.Operation) error {
 	return err
 }
 
+// authorizeCDC only allows the Groot of the galaxy namespace to subscribe to the change data
+// capture stream, since the stream carries the changes made to every predicate.
+func authorizeCDC(ctx context.Context) error {
+	if len(Config.HmacSecret) == 0 {
+		// the user has not turned on the acl feature
+		return nil
+	}
+
+	userData, err := extractUserAndGroups(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	if userData.userId != x.GrootId || userData.namespace != x.GalaxyNamespace {
+		return status.Errorf(codes.PermissionDenied,
+			"only Groot is allowed to subscribe to the change stream, but the current user is %s",
+			userData.userId)
+	}
+	return nil
+}
+
+// AttachNamespace attaches the namespace of the user who logged in with the accessJwt of the
+// request to the context. Requests without a valid accessJwt run in the galaxy namespace,
+// their authorization rejects them if they need one.
+func AttachNamespace(ctx context.Context) context.Context {
+	if len(Config.HmacSecret) == 0 {
+		// the user has not turned on the acl feature
+		return ctx
+	}
+	userData, err := extractUserAndGroups(ctx)
+	if err != nil {
+		return ctx
+	}
+	return x.AttachNamespace(ctx, userData.namespace)
+}
+
+// authorizeNamespaceOp only allows the Groot of the galaxy namespace to create and delete
+// namespaces.
+func authorizeNamespaceOp(ctx context.Context) error {
+	if len(Config.HmacSecret) == 0 {
+		return errors.Errorf("namespaces can only be used with the acl feature turned on")
+	}
+
+	userData, err := extractUserAndGroups(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	if userData.userId != x.GrootId || userData.namespace != x.GalaxyNamespace {
+		return status.Errorf(codes.PermissionDenied,
+			"only Groot is allowed to manage namespaces, but the current user is %s",
+			userData.userId)
+	}
+	return nil
+}
+
+// CreateNamespace creates a new namespace, along with its reserved predicates and its Groot
+// account using the given password, and returns its id.
+func (s *Server) CreateNamespace(ctx context.Context, password string) (uint64, error) {
+	if err := x.HealthCheck(); err != nil {
+		return 0, err
+	}
+	if err := authorizeNamespaceOp(ctx); err != nil {
+		return 0, err
+	}
+	if len(password) == 0 {
+		return 0, errors.Errorf("the password of the Groot account should not be empty")
+	}
+
+	// The ids of the namespaces are leased like uids, so they're never reused.
+	ids, err := worker.AssignUidsOverNetwork(ctx, &pb.Num{Val: 1})
+	if err != nil {
+		return 0, errors.Wrapf(err, "while leasing the namespace id")
+	}
+	ns := ids.StartId
+	if err := proposeNamespaceSchema(ctx, ns); err != nil {
+		return 0, errors.Wrapf(err, "while creating the schema of namespace %d", ns)
+	}
+	if err := upsertGroot(x.AttachNamespace(ctx, ns), password); err != nil {
+		return 0, errors.Wrapf(err, "while creating the groot account of namespace %d", ns)
+	}
+	glog.Infof("Created namespace %d", ns)
+	return ns, nil
+}
+
+// DeleteNamespace drops all the predicates and types of the namespace ns.
+func (s *Server) DeleteNamespace(ctx context.Context, ns uint64) error {
+	if err := x.HealthCheck(); err != nil {
+		return err
+	}
+	if err := authorizeNamespaceOp(ctx); err != nil {
+		return err
+	}
+	if ns == x.GalaxyNamespace {
+		return errors.Errorf("the galaxy namespace can't be deleted")
+	}
+
+	m := &pb.Mutations{
+		StartTs:   State.getTimestamp(false),
+		DropOp:    pb.Mutations_ALL,
+		DropValue: strconv.FormatUint(ns, 10),
+	}
+	if _, err := query.ApplyMutations(ctx, m); err != nil {
+		return err
+	}
+	aclCachePtr.update(ns, nil)
+	glog.Infof("Deleted namespace %d", ns)
+	return nil
+}
+
 // parsePredsFromMutation returns a union set of all the predicate names in the input nquads
 func parsePredsFromMutation(nquads []*api.NQuad) []string {
 	// use a map to dedup predicates
@@ -581,6 +753,7 @@ func authorizeMutation(ctx context.Context, gmu *gql.Mutation) error {
 
 	preds := parsePredsFromMutation(gmu.Set)
 
+	var ns uint64
 	var userId string
 	var groupIds []string
 	// doAuthorizeMutation checks if modification of all the predicates are allowed
@@ -593,8 +766,9 @@ func authorizeMutation(ctx context.Context, gmu *gql.Mutation) error {
 		} else if err != nil {
 			return status.Error(codes.Unauthenticated, err.Error())
 		} else {
-			userId = userData[0]
-			groupIds = userData[1:]
+			ns = userData.namespace
+			userId = userData.userId
+			groupIds = userData.groupIds
 
 			if userId == x.GrootId {
 				// groot is allowed to mutate anything except the permission of the acl predicates
@@ -605,7 +779,7 @@ func authorizeMutation(ctx context.Context, gmu *gql.Mutation) error {
 			}
 		}
 
-		return authorizePreds(userId, groupIds, preds, acl.Write)
+		return authorizePreds(ns, userId, groupIds, preds, acl.Write)
 	}
 
 	err := doAuthorizeMutation()
@@ -629,12 +803,30 @@ func parsePredsFromQuery(gqls []*gql.GraphQuery) []string {
 
 		if gq.Func != nil {
 			predsMap[gq.Func.Attr] = struct{}{}
+			if gq.Func.Name == "path" && len(gq.Func.Args) > 1 {
+				// The predicates followed by the path function are part of its expression.
+				if path, err := gql.ParsePropertyPath(gq.Func.Args[1].Value); err == nil {
+					for _, pred := range path.Predicates() {
+						predsMap[pred] = struct{}{}
+					}
+				}
+			}
 		}
 
 		if len(gq.Attr) > 0 {
 			predsMap[gq.Attr] = struct{}{}
 		}
 
+		// The locations used by A* and the weights of the nodes can be read from predicates
+		// by shortest path queries.
+		if gq.Alias == "shortest" {
+			for _, arg := range []string{"heuristic", "weight"} {
+				if pred := gq.Args[arg]; len(pred) > 0 && !needsVar(gq, pred) {
+					predsMap[pred] = struct{}{}
+				}
+			}
+		}
+
 		for _, childPred := range parsePredsFromQuery(gq.Children) {
 			predsMap[childPred] = struct{}{}
 		}
@@ -647,6 +839,16 @@ func parsePredsFromQuery(gqls []*gql.GraphQuery) []string {
 	return preds
 }
 
+// needsVar returns true if the query block uses the variable.
+func needsVar(gq *gql.GraphQuery, name string) bool {
+	for _, v := range gq.NeedsVar {
+		if v.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 type accessEntry struct {
 	userId    string
 	groups    []string
@@ -672,6 +874,7 @@ func authorizeQuery(ctx context.Context, parsedReq *gql.Result) error {
 		return nil
 	}
 
+	var ns uint64
 	var userId string
 	var groupIds []string
 	preds := parsePredsFromQuery(parsedReq.Query)
@@ -690,8 +893,9 @@ func authorizeQuery(ctx context.Context, parsedReq *gql.Result) error {
 		} else if err != nil {
 			return status.Error(codes.Unauthenticated, err.Error())
 		} else {
-			userId = userData[0]
-			groupIds = userData[1:]
+			ns = userData.namespace
+			userId = userData.userId
+			groupIds = userData.groupIds
 
 			if userId == x.GrootId {
 				// groot is allowed to query anything
@@ -699,7 +903,7 @@ func authorizeQuery(ctx context.Context, parsedReq *gql.Result) error {
 			}
 		}
 
-		return authorizePreds(userId, groupIds, preds, acl.Read)
+		return authorizePreds(ns, userId, groupIds, preds, acl.Read)
 	}
 
 	err := doAuthorizeQuery()
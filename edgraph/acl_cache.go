@@ -24,11 +24,16 @@ import (
 )
 
 type predRegexRule struct {
+	// namespace is the namespace of the group defining the rule, the rule only matches the
+	// predicates of that namespace.
+	namespace  uint64
 	predRegex  *regexp.Regexp
 	groupPerms map[string]int32
 }
 
-// aclCache is the cache mapping group names to the corresponding group acls
+// aclCache is the cache mapping group names to the corresponding group acls. The groups of a
+// namespace only define the rules for the predicates of their namespace, so the predicates are
+// stored as the attributes of their namespace.
 type aclCache struct {
 	sync.RWMutex
 	predPerms      map[string]map[string]int32
@@ -40,7 +45,8 @@ var aclCachePtr = &aclCache{
 	predRegexRules: make([]*predRegexRule, 0),
 }
 
-func (cache *aclCache) update(groups []acl.Group) {
+// update replaces the acl rules of the namespace ns with the rules of its groups.
+func (cache *aclCache) update(ns uint64, groups []acl.Group) {
 	// In dgraph, acl rules are divided by groups, e.g.
 	// the dev group has the following blob representing its ACL rules
 	// [friend, 4], [name, 7], [^user.*name$, 4]
@@ -73,12 +79,13 @@ func (cache *aclCache) update(groups []acl.Group) {
 
 		for _, acl := range acls {
 			if len(acl.Predicate) > 0 {
-				if groupPerms, found := predPerms[acl.Predicate]; found {
+				attr := x.NamespaceAttr(ns, acl.Predicate)
+				if groupPerms, found := predPerms[attr]; found {
 					groupPerms[group.GroupID] = acl.Perm
 				} else {
 					groupPerms := make(map[string]int32)
 					groupPerms[group.GroupID] = acl.Perm
-					predPerms[acl.Predicate] = groupPerms
+					predPerms[attr] = groupPerms
 				}
 			} else if len(acl.Regex) > 0 {
 				if regexRule, found := predRegexPerms[acl.Regex]; found {
@@ -94,6 +101,7 @@ func (cache *aclCache) update(groups []acl.Group) {
 					groupPermsMap := make(map[string]int32)
 					groupPermsMap[group.GroupID] = acl.Perm
 					predRegexPerms[acl.Regex] = &predRegexRule{
+						namespace:  ns,
 						predRegex:  predRegex,
 						groupPerms: groupPermsMap,
 					}
@@ -110,14 +118,26 @@ func (cache *aclCache) update(groups []acl.Group) {
 
 	aclCachePtr.Lock()
 	defer aclCachePtr.Unlock()
+	// keep the rules of the other namespaces
+	for attr, groupPerms := range aclCachePtr.predPerms {
+		if x.ParseNamespace(attr) != ns {
+			predPerms[attr] = groupPerms
+		}
+	}
+	for _, rule := range aclCachePtr.predRegexRules {
+		if rule.namespace != ns {
+			predRegexRules = append(predRegexRules, rule)
+		}
+	}
 	aclCachePtr.predPerms = predPerms
 	aclCachePtr.predRegexRules = predRegexRules
 }
 
 func (cache *aclCache) authorizePredicate(groups []string, predicate string,
 	operation *acl.Operation) error {
-	if x.IsAclPredicate(predicate) {
-		return errors.Errorf("only groot is allowed to access the ACL predicate: %s", predicate)
+	ns, name := x.ParseNamespaceAttr(predicate)
+	if x.IsAclPredicate(name) {
+		return errors.Errorf("only groot is allowed to access the ACL predicate: %s", name)
 	}
 
 	aclCachePtr.RLock()
@@ -134,7 +154,7 @@ func (cache *aclCache) authorizePredicate(groups []string, predicate string,
 
 	var predRegexMatch bool
 	for _, predRegexRule := range predRegexRules {
-		if predRegexRule.predRegex.MatchString(predicate) {
+		if predRegexRule.namespace == ns && predRegexRule.predRegex.MatchString(name) {
 			predRegexMatch = true
 			if hasRequiredAccess(predRegexRule.groupPerms, groups, operation) {
 				return nil
@@ -146,7 +166,7 @@ func (cache *aclCache) authorizePredicate(groups []string, predicate string,
 		// there is an ACL rule defined that can match the predicate
 		// and the operation has not been allowed
 		return errors.Errorf("unauthorized to do %s on predicate %s",
-			operation.Name, predicate)
+			operation.Name, name)
 	}
 
 	// no rule has been defined that can match the predicate
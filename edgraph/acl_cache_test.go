@@ -17,6 +17,7 @@ import (
 	"testing"
 
 	"github.com/dgraph-io/dgraph/ee/acl"
+	"github.com/dgraph-io/dgraph/x"
 	"github.com/stretchr/testify/require"
 )
 
@@ -45,7 +46,7 @@ func TestAclCache(t *testing.T) {
 			Acls:    string(aclBytes),
 		},
 	}
-	aclCachePtr.update(groups)
+	aclCachePtr.update(x.GalaxyNamespace, groups)
 	// after a rule is defined, the anonymous user should no longer have access
 	require.Error(t, aclCachePtr.authorizePredicate(emptyGroups, predicate, acl.Read),
 		"the anonymous user should not have access when the predicate has acl defined")
@@ -53,7 +54,7 @@ func TestAclCache(t *testing.T) {
 		"the user with group authorized should have access")
 
 	// update the cache with empty acl list in order to clear the cache
-	aclCachePtr.update([]acl.Group{})
+	aclCachePtr.update(x.GalaxyNamespace, []acl.Group{})
 	// the anonymous user should have access again
 	require.NoError(t, aclCachePtr.authorizePredicate(emptyGroups, predicate, acl.Read),
 		"the anonymous user should have access when the acl cache is empty")
@@ -72,9 +73,37 @@ func TestAclCache(t *testing.T) {
 			Acls:    string(aclBytes1),
 		},
 	}
-	aclCachePtr.update(groups1)
+	aclCachePtr.update(x.GalaxyNamespace, groups1)
 	require.Error(t, aclCachePtr.authorizePredicate(emptyGroups, predicate, acl.Read),
 		"the anonymous user should not have access when the predicate has acl defined")
 	require.NoError(t, aclCachePtr.authorizePredicate([]string{group}, predicate, acl.Read),
 		"the user with group authorized should have access")
 }
+
+func TestAclCacheNamespaces(t *testing.T) {
+	aclCachePtr = &aclCache{
+		predPerms:      make(map[string]map[string]int32),
+		predRegexRules: make([]*predRegexRule, 0),
+	}
+
+	group := "dev"
+	predicate := "friend"
+	acls, _ := json.Marshal([]acl.Acl{{Predicate: predicate, Perm: 4}})
+	regexAcls, _ := json.Marshal([]acl.Acl{{Regex: "^na", Perm: 4}})
+	aclCachePtr.update(1, []acl.Group{{GroupID: group, Acls: string(acls)}})
+	aclCachePtr.update(2, []acl.Group{{GroupID: group, Acls: string(regexAcls)}})
+
+	// the rules of a namespace don't apply to the predicates of the other namespaces
+	require.Error(t, aclCachePtr.authorizePredicate(nil, x.NamespaceAttr(1, predicate), acl.Read))
+	require.NoError(t, aclCachePtr.authorizePredicate(nil, predicate, acl.Read))
+	require.NoError(t, aclCachePtr.authorizePredicate(nil, x.NamespaceAttr(2, predicate), acl.Read))
+	require.Error(t, aclCachePtr.authorizePredicate(nil, x.NamespaceAttr(2, "name"), acl.Read))
+	require.NoError(t, aclCachePtr.authorizePredicate(nil, x.NamespaceAttr(1, "name"), acl.Read))
+
+	// updating a namespace keeps the rules of the other ones
+	aclCachePtr.update(1, nil)
+	require.NoError(t, aclCachePtr.authorizePredicate(nil, x.NamespaceAttr(1, predicate), acl.Read))
+	require.Error(t, aclCachePtr.authorizePredicate(nil, x.NamespaceAttr(2, "name"), acl.Read))
+	require.NoError(t, aclCachePtr.authorizePredicate([]string{group}, x.NamespaceAttr(2, "name"),
+		acl.Read))
+}
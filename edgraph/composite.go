@@ -0,0 +1,279 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+
+	"github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// addCompositeIndexes adds the composite indexes declared by the schema to the updates of their
+// types, along with the schema of their hidden predicates. The types being redefined keep the
+// indexes whose predicates are still fields of the type. The new indexes are returned, along
+// with the ones which are dropped.
+func addCompositeIndexes(ctx context.Context, ns uint64, result *schema.ParsedSchema) (
+	added, dropped []*pb.CompositeIndex, err error) {
+	updates := make(map[string]*pb.TypeUpdate, len(result.Types))
+	for _, typ := range result.Types {
+		updates[typ.TypeName] = typ
+		old, ok := schema.State().GetType(typ.TypeName)
+		if !ok {
+			continue
+		}
+		for _, index := range old.Indexes {
+			if hasFields(typ, index.Predicates) {
+				typ.Indexes = append(typ.Indexes, index)
+			} else {
+				dropped = append(dropped, index)
+			}
+		}
+	}
+
+	existing := make(map[string]*pb.CompositeIndex)
+	for _, index := range schema.State().CompositeIndexes(ns) {
+		if !isAdded(dropped, index) {
+			existing[index.Name] = index
+		}
+	}
+	for _, index := range result.Indexes {
+		index.TypeName = x.NamespaceAttr(ns, index.TypeName)
+		for i, pred := range index.Predicates {
+			index.Predicates[i] = x.NamespaceAttr(ns, pred)
+		}
+
+		if old, ok := existing[index.Name]; ok {
+			if old.TypeName != index.TypeName || !equalStrings(old.Predicates, index.Predicates) {
+				return nil, nil, errors.Errorf("Index %s already exists with other predicates",
+					index.Name)
+			}
+			continue
+		}
+		existing[index.Name] = index
+		if index.ValueTypes, err = valueTypes(ctx, index, result.Preds); err != nil {
+			return nil, nil, err
+		}
+
+		typ, ok := updates[index.TypeName]
+		if !ok {
+			old, ok := schema.State().GetType(index.TypeName)
+			if !ok {
+				return nil, nil, errors.Errorf("Type %s of index %s is not defined",
+					x.ParseAttr(index.TypeName), index.Name)
+			}
+			// The indexes are shared with the schema state, copy them.
+			old.Indexes = append([]*pb.CompositeIndex{}, old.Indexes...)
+			typ = &old
+			updates[typ.TypeName] = typ
+			result.Types = append(result.Types, typ)
+		}
+		typ.Indexes = append(typ.Indexes, index)
+		result.Preds = append(result.Preds, schema.CompositeIndexSchema(index))
+		added = append(added, index)
+	}
+	return added, dropped, nil
+}
+
+// buildCompositeIndexes indexes the existing nodes in the new composite indexes of the types,
+// then lets the queries use the indexes.
+func buildCompositeIndexes(ctx context.Context, types []*pb.TypeUpdate,
+	added []*pb.CompositeIndex) error {
+	if len(added) == 0 {
+		return nil
+	}
+	for _, index := range added {
+		if err := worker.BuildCompositeIndex(ctx, index); err != nil {
+			return errors.Wrapf(err, "while building index %s", index.Name)
+		}
+		index.Built = true
+	}
+
+	// The new indexes are shared with the type updates.
+	m := &pb.Mutations{StartTs: State.getTimestamp(false)}
+	for _, typ := range types {
+		for _, index := range typ.Indexes {
+			if isAdded(added, index) {
+				m.Types = append(m.Types, typ)
+				break
+			}
+		}
+	}
+	_, err := query.ApplyMutations(ctx, m)
+	return err
+}
+
+// valueTypes returns the names of the value types of the predicates of the index, as defined by
+// the schema update or else by the current schema.
+func valueTypes(ctx context.Context, index *pb.CompositeIndex, updates []*pb.SchemaUpdate) (
+	[]string, error) {
+	var missing []string
+	for _, pred := range index.Predicates {
+		if findUpdate(updates, pred) == nil {
+			missing = append(missing, pred)
+		}
+	}
+	var current map[string]*pb.SchemaUpdate
+	if len(missing) > 0 {
+		var err error
+		if current, err = worker.PredicateSchemas(ctx, missing); err != nil {
+			return nil, err
+		}
+	}
+
+	out := make([]string, 0, len(index.Predicates))
+	for _, pred := range index.Predicates {
+		su := findUpdate(updates, pred)
+		if su == nil {
+			su = current[pred]
+		}
+		if err := checkIndexable(index.Name, pred, su); err != nil {
+			return nil, err
+		}
+		out = append(out, types.TypeID(su.ValueType).Name())
+	}
+	return out, nil
+}
+
+// checkIndexable returns an error if the predicate, with the given schema, can't be part of the
+// composite index. The tuples of the index hold a single scalar value of every predicate.
+func checkIndexable(index, pred string, su *pb.SchemaUpdate) error {
+	switch {
+	case su.ValueType == pb.Posting_UID:
+		return errors.Errorf("Predicate %s of index %s is of type uid", x.ParseAttr(pred), index)
+	case su.List:
+		return errors.Errorf("Predicate %s of index %s is a list", x.ParseAttr(pred), index)
+	case su.Lang:
+		return errors.Errorf("Predicate %s of index %s has language tags", x.ParseAttr(pred),
+			index)
+	}
+	return nil
+}
+
+// checkIndexedTypes returns an error if the schema update or a migration changes the value type
+// of a predicate of a composite index, whose tuples would no longer match the values, or makes it
+// a list or adds language tags to it. Renaming the predicate is fine, the index follows it.
+func checkIndexedTypes(ns uint64, updates []*pb.SchemaUpdate, migrations []*pb.Migration) error {
+	check := func(pred string, su *pb.SchemaUpdate) error {
+		for _, index := range schema.State().CompositeIndexes(ns) {
+			for i, p := range index.Predicates {
+				if p != pred || i >= len(index.ValueTypes) {
+					continue
+				}
+				if err := checkIndexable(index.Name, pred, su); err != nil {
+					return err
+				}
+				if index.ValueTypes[i] != types.TypeID(su.ValueType).Name() {
+					return errors.Errorf("Cannot change the type of predicate %s used by index %s",
+						x.ParseAttr(pred), index.Name)
+				}
+			}
+		}
+		return nil
+	}
+	for _, update := range updates {
+		if err := check(update.Predicate, update); err != nil {
+			return err
+		}
+	}
+	for _, migration := range migrations {
+		if err := check(migration.From, migration.Schema); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func findUpdate(updates []*pb.SchemaUpdate, pred string) *pb.SchemaUpdate {
+	for _, update := range updates {
+		if update.Predicate == pred {
+			return update
+		}
+	}
+	return nil
+}
+
+// dropCompositeIndexes drops the hidden predicates of the composite indexes.
+func dropCompositeIndexes(ctx context.Context, indexes []*pb.CompositeIndex) error {
+	for _, index := range indexes {
+		nq := &api.NQuad{
+			Subject:     x.Star,
+			Predicate:   schema.CompositeIndexPredicate(index),
+			ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: x.Star}},
+		}
+		edge, err := (&gql.NQuad{NQuad: nq}).ToDeletePredEdge()
+		if err != nil {
+			return err
+		}
+		m := &pb.Mutations{StartTs: State.getTimestamp(false), Edges: []*pb.DirectedEdge{edge}}
+		if _, err := query.ApplyMutations(ctx, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isAdded(added []*pb.CompositeIndex, index *pb.CompositeIndex) bool {
+	for _, a := range added {
+		if a == index {
+			return true
+		}
+	}
+	return false
+}
+
+func hasFields(typ *pb.TypeUpdate, preds []string) bool {
+	for _, pred := range preds {
+		found := false
+		for _, field := range typ.Fields {
+			found = found || field.Predicate == pred
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func hasString(strs []string, s string) bool {
+	for _, str := range strs {
+		if str == s {
+			return true
+		}
+	}
+	return false
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+)
+
+func TestAddCompositeIndexes(t *testing.T) {
+	require.NoError(t, schema.ParseBytes(nil, 1))
+	result, err := schema.Parse(`
+		country: string @index(exact) .
+		age: int .
+		type Person {
+			country
+			age
+		}
+		index country_age on Person(country, age)
+	`)
+	require.NoError(t, err)
+	added, dropped, err := addCompositeIndexes(context.Background(), 0, result)
+	require.NoError(t, err)
+	require.Empty(t, dropped)
+	require.Len(t, added, 1)
+	require.Equal(t, []string{"string", "int"}, added[0].ValueTypes)
+
+	tests := []struct {
+		schema string
+		err    string
+	}{
+		{schema: "tags: [string] .", err: "Predicate tags of index country_tags is a list"},
+		{schema: "tags: uid .", err: "Predicate tags of index country_tags is of type uid"},
+		{schema: "tags: [uid] .", err: "Predicate tags of index country_tags is of type uid"},
+		{schema: "tags: string @lang .",
+			err: "Predicate tags of index country_tags has language tags"},
+	}
+	for _, tc := range tests {
+		result, err := schema.Parse(tc.schema + `
+			country: string @index(exact) .
+			type Person {
+				country
+				tags
+			}
+			index country_tags on Person(country, tags)
+		`)
+		require.NoError(t, err)
+		_, _, err = addCompositeIndexes(context.Background(), 0, result)
+		require.Error(t, err, tc.schema)
+		require.Contains(t, err.Error(), tc.err, tc.schema)
+	}
+}
+
+func TestCheckIndexedTypes(t *testing.T) {
+	require.NoError(t, schema.ParseBytes(nil, 1))
+	schema.State().SetType("Person", pb.TypeUpdate{
+		TypeName: "Person",
+		Indexes: []*pb.CompositeIndex{{
+			Name:       "country_age",
+			TypeName:   "Person",
+			Predicates: []string{"country", "age"},
+			ValueTypes: []string{"string", "int"},
+		}},
+	})
+	defer func() { require.NoError(t, schema.ParseBytes(nil, 1)) }()
+
+	check := func(update *pb.SchemaUpdate) error {
+		return checkIndexedTypes(0, []*pb.SchemaUpdate{update}, nil)
+	}
+	require.NoError(t, check(&pb.SchemaUpdate{Predicate: "age", ValueType: pb.Posting_INT}))
+	require.Error(t, check(&pb.SchemaUpdate{Predicate: "age", ValueType: pb.Posting_FLOAT}))
+	require.Error(t, check(&pb.SchemaUpdate{Predicate: "age", ValueType: pb.Posting_INT,
+		List: true}))
+	require.Error(t, check(&pb.SchemaUpdate{Predicate: "country",
+		ValueType: pb.Posting_STRING, Lang: true}))
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// validateCommit checks the constraints of the types of the nodes modified by the transaction,
+// before it's committed. The nodes are read from the groups the transaction was applied to.
+func validateCommit(ctx context.Context, tc *api.TxnContext) error {
+	if !query.HasTypeConstraints(x.ExtractNamespace(ctx)) {
+		return nil
+	}
+	uids, err := worker.TxnSubjects(ctx, tc.StartTs)
+	if err != nil {
+		return err
+	}
+	return query.ValidateTypeConstraints(ctx, tc.StartTs, uids)
+}
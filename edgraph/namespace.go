@@ -0,0 +1,87 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// namespaceNQuads rewrites the predicates of the nquads to the attributes storing them in the
+// namespace ns. The * of the deletions of all the predicates of a node is kept as is.
+func namespaceNQuads(ns uint64, nquads []*api.NQuad) {
+	if ns == x.GalaxyNamespace {
+		return
+	}
+	for _, nq := range nquads {
+		if nq.Predicate != x.Star {
+			nq.Predicate = x.NamespaceAttr(ns, nq.Predicate)
+		}
+	}
+}
+
+// namespaceTypes rewrites the names of the types, and of their fields, to the attributes
+// storing them in the namespace ns.
+func namespaceTypes(ns uint64, types []*pb.TypeUpdate) {
+	if ns == x.GalaxyNamespace {
+		return
+	}
+	for _, typ := range types {
+		typ.TypeName = x.NamespaceAttr(ns, typ.TypeName)
+		for _, field := range typ.Fields {
+			field.Predicate = x.NamespaceAttr(ns, field.Predicate)
+		}
+	}
+}
+
+// dropNamespace drops the predicates and types of the namespace ns, or only their data if
+// keepSchema is set. The reserved predicates and the Groot account of the namespace are
+// created again afterwards.
+func dropNamespace(ctx context.Context, m *pb.Mutations, ns uint64, keepSchema bool) error {
+	m.DropOp = pb.Mutations_ALL
+	if keepSchema {
+		m.DropOp = pb.Mutations_DATA
+	}
+	m.DropValue = strconv.FormatUint(ns, 10)
+	if _, err := query.ApplyMutations(ctx, m); err != nil {
+		return err
+	}
+
+	if !keepSchema {
+		if err := proposeNamespaceSchema(ctx, ns); err != nil {
+			return err
+		}
+	}
+	resetNamespaceAcl(ns)
+	return nil
+}
+
+// proposeNamespaceSchema proposes the schema of the reserved predicates of the namespace ns.
+func proposeNamespaceSchema(ctx context.Context, ns uint64) error {
+	m := &pb.Mutations{
+		StartTs: State.getTimestamp(false),
+		Schema:  schema.NamespaceInitialSchema(ns),
+	}
+	_, err := query.ApplyMutations(ctx, m)
+	return err
+}
@@ -49,6 +49,7 @@ import (
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
@@ -333,6 +334,7 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 		return nil, err
 	}
 
+	ctx = AttachNamespace(ctx)
 	if err := authorizeAlter(ctx, op); err != nil {
 		glog.Warningf("Alter denied with error: %v\n", err)
 		return nil, err
@@ -343,11 +345,17 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 	// StartTs is not needed if the predicate to be dropped lies on this server but is required
 	// if it lies on some other machine. Let's get it for safety.
 	m := &pb.Mutations{StartTs: State.getTimestamp(false)}
+	ns := x.ExtractNamespace(ctx)
 	if isDropAll(op) {
 		if len(op.DropValue) > 0 {
 			return empty, errors.Errorf("If DropOp is set to ALL, DropValue must be empty")
 		}
 
+		if ns != x.GalaxyNamespace {
+			// Only the predicates and types of the namespace are dropped.
+			return empty, dropNamespace(ctx, m, ns, false)
+		}
+
 		m.DropOp = pb.Mutations_ALL
 		_, err := query.ApplyMutations(ctx, m)
 
@@ -361,6 +369,10 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 			return empty, errors.Errorf("If DropOp is set to DATA, DropValue must be empty")
 		}
 
+		if ns != x.GalaxyNamespace {
+			return empty, dropNamespace(ctx, m, ns, true)
+		}
+
 		m.DropOp = pb.Mutations_DATA
 		_, err := query.ApplyMutations(ctx, m)
 
@@ -387,10 +399,16 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 				attr)
 			return empty, err
 		}
+		for _, index := range schema.State().CompositeIndexes(ns) {
+			if hasString(index.Predicates, x.NamespaceAttr(ns, attr)) {
+				return empty, errors.Errorf("predicate %s is used by index %s and cannot be "+
+					"dropped", attr, index.Name)
+			}
+		}
 
 		nq := &api.NQuad{
 			Subject:     x.Star,
-			Predicate:   attr,
+			Predicate:   x.NamespaceAttr(ns, attr),
 			ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: x.Star}},
 		}
 		wnq := &gql.NQuad{NQuad: nq}
@@ -410,9 +428,12 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 		}
 
 		m.DropOp = pb.Mutations_TYPE
-		m.DropValue = op.DropValue
-		_, err := query.ApplyMutations(ctx, m)
-		return empty, err
+		m.DropValue = x.NamespaceAttr(ns, op.DropValue)
+		typ, _ := schema.State().GetType(m.DropValue)
+		if _, err := query.ApplyMutations(ctx, m); err != nil {
+			return empty, err
+		}
+		return empty, dropCompositeIndexes(ctx, typ.Indexes)
 	}
 
 	result, err := schema.Parse(op.Schema)
@@ -421,25 +442,88 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 	}
 
 	for _, update := range result.Preds {
+		if err := validatePredName(update.Predicate); err != nil {
+			return nil, err
+		}
+
+		name := update.Predicate
+		update.Predicate = x.NamespaceAttr(ns, name)
+		if update.Where != nil {
+			if err := validatePredName(update.Where.Predicate); err != nil {
+				return nil, err
+			}
+			update.Where.Predicate = x.NamespaceAttr(ns, update.Where.Predicate)
+		}
 		// Reserved predicates cannot be altered but let the update go through
 		// if the update is equal to the existing one.
 		if schema.IsReservedPredicateChanged(update.Predicate, update) {
 			err := errors.Errorf("predicate %s is reserved and is not allowed to be modified",
-				update.Predicate)
+				name)
 			return nil, err
 		}
-
-		if err := validatePredName(update.Predicate); err != nil {
-			return nil, err
+	}
+	// The conditions of the partial indexes are checked against the schema of their predicates,
+	// which may be in the same update.
+	sort.SliceStable(result.Preds, func(i, j int) bool {
+		return result.Preds[i].Where == nil && result.Preds[j].Where != nil
+	})
+	namespaceTypes(ns, result.Types)
+	addedIndexes, droppedIndexes, err := addCompositeIndexes(ctx, ns, result)
+	if err != nil {
+		return nil, err
+	}
+	for _, migration := range result.Migrations {
+		for _, pred := range []string{migration.From, migration.Schema.Predicate} {
+			if err := validatePredName(pred); err != nil {
+				return nil, err
+			}
+			if x.IsReservedPredicate(pred) {
+				return nil, errors.Errorf("predicate %s is reserved and cannot be migrated", pred)
+			}
+		}
+		migration.From = x.NamespaceAttr(ns, migration.From)
+		migration.Schema.Predicate = x.NamespaceAttr(ns, migration.Schema.Predicate)
+		if where := migration.Schema.Where; where != nil {
+			where.Predicate = x.NamespaceAttr(ns, where.Predicate)
 		}
 	}
+	if err := checkIndexedTypes(ns, result.Preds, result.Migrations); err != nil {
+		return nil, err
+	}
 
 	glog.Infof("Got schema: %+v\n", result)
 	// TODO: Maybe add some checks about the schema.
-	m.Schema = result.Preds
-	m.Types = result.Types
-	_, err = query.ApplyMutations(ctx, m)
-	return empty, err
+	if err := dropCompositeIndexes(ctx, droppedIndexes); err != nil {
+		return empty, err
+	}
+	if len(result.Preds) > 0 || len(result.Types) > 0 || len(result.Migrations) == 0 {
+		m.Schema = result.Preds
+		m.Types = result.Types
+		if _, err = query.ApplyMutations(ctx, m); err != nil {
+			return empty, err
+		}
+	}
+	if err := buildCompositeIndexes(ctx, result.Types, addedIndexes); err != nil {
+		return empty, err
+	}
+	if len(result.Migrations) == 0 {
+		return empty, nil
+	}
+
+	// The migrations run in the background, their progress is reported by /admin/migrations.
+	ids := make([]uint64, 0, len(result.Migrations))
+	for _, migration := range result.Migrations {
+		id, err := worker.StartMigration(ctx, migration)
+		if err != nil {
+			return empty, err
+		}
+		ids = append(ids, id)
+	}
+	data, err := json.Marshal(map[string][]uint64{"migrations": ids})
+	if err != nil {
+		return empty, err
+	}
+	return &api.Payload{Data: data}, nil
 }
 
 func annotateStartTs(span *otrace.Span, ts uint64) {
@@ -478,6 +562,9 @@ func (s *Server) doMutate(ctx context.Context, qc *queryContext, resp *api.Respo
 	// 2. For a uid variable that is part of an upsert query,
 	//    like uid(foo), the key would be uid(foo).
 	resp.Uids = query.UidsToHex(query.StripBlankNode(newUids))
+	ns := x.ExtractNamespace(ctx)
+	namespaceNQuads(ns, gmu.Set)
+	namespaceNQuads(ns, gmu.Del)
 	edges, err := query.ToDirectedEdges(gmu, newUids)
 	if err != nil {
 		return err
@@ -517,6 +604,11 @@ func (s *Server) doMutate(ctx context.Context, qc *queryContext, resp *api.Respo
 
 	qc.span.Annotatef(nil, "Prewrites err: %v. Attempting to commit/abort immediately.", err)
 	ctxn := resp.Txn
+	if err := validateCommit(ctx, ctxn); err != nil {
+		ctxn.Aborted = true
+		_, _ = worker.CommitOverNetwork(ctx, ctxn)
+		return err
+	}
 	// zero would assign the CommitTs
 	cts, err := worker.CommitOverNetwork(ctx, ctxn)
 	qc.span.Annotatef(nil, "Status of commit at ts: %d: %v", ctxn.StartTs, err)
@@ -817,6 +909,15 @@ func (s *Server) Query(ctx context.Context, req *api.Request) (*api.Response, er
 	return s.doQuery(ctx, req, NeedAuthorize)
 }
 
+// sendProfile sets the profile trailer of the gRPC response to the JSON encoded profile.
+func sendProfile(ctx context.Context, profile *query.Profile) error {
+	js, err := json.Marshal(profile.Nodes)
+	if err != nil {
+		return errors.Wrapf(err, "while encoding query profile")
+	}
+	return grpc.SetTrailer(ctx, metadata.Pairs("profile", string(js)))
+}
+
 func (s *Server) doQuery(ctx context.Context, req *api.Request, authorize int) (
 	resp *api.Response, rerr error) {
 
@@ -873,6 +974,25 @@ func (s *Server) doQuery(ctx context.Context, req *api.Request, authorize int) (
 	if isMutation && len(req.Mutations) != 1 {
 		return nil, errors.Errorf("Only 1 mutation per request is supported")
 	}
+	if isMutation {
+		if mode, err := query.ExplainMode(ctx); err != nil || mode != "" {
+			return nil, errors.Errorf("Only queries without mutations can be explained")
+		}
+	}
+	if _, ok := ctx.Value(query.ProfileKey).(*query.Profile); !ok && isQuery &&
+		query.ProfileRequested(ctx) {
+		// gRPC clients get the profile back in the trailer of the response.
+		profile := &query.Profile{}
+		ctx = context.WithValue(ctx, query.ProfileKey, profile)
+		defer func() {
+			if rerr != nil {
+				return
+			}
+			if err := sendProfile(ctx, profile); err != nil {
+				glog.Warningf("Unable to send the query profile: %+v", err)
+			}
+		}()
+	}
 
 	qc := &queryContext{req: req, latency: l, span: span}
 	if rerr = parseRequest(qc); rerr != nil {
@@ -880,11 +1000,29 @@ func (s *Server) doQuery(ctx context.Context, req *api.Request, authorize int) (
 	}
 
 	if authorize == NeedAuthorize {
+		// Internal requests attach the namespace they run in themselves.
+		ctx = AttachNamespace(ctx)
 		if rerr = authorizeRequest(ctx, qc); rerr != nil {
 			return
 		}
 	}
 
+	asOf, rerr := readTsAsOf(ctx)
+	if rerr != nil {
+		return
+	}
+	if asOf > 0 {
+		if isMutation || req.StartTs != 0 {
+			return nil, errors.Errorf("Queries can only be run as of an earlier time outside " +
+				"of transactions, and without mutations")
+		}
+		if rerr = worker.ValidateAsOf(asOf); rerr != nil {
+			return
+		}
+		req.StartTs = asOf
+		req.ReadOnly = true
+	}
+
 	// We use defer here because for queries, startTs will be
 	// assigned in the processQuery function called below.
 	defer annotateStartTs(qc.span, qc.req.StartTs)
@@ -979,9 +1117,12 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 			respMap["types"] = formatTypes(er.Types)
 		}
 		resp.Json, err = json.Marshal(respMap)
-	} else {
+	} else if !er.PlanOnly {
 		resp.Json, err = query.ToJson(qc.latency, er.Subgraphs)
 	}
+	if err == nil && er.Plan != nil {
+		resp.Json, err = query.WithPlan(resp.Json, er.Plan)
+	}
 	if err != nil {
 		return resp, err
 	}
@@ -1091,6 +1232,7 @@ func (s *Server) CommitOrAbort(ctx context.Context, tc *api.TxnContext) (*api.Tx
 		return &api.TxnContext{}, err
 	}
 
+	ctx = AttachNamespace(ctx)
 	tctx := &api.TxnContext{}
 	if tc.StartTs == 0 {
 		return &api.TxnContext{}, errors.Errorf(
@@ -1099,6 +1241,13 @@ func (s *Server) CommitOrAbort(ctx context.Context, tc *api.TxnContext) (*api.Tx
 	annotateStartTs(span, tc.StartTs)
 
 	span.Annotatef(nil, "Txn Context received: %+v", tc)
+	if !tc.Aborted {
+		if err := validateCommit(ctx, tc); err != nil {
+			tc.Aborted = true
+			_, _ = worker.CommitOverNetwork(ctx, tc)
+			return &api.TxnContext{StartTs: tc.StartTs, Aborted: true}, err
+		}
+	}
 	commitTs, err := worker.CommitOverNetwork(ctx, tc)
 	if err == dgo.ErrAborted {
 		tctx.Aborted = true
@@ -1120,6 +1269,27 @@ func (s *Server) CheckVersion(ctx context.Context, c *api.Check) (v *api.Version
 	return v, nil
 }
 
+// Subscribe streams the changes made by the transactions committed after req.SinceTs, in
+// the order of their commit timestamps. The stream only ends when the client goes away or an
+// error happens, so clients resume from the last commit timestamp they received.
+func (s *Server) Subscribe(req *pb.CDCRequest, stream pb.CDC_SubscribeServer) error {
+	if err := x.HealthCheck(); err != nil {
+		return err
+	}
+	ctx := stream.Context()
+	if err := authorizeCDC(ctx); err != nil {
+		return err
+	}
+	if !x.WorkerConfig.CDC {
+		return errors.New("Change data capture is disabled. Restart the alphas with --cdc " +
+			"to enable it")
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		glog.Infof("Streaming changes committed after ts %d to %q", req.SinceTs, p.Addr)
+	}
+	return worker.StreamCDC(ctx, req.SinceTs, stream.Send)
+}
+
 //-------------------------------------------------------------------------------------------------
 // HELPER FUNCTIONS
 //-------------------------------------------------------------------------------------------------
@@ -1134,6 +1304,34 @@ func isMutationAllowed(ctx context.Context) bool {
 	return true
 }
 
+// readTsAsOf returns the timestamp to read at when the request asks to see the data as of an
+// earlier timestamp, via the as_of metadata, or an earlier time in RFC 3339 format, via the
+// as_of_time metadata. It returns zero otherwise.
+func readTsAsOf(ctx context.Context) (uint64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+	asOf, asOfTime := md.Get("as_of"), md.Get("as_of_time")
+	switch {
+	case len(asOf) > 0 && len(asOfTime) > 0:
+		return 0, errors.Errorf("Only one of as_of and as_of_time can be set")
+	case len(asOf) > 0:
+		ts, err := strconv.ParseUint(asOf[0], 0, 64)
+		if err != nil || ts == 0 {
+			return 0, errors.Errorf("Invalid as_of timestamp: %q", asOf[0])
+		}
+		return ts, nil
+	case len(asOfTime) > 0:
+		t, err := time.Parse(time.RFC3339Nano, asOfTime[0])
+		if err != nil {
+			return 0, errors.Wrapf(err, "while parsing as_of_time")
+		}
+		return worker.TimestampAt(t)
+	}
+	return 0, nil
+}
+
 var errNoAuth = errors.Errorf("No Auth Token found. Token needed for Alter operations.")
 
 func isAlterAllowed(ctx context.Context) error {
@@ -1318,6 +1516,14 @@ func validatePredName(name string) error {
 		return errors.Errorf("Predicate name length cannot be bigger than 2^16. Predicate: %v",
 			name[:80])
 	}
+	if x.HasNamespaceMarker(name) {
+		return errors.Errorf("Predicate name cannot start with the byte 0x00. Predicate: %q",
+			name)
+	}
+	if schema.IsCompositeIndexPredicate(name) {
+		return errors.Errorf("Predicate %s stores a composite index and cannot be used directly",
+			name)
+	}
 	return nil
 }
 
@@ -1336,6 +1542,16 @@ func formatTypes(types []*pb.TypeUpdate) []map[string]interface{} {
 			fields[i] = m
 		}
 		typeMap["fields"] = fields
+		if len(typ.Indexes) > 0 {
+			indexes := make([]map[string]interface{}, len(typ.Indexes))
+			for i, index := range typ.Indexes {
+				indexes[i] = map[string]interface{}{
+					"name":       index.Name,
+					"predicates": index.Predicates,
+				}
+			}
+			typeMap["indexes"] = indexes
+		}
 
 		res = append(res, typeMap)
 	}
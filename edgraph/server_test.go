@@ -17,12 +17,14 @@
 package edgraph
 
 import (
+	"context"
 	"testing"
 
 	"github.com/dgraph-io/dgo/v2/protos/api"
 	"github.com/dgraph-io/dgraph/chunker"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
 )
 
 func makeNquad(sub, pred string, val *api.Value) *api.NQuad {
@@ -119,3 +121,47 @@ func TestValidateKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestReadTsAsOf(t *testing.T) {
+	asOf := func(kv ...string) (uint64, error) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(kv...))
+		return readTsAsOf(ctx)
+	}
+
+	ts, err := readTsAsOf(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, ts)
+
+	ts, err = asOf("as_of", "42")
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), ts)
+
+	ts, err = asOf("as_of", "0x10")
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), ts)
+
+	_, err = asOf("as_of", "0")
+	require.Error(t, err)
+	_, err = asOf("as_of", "yesterday")
+	require.Error(t, err)
+	_, err = asOf("as_of_time", "yesterday")
+	require.Error(t, err)
+	_, err = asOf("as_of", "42", "as_of_time", "2019-11-01T00:00:00Z")
+	require.Error(t, err)
+}
+
+func TestNamespaceNQuads(t *testing.T) {
+	nquads := []*api.NQuad{
+		makeNquadEdge("_:a", "friend", "_:b"),
+		makeNquadEdge("0x1", x.Star, x.Star),
+	}
+	namespaceNQuads(x.GalaxyNamespace, nquads)
+	require.Equal(t, "friend", nquads[0].Predicate)
+
+	namespaceNQuads(2, nquads)
+	require.Equal(t, x.NamespaceAttr(2, "friend"), nquads[0].Predicate)
+	require.Equal(t, x.Star, nquads[1].Predicate)
+
+	require.Error(t, validatePredName(x.NamespaceAttr(2, "friend")))
+	require.NoError(t, validatePredName("friend"))
+}
@@ -65,7 +65,8 @@ type MathTree struct {
 
 func isUnary(f string) bool {
 	return f == "exp" || f == "ln" || f == "u-" || f == "sqrt" ||
-		f == "floor" || f == "ceil" || f == "since"
+		f == "floor" || f == "ceil" || f == "since" ||
+		f == "lower" || f == "upper" || f == "len" || f == "year"
 }
 
 func isBinaryMath(f string) bool {
@@ -73,7 +74,7 @@ func isBinaryMath(f string) bool {
 }
 
 func isTernary(f string) bool {
-	return f == "cond"
+	return f == "cond" || f == "substr" || f == "dateadd" || f == "datediff"
 }
 
 func isZero(f string, rval types.Val) bool {
@@ -161,7 +162,9 @@ func isMathFunc(f string) bool {
 		f == "==" || f == "!=" ||
 		f == "min" || f == "max" || f == "sqrt" ||
 		f == "pow" || f == "logbase" || f == "floor" || f == "ceil" ||
-		f == "since"
+		f == "since" || f == "concat" || f == "lower" || f == "upper" ||
+		f == "substr" || f == "len" || f == "dateadd" || f == "datediff" ||
+		f == "truncate" || f == "year"
 }
 
 func parseMathFunc(it *lex.ItemIterator, again bool) (*MathTree, bool, error) {
@@ -246,8 +249,21 @@ func parseMathFunc(it *lex.ItemIterator, again bool) (*MathTree, bool, error) {
 				}
 				continue
 			}
-			// We will try to parse the constant as an Int first, if that fails we move to float
 			child := &MathTree{}
+			if len(item.Val) > 0 && item.Val[0] == quote {
+				// String constant, e.g. the unit of truncate(dt, "day").
+				str, err := unquoteIfQuoted(item.Val)
+				if err != nil {
+					return nil, false, err
+				}
+				child.Const = types.Val{
+					Tid:   types.StringID,
+					Value: str,
+				}
+				valueStack.push(child)
+				continue
+			}
+			// We will try to parse the constant as an Int first, if that fails we move to float
 			i, err := strconv.ParseInt(item.Val, 10, 64)
 			if err != nil {
 				v, err := strconv.ParseFloat(item.Val, 64)
@@ -366,6 +382,8 @@ func (t *MathTree) stringHelper(buf *bytes.Buffer) {
 			leafStr, err = buf.WriteString(strconv.FormatFloat(t.Const.Value.(float64), 'E', -1, 64))
 		} else if t.Const.Tid == types.IntID {
 			leafStr, err = buf.WriteString(strconv.FormatInt(t.Const.Value.(int64), 10))
+		} else if t.Const.Tid == types.StringID {
+			leafStr, err = buf.WriteString(strconv.Quote(t.Const.Value.(string)))
 		}
 		x.Check2(leafStr, err)
 		return
@@ -375,7 +393,8 @@ func (t *MathTree) stringHelper(buf *bytes.Buffer) {
 	switch t.Fn {
 	case "+", "-", "/", "*", "%", "exp", "ln", "cond", "min",
 		"sqrt", "max", "<", ">", "<=", ">=", "==", "!=", "u-",
-		"logbase", "pow":
+		"logbase", "pow", "concat", "lower", "upper", "substr", "len", "dateadd",
+		"datediff", "truncate", "year":
 		x.Check2(buf.WriteString(t.Fn))
 	default:
 		x.Fatalf("Unknown operator: %q", t.Fn)
@@ -31,11 +31,13 @@ import (
 )
 
 const (
-	uidFunc   = "uid"
-	valueFunc = "val"
-	typFunc   = "type"
-	lenFunc   = "len"
-	countFunc = "count"
+	uidFunc     = "uid"
+	valueFunc   = "val"
+	typFunc     = "type"
+	lenFunc     = "len"
+	countFunc   = "count"
+	pathFunc    = "path"
+	historyFunc = "history"
 )
 
 // GraphQuery stores the parsed Query in a tree format. This gets converted to
@@ -192,6 +194,16 @@ var mathOpPrecedence = map[string]int{
 	"max":     85,
 	"min":     84,
 
+	"concat":   80,
+	"lower":    79,
+	"upper":    78,
+	"substr":   77,
+	"len":      76,
+	"dateadd":  75,
+	"datediff": 74,
+	"truncate": 73,
+	"year":     72,
+
 	"/": 50,
 	"*": 49,
 	"%": 48,
@@ -216,6 +228,17 @@ func (f *Function) IsPasswordVerifier() bool {
 	return f.Name == "checkpwd"
 }
 
+// IsHistory returns true if the function name is "history".
+func (f *Function) IsHistory() bool {
+	return f.Name == historyFunc
+}
+
+// IsGraphAlgorithm returns true if the function name is a graph algorithm, i.e. "pagerank",
+// "components" or "distance".
+func (f *Function) IsGraphAlgorithm() bool {
+	return isGraphAlgoFunc(f.Name)
+}
+
 // DebugPrint is useful for debugging.
 func (gq *GraphQuery) DebugPrint(prefix string) {
 	glog.Infof("%s[%x %q %q]\n", prefix, gq.UID, gq.Attr, gq.Alias)
@@ -1504,12 +1527,44 @@ func validFuncName(name string) bool {
 
 	switch name {
 	case "regexp", "anyofterms", "allofterms", "alloftext", "anyoftext",
-		"has", "uid", "uid_in", "anyof", "allof", "type", "match":
+		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to",
+		"facet_eq", "facet_le", "facet_lt", "facet_ge", "facet_gt", pathFunc:
 		return true
 	}
 	return false
 }
 
+// validatePathArgs checks the arguments of the path function, i.e. the uid of the source node, a
+// property path expression and optionally the uid of the destination node.
+func validatePathArgs(f *Function) error {
+	// A nested function like val(a) is parsed as the attribute of the function, but the source
+	// and destination of the path have to be uids known before the query runs.
+	if f.IsValueVar || f.IsLenVar || f.IsCount {
+		return errors.Errorf("Function path doesn't support variables or functions as "+
+			"arguments. Got: %s", f.Attr)
+	}
+	if len(f.Args) != 2 && len(f.Args) != 3 {
+		return errors.Errorf("Function path expects a source uid, a property path and "+
+			"optionally a destination uid. Got: %d arguments", len(f.Args))
+	}
+	for i, arg := range f.Args {
+		if arg.IsGraphQLVar {
+			continue
+		}
+		if i == 1 {
+			if _, err := ParsePropertyPath(arg.Value); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := strconv.ParseUint(arg.Value, 0, 64); err != nil {
+			return errors.Errorf("Function path expects uids as source and destination. "+
+				"Got: %s", arg.Value)
+		}
+	}
+	return nil
+}
+
 type regexArgs struct {
 	expr  string
 	flags string
@@ -1711,7 +1766,7 @@ L:
 
 			// Unlike other functions, uid function has no attribute, everything is args.
 			if len(function.Attr) == 0 && function.Name != uidFunc &&
-				function.Name != typFunc {
+				function.Name != typFunc && function.Name != pathFunc {
 
 				if strings.ContainsRune(itemInFunc.Val, '"') {
 					return nil, itemInFunc.Errorf("Attribute in function"+
@@ -1777,7 +1832,8 @@ L:
 		}
 	}
 
-	if function.Name != uidFunc && function.Name != typFunc && len(function.Attr) == 0 {
+	if function.Name != uidFunc && function.Name != typFunc && function.Name != pathFunc &&
+		len(function.Attr) == 0 {
 		return nil, it.Errorf("Got empty attr for function: [%s]", function.Name)
 	}
 
@@ -2368,7 +2424,7 @@ func validKeyAtRoot(k string) bool {
 	switch k {
 	case "func", "orderasc", "orderdesc", "first", "offset", "after":
 		return true
-	case "from", "to", "numpaths", "minweight", "maxweight":
+	case "from", "to", "numpaths", "minweight", "maxweight", "allpaths", "heuristic", "weight":
 		// Specific to shortest path
 		return true
 	case "depth":
@@ -2488,6 +2544,11 @@ func getRoot(it *lex.ItemIterator) (gq *GraphQuery, rerr error) {
 			if !validFuncName(gen.Name) {
 				return nil, item.Errorf("Function name: %s is not valid.", gen.Name)
 			}
+			if gen.Name == pathFunc {
+				if err := validatePathArgs(gen); err != nil {
+					return nil, err
+				}
+			}
 			gq.Func = gen
 			gq.NeedsVar = append(gq.NeedsVar, gen.NeedsVar...)
 		case "from", "to":
@@ -2760,6 +2821,49 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 				gq.Children = append(gq.Children, child)
 				curp = nil
 				continue
+			} else if valLower == historyFunc && isHistoryFunc(it) {
+				if varName != "" {
+					return it.Errorf("Cannot assign a variable to history()")
+				}
+				child := &GraphQuery{
+					Args:  make(map[string]string),
+					Alias: alias,
+				}
+				alias = ""
+				it.Prev()
+				if child.Func, err = parseFunction(it, gq); err != nil {
+					return err
+				}
+				if len(child.Func.Args) > 0 || child.Func.Attr == "" || child.Func.Attr == uidFunc {
+					return it.Errorf("Function history expects a single predicate")
+				}
+				child.Attr = child.Func.Attr
+				gq.Children = append(gq.Children, child)
+				curp = nil
+				continue
+			} else if isGraphAlgoFunc(valLower) && peekIt[0].Typ == itemLeftRound {
+				if varName == "" && alias == "" {
+					return it.Errorf("Function %s should be used with a variable or have an alias",
+						valLower)
+				}
+				child := &GraphQuery{
+					Args:       make(map[string]string),
+					Var:        varName,
+					Alias:      alias,
+					IsInternal: true,
+				}
+				varName, alias = "", ""
+				it.Prev()
+				if child.Func, err = parseFunction(it, gq); err != nil {
+					return err
+				}
+				if err := validateGraphAlgoArgs(child.Func); err != nil {
+					return err
+				}
+				child.Attr = child.Func.Attr
+				gq.Children = append(gq.Children, child)
+				curp = nil
+				continue
 			} else if isAggregator(valLower) {
 				child := &GraphQuery{
 					Attr:       valueFunc,
@@ -2789,6 +2893,27 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 					}
 					child.Attr = attr
 					child.IsInternal = false
+				} else if valLower == "countdistinct" && it.Item().Val != valueFunc {
+					// countdistinct of a predicate counts its distinct values over all the
+					// nodes of the block.
+					if gq.IsEmpty {
+						return it.Errorf("Only variables allowed in aggregate functions "+
+							"inside empty blocks. Got: %v", it.Item().Val)
+					}
+					if child.Var != "" {
+						return it.Errorf("Cannot assign a variable to countdistinct of a predicate")
+					}
+					item = it.Item()
+					child.Attr = collectName(it, item.Val)
+					items, err := it.Peek(1)
+					if err == nil && items[0].Typ == itemAt {
+						it.Next() // consume '@'
+						it.Next() // move forward
+						if child.Langs, err = parseLanguageList(it); err != nil {
+							return err
+						}
+					}
+					child.IsInternal = false
 				} else {
 					if it.Item().Val != valueFunc {
 						return it.Errorf("Only variables allowed in aggregate functions. Got: %v",
@@ -2808,6 +2933,11 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 					Name:     valLower,
 					NeedsVar: child.NeedsVar,
 				}
+				if valLower == "percentile" {
+					if err := parsePercentileArg(it, child.Func); err != nil {
+						return err
+					}
+				}
 				it.Next() // Skip the closing ')'
 				gq.Children = append(gq.Children, child)
 				curp = nil
@@ -3062,7 +3192,98 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 }
 
 func isAggregator(fname string) bool {
-	return fname == "min" || fname == "max" || fname == "sum" || fname == "avg"
+	switch fname {
+	case "min", "max", "sum", "avg", "median", "percentile", "stddev", "variance",
+		"countdistinct":
+		return true
+	}
+	return false
+}
+
+// parsePercentileArg parses the fraction given to percentile after the variable or predicate,
+// e.g. the 0.95 of percentile(val(v), 0.95).
+func parsePercentileArg(it *lex.ItemIterator, f *Function) error {
+	it.Next()
+	if item := it.Item(); item.Typ != itemComma {
+		return item.Errorf("Expected a fraction as the second argument of percentile")
+	}
+	it.Next()
+	item := it.Item()
+	if item.Typ != itemName {
+		return item.Errorf("Expected a fraction as the second argument of percentile. Got: %v",
+			item.Val)
+	}
+	fraction, err := strconv.ParseFloat(item.Val, 64)
+	if err != nil || fraction < 0 || fraction > 1 {
+		return item.Errorf("The fraction of percentile should be between 0 and 1. Got: %v",
+			item.Val)
+	}
+	f.Args = append(f.Args, Arg{Value: item.Val})
+	return nil
+}
+
+func isGraphAlgoFunc(name string) bool {
+	return name == "pagerank" || name == "components" || name == "distance"
+}
+
+// validateGraphAlgoArgs checks the arguments of a graph algorithm. pagerank takes an optional
+// number of iterations and damping factor after the predicate, components only the predicate.
+// distance takes a vector, which can be given through a GraphQL variable, after the predicate of
+// the vectors.
+func validateGraphAlgoArgs(f *Function) error {
+	if f.Attr == "" || f.Attr == "uid" || f.IsCount || f.IsValueVar || f.Lang != "" {
+		if f.Name == "distance" {
+			return errors.Errorf("Function distance expects a vector predicate as first argument")
+		}
+		return errors.Errorf("Function %s expects a uid predicate as first argument", f.Name)
+	}
+	for _, arg := range f.Args {
+		if arg.IsValueVar || (arg.IsGraphQLVar && f.Name != "distance") {
+			return errors.Errorf("Function %s only accepts constant arguments", f.Name)
+		}
+	}
+
+	switch f.Name {
+	case "distance":
+		if len(f.Args) != 1 {
+			return errors.Errorf("Function distance expects a predicate and a vector. Got: %d "+
+				"arguments", len(f.Args)+1)
+		}
+	case "components":
+		if len(f.Args) != 0 {
+			return errors.Errorf("Function components expects only a predicate. Got: %d "+
+				"more arguments", len(f.Args))
+		}
+	case "pagerank":
+		if len(f.Args) > 2 {
+			return errors.Errorf("Function pagerank expects at most a predicate, a number of "+
+				"iterations and a damping factor. Got: %d arguments", len(f.Args)+1)
+		}
+		if len(f.Args) > 0 {
+			if n, err := strconv.Atoi(f.Args[0].Value); err != nil || n <= 0 {
+				return errors.Errorf("Number of iterations of pagerank must be a positive "+
+					"integer. Got: %s", f.Args[0].Value)
+			}
+		}
+		if len(f.Args) > 1 {
+			if d, err := strconv.ParseFloat(f.Args[1].Value, 64); err != nil || d <= 0 || d >= 1 {
+				return errors.Errorf("Damping factor of pagerank must be between 0 and 1. "+
+					"Got: %s", f.Args[1].Value)
+			}
+		}
+	}
+	return nil
+}
+
+// isHistoryFunc returns whether the name read by the iterator is the history function, e.g.
+// history(name), rather than a predicate named history with arguments, e.g. history(first: 2).
+func isHistoryFunc(it *lex.ItemIterator) bool {
+	peekIt, err := it.Peek(3)
+	if err != nil {
+		return false
+	}
+	return peekIt[0].Typ == itemLeftRound && peekIt[1].Typ == itemName &&
+		peekIt[2].Typ != itemColon
 }
 
 func isExpandFunc(name string) bool {
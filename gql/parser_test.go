@@ -2689,6 +2689,185 @@ func TestParseCheckPwd(t *testing.T) {
 	require.Equal(t, "password", gq.Query[0].Children[0].Attr)
 }
 
+func TestParseHistory(t *testing.T) {
+	query := `{
+		me(func: uid(1)) {
+			history(name)
+			past: history(friend)
+			history(first: 2)
+		}
+	}`
+	gq, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	children := gq.Query[0].Children
+	require.Len(t, children, 3)
+	require.Equal(t, "name", children[0].Attr)
+	require.True(t, children[0].Func.IsHistory())
+	require.Equal(t, "friend", children[1].Attr)
+	require.Equal(t, "past", children[1].Alias)
+	require.True(t, children[1].Func.IsHistory())
+	// A predicate named history can still be asked for.
+	require.Equal(t, "history", children[2].Attr)
+	require.Nil(t, children[2].Func)
+	require.Equal(t, "2", children[2].Args["first"])
+
+	for _, q := range []string{
+		`{ me(func: uid(1)) { h as history(name) } }`,
+		`{ me(func: uid(1)) { history(name, 2) } }`,
+		`{ me(func: uid(1)) { history(uid) } }`,
+	} {
+		_, err := Parse(Request{Str: q})
+		require.Error(t, err, q)
+	}
+}
+
+func TestParseGraphAlgorithms(t *testing.T) {
+	query := `{
+		me(func: has(follows)) {
+			pr as pagerank(follows, 10, 0.9)
+			cc as components(follows)
+		}
+		top(func: uid(pr), orderdesc: val(pr)) {
+			name
+			val(cc)
+		}
+	}`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	children := res.Query[0].Children
+	require.Len(t, children, 2)
+
+	pr := children[0]
+	require.True(t, pr.IsInternal)
+	require.True(t, pr.Func.IsGraphAlgorithm())
+	require.Equal(t, "pr", pr.Var)
+	require.Equal(t, "follows", pr.Attr)
+	require.Equal(t, "pagerank", pr.Func.Name)
+	require.Equal(t, []Arg{{Value: "10"}, {Value: "0.9"}}, pr.Func.Args)
+
+	cc := children[1]
+	require.Equal(t, "cc", cc.Var)
+	require.Equal(t, "components", cc.Func.Name)
+	require.Equal(t, "follows", cc.Attr)
+}
+
+func TestParseGraphAlgorithmsError(t *testing.T) {
+	tests := map[string]string{
+		"pagerank(follows)":                   "should be used with a variable or have an alias",
+		"pr as pagerank(follows, 0)":          "must be a positive integer",
+		"pr as pagerank(follows, 10, 1.5)":    "must be between 0 and 1",
+		"pr as pagerank(follows, 10, 0.8, 1)": "expects at most",
+		"cc as components(follows, 10)":       "expects only a predicate",
+		"cc as components(uid)":               "expects a uid predicate",
+		"d as distance(uid, \"[1]\")":         "expects a vector predicate",
+		"d as distance(emb)":                  "expects a predicate and a vector",
+	}
+	for fn, msg := range tests {
+		query := "{ me(func: has(follows)) { " + fn + " } }"
+		_, err := Parse(Request{Str: query})
+		require.Error(t, err, fn)
+		require.Contains(t, err.Error(), msg, fn)
+	}
+}
+
+func TestParseFacetIndexFunc(t *testing.T) {
+	query := `{
+		q(func: facet_ge(friend, since, "2019-01-01")) @filter(facet_eq(rated, stars, 5)) {
+			name
+		}
+	}`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	fn := res.Query[0].Func
+	require.Equal(t, "facet_ge", fn.Name)
+	require.Equal(t, "friend", fn.Attr)
+	require.Len(t, fn.Args, 2)
+	require.Equal(t, "since", fn.Args[0].Value)
+	require.Equal(t, "2019-01-01", fn.Args[1].Value)
+
+	filter := res.Query[0].Filter.Func
+	require.Equal(t, "facet_eq", filter.Name)
+	require.Equal(t, "rated", filter.Attr)
+	require.Equal(t, "stars", filter.Args[0].Value)
+	require.Equal(t, "5", filter.Args[1].Value)
+}
+
+func TestParseSimilarTo(t *testing.T) {
+	query := `query test($v: string) {
+		var(func: similar_to(emb, 3, $v)) {
+			d as distance(emb, $v)
+		}
+		q(func: uid(d), orderasc: val(d)) {
+			name
+		}
+	}`
+	res, err := Parse(Request{
+		Str:       query,
+		Variables: map[string]string{"$v": "[0.5, 1]"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "similar_to", res.Query[0].Func.Name)
+	require.Equal(t, "emb", res.Query[0].Func.Attr)
+	require.Equal(t, "3", res.Query[0].Func.Args[0].Value)
+	require.Equal(t, "[0.5, 1]", res.Query[0].Func.Args[1].Value)
+
+	d := res.Query[0].Children[0]
+	require.True(t, d.IsInternal)
+	require.True(t, d.Func.IsGraphAlgorithm())
+	require.Equal(t, "emb", d.Attr)
+	require.Equal(t, "distance", d.Func.Name)
+	require.Len(t, d.Func.Args, 1)
+	require.Equal(t, "[0.5, 1]", d.Func.Args[0].Value)
+}
+
+func TestParsePathFunction(t *testing.T) {
+	query := `{
+		me(func: path(0x1, "follows+/worksAt?/(locatedIn)*", 0x5)) {
+			name
+		}
+	}`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	fn := res.Query[0].Func
+	require.Equal(t, "path", fn.Name)
+	require.Empty(t, fn.Attr)
+	require.Equal(t, []Arg{{Value: "0x1"}, {Value: "follows+/worksAt?/(locatedIn)*"},
+		{Value: "0x5"}}, fn.Args)
+
+	_, err = Parse(Request{Str: `{ me(func: path(0x1, "follows/(worksAt")) { name } }`})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Expected )")
+
+	_, err = Parse(Request{Str: `{ me(func: path(alice, "follows")) { name } }`})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "expects uids")
+
+	_, err = Parse(Request{Str: `{
+		var(func: uid(0x1)) { a as age }
+		me(func: path(val(a), "follows")) { name }
+	}`})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "doesn't support variables")
+}
+
+func TestParsePropertyPath(t *testing.T) {
+	path, err := ParsePropertyPath("follows+/^worksAt?|(locatedIn)*")
+	require.NoError(t, err)
+	require.Equal(t, &PropertyPath{Op: PathAlt, Children: []*PropertyPath{
+		{Op: PathSeq, Children: []*PropertyPath{
+			{Op: PathPlus, Children: []*PropertyPath{{Op: PathPred, Pred: "follows"}}},
+			{Op: PathOpt, Children: []*PropertyPath{{Op: PathPred, Pred: "~worksAt"}}},
+		}},
+		{Op: PathStar, Children: []*PropertyPath{{Op: PathPred, Pred: "locatedIn"}}},
+	}}, path)
+	require.Equal(t, []string{"follows", "worksAt", "locatedIn"}, path.Predicates())
+
+	for _, expr := range []string{"", "follows/", "a||b", "*", "(a", "a)"} {
+		_, err := ParsePropertyPath(expr)
+		require.Error(t, err, expr)
+	}
+}
+
 func TestParseComments(t *testing.T) {
 	query := `
 	# Something
@@ -3978,6 +4157,25 @@ func TestDotsEOF(t *testing.T) {
 	require.Contains(t, err.Error(), "Unclosed action")
 }
 
+func TestMathStringAndDateFunctions(t *testing.T) {
+	query := `{
+			f(func: anyofterms(name, "Rick Michonne Andrea")) {
+				n as name
+				d as dob
+				a: math(concat(upper(substr(n, 0, 3)), "-x"))
+				b: math(datediff(dateadd(d, 2, "month"), truncate(d, "year"), "day"))
+				c: math(len(lower(n)) + year(d))
+			}
+		}`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	children := res.Query[0].Children
+	require.Equal(t, `(concat (upper (substr n 0 3)) "-x")`, children[2].MathExp.debugString())
+	require.Equal(t, `(datediff (dateadd d 2 "month") (truncate d "year") "day")`,
+		children[3].MathExp.debugString())
+	require.Equal(t, "(+ (len (lower n)) (year d))", children[4].MathExp.debugString())
+}
+
 func TestMathWithoutVarAlias(t *testing.T) {
 	query := `{
 			f(func: anyofterms(name, "Rick Michonne Andrea")) {
@@ -4265,6 +4463,99 @@ func TestAggRoot1(t *testing.T) {
 	require.Equal(t, true, gql.Query[1].IsEmpty)
 }
 
+func TestParseStatAggregators(t *testing.T) {
+	query := `
+		{
+			var(func: anyofterms(name, "Rick Michonne Andrea")) {
+				a as age
+			}
+
+			me() {
+				median(val(a))
+				percentile(val(a), 0.95)
+				stddev(val(a))
+				variance(val(a))
+				countdistinct(val(a))
+			}
+
+			groups(func: uid(1)) {
+				friend @groupby(school) {
+					percentile(age, 0.5)
+					countdistinct(name)
+				}
+			}
+		}
+	`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	me := res.Query[1]
+	require.Len(t, me.Children, 5)
+	for i, name := range []string{"median", "percentile", "stddev", "variance", "countdistinct"} {
+		require.Equal(t, name, me.Children[i].Func.Name)
+		require.Equal(t, "a", me.Children[i].NeedsVar[0].Name)
+	}
+	require.Equal(t, []Arg{{Value: "0.95"}}, me.Children[1].Func.Args)
+
+	groupby := res.Query[2].Children[0]
+	require.Equal(t, "age", groupby.Children[0].Attr)
+	require.Equal(t, []Arg{{Value: "0.5"}}, groupby.Children[0].Func.Args)
+	require.Equal(t, "countdistinct", groupby.Children[1].Func.Name)
+}
+
+func TestParseCountDistinctPredicate(t *testing.T) {
+	query := `
+		{
+			me(func: uid(1)) {
+				countdistinct(name@en)
+				friend {
+					ages: countdistinct(age)
+				}
+			}
+		}
+	`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	me := res.Query[0]
+	require.Equal(t, "name", me.Children[0].Attr)
+	require.Equal(t, []string{"en"}, me.Children[0].Langs)
+	require.Equal(t, "countdistinct", me.Children[0].Func.Name)
+	require.False(t, me.Children[0].IsInternal)
+	ages := me.Children[1].Children[0]
+	require.Equal(t, "age", ages.Attr)
+	require.Equal(t, "ages", ages.Alias)
+	require.Empty(t, ages.NeedsVar)
+
+	for _, agg := range []string{"median(age)", "a as countdistinct(age)"} {
+		query = `{ me(func: uid(1)) { ` + agg + ` } }`
+		_, err = Parse(Request{Str: query})
+		require.Error(t, err, agg)
+	}
+	_, err = Parse(Request{Str: `{ me() { countdistinct(age) } }`})
+	require.Error(t, err)
+}
+
+func TestParsePercentileError(t *testing.T) {
+	tests := map[string]string{
+		"percentile(val(a))":      "Expected a fraction",
+		"percentile(val(a), 1.5)": "should be between 0 and 1",
+		"percentile(val(a), abc)": "should be between 0 and 1",
+	}
+	for agg, msg := range tests {
+		query := `
+		{
+			var(func: uid(1)) {
+				a as age
+			}
+			me() {
+				` + agg + `
+			}
+		}`
+		_, err := Parse(Request{Str: query})
+		require.Error(t, err, agg)
+		require.Contains(t, err.Error(), msg, agg)
+	}
+}
+
 func TestAggRootError(t *testing.T) {
 	query := `
 		{
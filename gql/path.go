@@ -0,0 +1,205 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gql
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// PathOp is the operator of a node of a property path expression.
+type PathOp int
+
+const (
+	// PathPred follows an edge of a predicate.
+	PathPred PathOp = iota
+	// PathSeq follows its children one after the other, e.g. follows/worksAt.
+	PathSeq
+	// PathAlt follows any of its children, e.g. follows|knows.
+	PathAlt
+	// PathStar follows its child zero or more times, e.g. follows*.
+	PathStar
+	// PathPlus follows its child one or more times, e.g. follows+.
+	PathPlus
+	// PathOpt follows its child zero or one time, e.g. follows?.
+	PathOpt
+)
+
+// PropertyPath is a regular expression over predicates, used by the path function to find the
+// nodes reachable through a sequence of edges matching it. For example,
+// follows+/worksAt?/(locatedIn)* matches one or more follows edges, followed by an optional
+// worksAt edge and any number of locatedIn edges. The edges of a predicate are followed in
+// reverse with ^pred or ~pred.
+type PropertyPath struct {
+	Op PathOp
+	// Pred is the predicate of a PathPred node. Reverse predicates start with ~.
+	Pred     string
+	Children []*PropertyPath
+}
+
+// Predicates returns the predicates used by the property path, without the ~ of reverse ones.
+func (p *PropertyPath) Predicates() []string {
+	var preds []string
+	seen := make(map[string]bool)
+	var walk func(p *PropertyPath)
+	walk = func(p *PropertyPath) {
+		if p.Op == PathPred {
+			pred := strings.TrimPrefix(p.Pred, "~")
+			if !seen[pred] {
+				seen[pred] = true
+				preds = append(preds, pred)
+			}
+		}
+		for _, c := range p.Children {
+			walk(c)
+		}
+	}
+	walk(p)
+	return preds
+}
+
+type pathParser struct {
+	expr []rune
+	pos  int
+}
+
+// ParsePropertyPath parses a property path expression. Sequences are written with /,
+// alternatives with | and the number of repetitions with the *, + and ? postfix operators.
+// Parentheses group expressions.
+func ParsePropertyPath(expr string) (*PropertyPath, error) {
+	p := &pathParser{expr: []rune(expr)}
+	path, err := p.parseAlt()
+	if err != nil {
+		return nil, errors.Wrapf(err, "while parsing property path %q", expr)
+	}
+	p.skipSpaces()
+	if p.pos < len(p.expr) {
+		return nil, errors.Errorf("Unexpected %q at position %d of property path %q",
+			p.expr[p.pos], p.pos, expr)
+	}
+	return path, nil
+}
+
+func (p *pathParser) skipSpaces() {
+	for p.pos < len(p.expr) && unicode.IsSpace(p.expr[p.pos]) {
+		p.pos++
+	}
+}
+
+// consume skips the next rune if it's r.
+func (p *pathParser) consume(r rune) bool {
+	p.skipSpaces()
+	if p.pos < len(p.expr) && p.expr[p.pos] == r {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *pathParser) parseAlt() (*PropertyPath, error) {
+	return p.parseList(PathAlt, '|', p.parseSeq)
+}
+
+func (p *pathParser) parseSeq() (*PropertyPath, error) {
+	return p.parseList(PathSeq, '/', p.parsePostfix)
+}
+
+// parseList parses the expressions separated by sep, and returns a node of the given operator
+// holding them if there is more than one.
+func (p *pathParser) parseList(op PathOp, sep rune,
+	parse func() (*PropertyPath, error)) (*PropertyPath, error) {
+
+	first, err := parse()
+	if err != nil {
+		return nil, err
+	}
+	node := &PropertyPath{Op: op, Children: []*PropertyPath{first}}
+	for p.consume(sep) {
+		next, err := parse()
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, next)
+	}
+	if len(node.Children) == 1 {
+		return first, nil
+	}
+	return node, nil
+}
+
+func (p *pathParser) parsePostfix() (*PropertyPath, error) {
+	node, err := p.parseAtom()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var op PathOp
+		switch {
+		case p.consume('*'):
+			op = PathStar
+		case p.consume('+'):
+			op = PathPlus
+		case p.consume('?'):
+			op = PathOpt
+		default:
+			return node, nil
+		}
+		node = &PropertyPath{Op: op, Children: []*PropertyPath{node}}
+	}
+}
+
+func (p *pathParser) parseAtom() (*PropertyPath, error) {
+	if p.consume('(') {
+		node, err := p.parseAlt()
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume(')') {
+			return nil, errors.Errorf("Expected ) at position %d", p.pos)
+		}
+		return node, nil
+	}
+
+	reverse := p.consume('^') || p.consume('~')
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.expr) && isPathPredRune(p.expr[p.pos]) {
+		p.pos++
+	}
+	if start == p.pos {
+		if p.pos < len(p.expr) {
+			return nil, errors.Errorf("Expected a predicate at position %d. Got: %q",
+				p.pos, p.expr[p.pos])
+		}
+		return nil, errors.Errorf("Expected a predicate at the end")
+	}
+	pred := string(p.expr[start:p.pos])
+	if reverse {
+		pred = "~" + pred
+	}
+	return &PropertyPath{Op: PathPred, Pred: pred}, nil
+}
+
+func isPathPredRune(r rune) bool {
+	switch r {
+	case '/', '|', '*', '+', '?', '(', ')', '^', '~':
+		return false
+	}
+	return !unicode.IsSpace(r)
+}
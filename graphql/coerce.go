@@ -0,0 +1,311 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// coerceVariables validates the variables sent with the request against the
+// variable definitions of the operation and fills in default values.
+func (s *Schema) coerceVariables(op *Operation,
+	vars map[string]interface{}) (map[string]interface{}, error) {
+
+	res := make(map[string]interface{}, len(op.Variables))
+	for _, def := range op.Variables {
+		t := s.Type(def.Type.NamedType())
+		if t == nil {
+			return nil, errors.Errorf("Unknown type %q of variable $%s", def.Type, def.Name)
+		}
+		if t.Kind != ScalarKind && t.Kind != EnumKind && t.Kind != InputObjectKind {
+			return nil, errors.Errorf("Variable $%s can not be of non-input type %q",
+				def.Name, def.Type)
+		}
+
+		val, ok := vars[def.Name]
+		if !ok && def.Default != nil {
+			var err error
+			if val, err = valueToGo(def.Default, nil); err != nil {
+				return nil, err
+			}
+			ok = true
+		}
+		if !ok {
+			if def.Type.NonNull {
+				return nil, errors.Errorf("Variable $%s of required type %q was not provided",
+					def.Name, def.Type)
+			}
+			continue
+		}
+		coerced, err := s.coerceInput(def.Type, val)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while coercing variable $%s", def.Name)
+		}
+		res[def.Name] = coerced
+	}
+	return res, nil
+}
+
+// coerceArguments validates the arguments of a field or directive against their
+// definitions and returns their values.
+func (s *Schema) coerceArguments(defs []*InputValue, args []*Argument,
+	vars map[string]interface{}, where string) (map[string]interface{}, error) {
+
+	for _, arg := range args {
+		found := false
+		for _, def := range defs {
+			found = found || def.Name == arg.Name
+		}
+		if !found {
+			return nil, errors.Errorf("Unknown argument %q on %s", arg.Name, where)
+		}
+	}
+
+	res := make(map[string]interface{}, len(defs))
+	for _, def := range defs {
+		var val interface{}
+		ok := false
+		for _, arg := range args {
+			if arg.Name != def.Name {
+				continue
+			}
+			if arg.Value.Kind == VariableValue {
+				val, ok = vars[arg.Value.Raw]
+			} else {
+				var err error
+				if val, err = valueToGo(arg.Value, vars); err != nil {
+					return nil, err
+				}
+				ok = true
+			}
+		}
+		if !ok && def.DefaultValue != "" {
+			v, err := parseConstValue(def.DefaultValue)
+			if err != nil {
+				return nil, err
+			}
+			val, ok = v, true
+		}
+		if !ok {
+			if def.Type.NonNull {
+				return nil, errors.Errorf("Argument %q of required type %q was not provided on %s",
+					def.Name, def.Type, where)
+			}
+			continue
+		}
+		coerced, err := s.coerceInput(def.Type, val)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while coercing argument %q on %s", def.Name, where)
+		}
+		res[def.Name] = coerced
+	}
+	return res, nil
+}
+
+// coerceInput checks that the value is valid for the input type and converts scalars
+// to their Go representation: int64 for Int, float64 for Float, bool for Boolean and
+// string for the other scalars and enums.
+func (s *Schema) coerceInput(typ *TypeRef, val interface{}) (interface{}, error) {
+	if val == nil {
+		if typ.NonNull {
+			return nil, errors.Errorf("Expected a value of type %q, got null", typ)
+		}
+		return nil, nil
+	}
+
+	if typ.Elem != nil {
+		vals, ok := val.([]interface{})
+		if !ok {
+			// A single value is accepted where a list is expected.
+			vals = []interface{}{val}
+		}
+		res := make([]interface{}, 0, len(vals))
+		for _, v := range vals {
+			c, err := s.coerceInput(typ.Elem, v)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, c)
+		}
+		return res, nil
+	}
+
+	t := s.Type(typ.Name)
+	switch t.Kind {
+	case InputObjectKind:
+		in, ok := val.(map[string]interface{})
+		if !ok {
+			return nil, errors.Errorf("Expected an object of type %q, got %v", typ.Name, val)
+		}
+		for name := range in {
+			if t.InputField(name) == nil {
+				return nil, errors.Errorf("Unknown field %q in input type %q", name, typ.Name)
+			}
+		}
+		res := make(map[string]interface{}, len(in))
+		for _, f := range t.InputFields {
+			v, ok := in[f.Name]
+			if !ok {
+				if f.Type.NonNull {
+					return nil, errors.Errorf("Field %q of required type %q was not provided",
+						f.Name, f.Type)
+				}
+				continue
+			}
+			c, err := s.coerceInput(f.Type, v)
+			if err != nil {
+				return nil, err
+			}
+			res[f.Name] = c
+		}
+		return res, nil
+	case EnumKind:
+		if str, ok := val.(string); ok {
+			for _, v := range t.EnumValues {
+				if v == str {
+					return str, nil
+				}
+			}
+		}
+		return nil, errors.Errorf("Expected a value of enum %q, got %v", typ.Name, val)
+	}
+	return coerceScalar(typ.Name, val)
+}
+
+func coerceScalar(name string, val interface{}) (interface{}, error) {
+	switch name {
+	case "Int":
+		if f, ok := toFloat(val); ok && f == math.Trunc(f) &&
+			f >= math.MinInt64 && f <= math.MaxInt64 {
+			if n, ok := val.(json.Number); ok {
+				// Avoid losing precision on large integers.
+				if i, err := n.Int64(); err == nil {
+					return i, nil
+				}
+			}
+			return int64(f), nil
+		}
+	case "Float":
+		if f, ok := toFloat(val); ok {
+			return f, nil
+		}
+	case "Boolean":
+		if b, ok := val.(bool); ok {
+			return b, nil
+		}
+	case "ID":
+		switch v := val.(type) {
+		case string:
+			return v, nil
+		case json.Number:
+			return v.String(), nil
+		}
+	case "DateTime":
+		if str, ok := val.(string); ok {
+			if _, err := time.Parse(time.RFC3339Nano, str); err == nil {
+				return str, nil
+			}
+			if _, err := time.Parse("2006-01-02", str); err == nil {
+				return str, nil
+			}
+		}
+	case "Geo":
+		if m, ok := val.(map[string]interface{}); ok {
+			return m, nil
+		}
+	default:
+		if str, ok := val.(string); ok {
+			return str, nil
+		}
+	}
+	return nil, errors.Errorf("Expected a value of type %q, got %v", name, val)
+}
+
+func toFloat(val interface{}) (float64, bool) {
+	switch v := val.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// valueToGo converts a value from the document into the same representation used for
+// JSON variables, substituting the variables it refers to.
+func valueToGo(v *Value, vars map[string]interface{}) (interface{}, error) {
+	switch v.Kind {
+	case VariableValue:
+		return vars[v.Raw], nil
+	case IntValue, FloatValue:
+		return json.Number(v.Raw), nil
+	case StringValue, EnumValue:
+		return v.Raw, nil
+	case BooleanValue:
+		return v.Raw == "true", nil
+	case NullValue:
+		return nil, nil
+	case ListValue:
+		res := make([]interface{}, 0, len(v.List))
+		for _, elem := range v.List {
+			val, err := valueToGo(elem, vars)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, val)
+		}
+		return res, nil
+	case ObjectValue:
+		res := make(map[string]interface{}, len(v.Fields))
+		for _, f := range v.Fields {
+			if f.Value.Kind == VariableValue {
+				// Fields whose variable wasn't provided are left out, as if they
+				// weren't part of the object.
+				val, ok := vars[f.Value.Raw]
+				if ok {
+					res[f.Name] = val
+				}
+				continue
+			}
+			val, err := valueToGo(f.Value, vars)
+			if err != nil {
+				return nil, err
+			}
+			res[f.Name] = val
+		}
+		return res, nil
+	}
+	return nil, errors.Errorf("Invalid value %q", v.Raw)
+}
+
+// parseConstValue parses the default value of an argument.
+func parseConstValue(s string) (interface{}, error) {
+	if _, err := strconv.ParseBool(s); err == nil {
+		return s == "true", nil
+	}
+	return json.Number(s), nil
+}
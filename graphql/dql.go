@@ -0,0 +1,330 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/pkg/errors"
+)
+
+// upsertVar is the variable holding the nodes matched by update and delete mutations.
+const upsertVar = "x"
+
+// upsertQuery returns the query block of the upsert that finds the nodes to update
+// or delete. The matched uids are returned by the block and stored in upsertVar.
+func (r *resolver) upsertQuery(obj *Type, filter map[string]interface{}) (
+	*gql.GraphQuery, error) {
+
+	q := &gql.GraphQuery{
+		Alias:    "nodes",
+		Attr:     "nodes",
+		Func:     &gql.Function{Name: "type", Args: []gql.Arg{{Value: obj.dgraphType}}},
+		Children: []*gql.GraphQuery{{Attr: "uid", Var: upsertVar}},
+	}
+	tree, err := r.schema.buildFilter(obj, filter)
+	if err != nil {
+		return nil, err
+	}
+	q.Filter = tree
+	return q, nil
+}
+
+func typeFilter(obj *Type) *gql.FilterTree {
+	return &gql.FilterTree{
+		Func: &gql.Function{Name: "type", Args: []gql.Arg{{Value: obj.dgraphType}}},
+	}
+}
+
+func orderBy(obj *Type, field string, desc bool) *pb.Order {
+	return &pb.Order{Attr: obj.Field(field).predicate, Desc: desc}
+}
+
+func parseUid(s string) (uint64, error) {
+	uid, err := strconv.ParseUint(s, 0, 64)
+	if err != nil || uid == 0 {
+		return 0, errors.Errorf("Invalid uid %q", s)
+	}
+	return uid, nil
+}
+
+// buildFilter converts the value of a filter argument into a filter tree. All the
+// conditions in the filter must hold.
+func (s *Schema) buildFilter(obj *Type, filter map[string]interface{}) (*gql.FilterTree, error) {
+	var conds []*gql.FilterTree
+	for _, name := range sortedKeys(filter) {
+		val := filter[name]
+		if val == nil {
+			continue
+		}
+		switch name {
+		case "uid":
+			fn := &gql.Function{Name: "uid"}
+			for _, v := range val.([]interface{}) {
+				uid, err := parseUid(v.(string))
+				if err != nil {
+					return nil, err
+				}
+				fn.UID = append(fn.UID, uid)
+			}
+			conds = append(conds, &gql.FilterTree{Func: fn})
+		case "has":
+			for _, v := range val.([]interface{}) {
+				fn := &gql.Function{Name: "has", Attr: obj.Field(v.(string)).predicate}
+				conds = append(conds, &gql.FilterTree{Func: fn})
+			}
+		case "and", "or":
+			tree := &gql.FilterTree{Op: name}
+			for _, v := range val.([]interface{}) {
+				child, err := s.buildFilter(obj, v.(map[string]interface{}))
+				if err != nil {
+					return nil, err
+				}
+				if child != nil {
+					tree.Child = append(tree.Child, child)
+				}
+			}
+			conds = append(conds, simplify(tree))
+		case "not":
+			child, err := s.buildFilter(obj, val.(map[string]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			if child != nil {
+				conds = append(conds, &gql.FilterTree{Op: "not", Child: []*gql.FilterTree{child}})
+			}
+		default:
+			pred := s.Type(obj.Name + "Filter").InputField(name).predicate
+			fns := val.(map[string]interface{})
+			for _, fnName := range sortedKeys(fns) {
+				fn, err := filterFunction(fnName, pred, fns[fnName])
+				if err != nil {
+					return nil, err
+				}
+				if fn != nil {
+					conds = append(conds, &gql.FilterTree{Func: fn})
+				}
+			}
+		}
+	}
+
+	var res []*gql.FilterTree
+	for _, c := range conds {
+		if c != nil {
+			res = append(res, c)
+		}
+	}
+	return simplify(&gql.FilterTree{Op: "and", Child: res}), nil
+}
+
+// simplify removes the and/or nodes with less than two children.
+func simplify(tree *gql.FilterTree) *gql.FilterTree {
+	switch len(tree.Child) {
+	case 0:
+		return nil
+	case 1:
+		return tree.Child[0]
+	}
+	return tree
+}
+
+func filterFunction(name, pred string, val interface{}) (*gql.Function, error) {
+	if val == nil {
+		return nil, nil
+	}
+	fn := &gql.Function{Name: name, Attr: pred}
+	if name == "in" {
+		fn.Name = "eq"
+		vals := val.([]interface{})
+		if len(vals) == 0 {
+			return nil, errors.Errorf("Filter \"in\" on %s requires at least one value", pred)
+		}
+		for _, v := range vals {
+			fn.Args = append(fn.Args, gql.Arg{Value: fmt.Sprint(v)})
+		}
+		return fn, nil
+	}
+	if name == "regexp" {
+		arg, err := regexpArg(pred, fmt.Sprint(val))
+		if err != nil {
+			return nil, err
+		}
+		fn.Args = []gql.Arg{{Value: arg}}
+		return fn, nil
+	}
+	fn.Args = []gql.Arg{{Value: fmt.Sprint(val)}}
+	return fn, nil
+}
+
+// regexpArg checks that the value of a regexp filter on pred has the form /pattern/flags,
+// and returns it with the slashes of the pattern escaped, so that it can be written as is
+// in a query.
+func regexpArg(pred, val string) (string, error) {
+	end := strings.LastIndex(val, "/")
+	if !strings.HasPrefix(val, "/") || end == 0 {
+		return "", errors.Errorf("Filter \"regexp\" on %s requires a value of the form "+
+			"/pattern/flags, got: %q", pred, val)
+	}
+	flags := val[end+1:]
+	for _, r := range flags {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return "", errors.Errorf("Invalid flags %q of regexp filter on %s", flags, pred)
+		}
+	}
+
+	var sb strings.Builder
+	pattern := val[1:end]
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			// The escaped character is kept as is, slashes included.
+			if i+1 == len(pattern) {
+				return "", errors.Errorf("Invalid regexp %q of filter on %s: trailing backslash",
+					val, pred)
+			}
+			sb.WriteString(pattern[i : i+2])
+			i++
+		case '/':
+			sb.WriteString(`\/`)
+		default:
+			sb.WriteByte(pattern[i])
+		}
+	}
+	escaped := sb.String()
+	if _, err := regexp.Compile(strings.Replace(escaped, `\/`, "/", -1)); err != nil {
+		return "", errors.Wrapf(err, "Invalid regexp %q of filter on %s", val, pred)
+	}
+	return "/" + escaped + "/" + flags, nil
+}
+
+// asString returns the GraphQL+- query made of the given query blocks.
+func asString(queries []*gql.GraphQuery) string {
+	var sb strings.Builder
+	sb.WriteString("{\n")
+	for _, q := range queries {
+		writeQuery(&sb, q, "\t", true)
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func writeQuery(sb *strings.Builder, q *gql.GraphQuery, indent string, root bool) {
+	sb.WriteString(indent)
+	if q.Var != "" {
+		sb.WriteString(q.Var + " as ")
+	}
+	if q.Alias != "" && (root || q.Alias != q.Attr) {
+		sb.WriteString(q.Alias)
+		if !root {
+			sb.WriteString(" : ")
+		}
+	}
+	if !root {
+		sb.WriteString(predicate(q.Attr))
+	}
+
+	var args []string
+	if q.Func != nil {
+		args = append(args, "func: "+function(q.Func))
+	}
+	for _, o := range q.Order {
+		dir := "orderasc"
+		if o.Desc {
+			dir = "orderdesc"
+		}
+		args = append(args, dir+": "+predicate(o.Attr))
+	}
+	keys := make([]string, 0, len(q.Args))
+	for k := range q.Args {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		args = append(args, k+": "+q.Args[k])
+	}
+	if len(args) > 0 {
+		sb.WriteString("(" + strings.Join(args, ", ") + ")")
+	}
+	if q.Filter != nil {
+		sb.WriteString(" @filter(" + filter(q.Filter) + ")")
+	}
+
+	if len(q.Children) > 0 {
+		sb.WriteString(" {\n")
+		for _, child := range q.Children {
+			writeQuery(sb, child, indent+"\t", false)
+		}
+		sb.WriteString(indent + "}")
+	}
+	sb.WriteString("\n")
+}
+
+func filter(tree *gql.FilterTree) string {
+	if tree.Func != nil {
+		return function(tree.Func)
+	}
+	if tree.Op == "not" {
+		return "NOT (" + filter(tree.Child[0]) + ")"
+	}
+	parts := make([]string, 0, len(tree.Child))
+	for _, child := range tree.Child {
+		parts = append(parts, "("+filter(child)+")")
+	}
+	return strings.Join(parts, " "+strings.ToUpper(tree.Op)+" ")
+}
+
+func function(fn *gql.Function) string {
+	var args []string
+	if fn.Attr != "" {
+		args = append(args, predicate(fn.Attr))
+	}
+	switch {
+	case fn.Name == "uid":
+		for _, uid := range fn.UID {
+			args = append(args, fmt.Sprintf("%#x", uid))
+		}
+	case fn.Name == "regexp":
+		// Regular expressions are passed as /pattern/flags, escaped by regexpArg.
+		args = append(args, fn.Args[0].Value)
+	case len(fn.Args) == 1:
+		args = append(args, strconv.Quote(fn.Args[0].Value))
+	case len(fn.Args) > 1:
+		vals := make([]string, 0, len(fn.Args))
+		for _, arg := range fn.Args {
+			vals = append(vals, strconv.Quote(arg.Value))
+		}
+		args = append(args, "["+strings.Join(vals, ", ")+"]")
+	}
+	return fn.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+// predicate returns the name of the predicate as it should be written in a query,
+// wrapping it in angle brackets when it isn't a plain name.
+func predicate(name string) string {
+	for i, r := range name {
+		if !isNameSuffix(r) && (r != '.' || i == 0) {
+			return "<" + name + ">"
+		}
+	}
+	return name
+}
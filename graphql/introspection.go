@@ -0,0 +1,375 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+// directive is a directive supported by the server as reported by introspection.
+type directive struct {
+	name        string
+	description string
+	locations   []string
+	args        []*InputValue
+}
+
+// enumValue is a value of an enum type as reported by introspection.
+type enumValue string
+
+var builtinDirectives = []*directive{
+	{
+		name:        "skip",
+		description: "Directs the executor to skip this field or fragment when the `if` argument is true.",
+		locations:   []string{"FIELD", "FRAGMENT_SPREAD", "INLINE_FRAGMENT"},
+		args:        []*InputValue{{Name: "if", Type: nonNull(named("Boolean"))}},
+	},
+	{
+		name:        "include",
+		description: "Directs the executor to include this field or fragment only when the `if` argument is true.",
+		locations:   []string{"FIELD", "FRAGMENT_SPREAD", "INLINE_FRAGMENT"},
+		args:        []*InputValue{{Name: "if", Type: nonNull(named("Boolean"))}},
+	},
+}
+
+// Meta-fields that can be queried on the Query type.
+var (
+	schemaMetaField = &FieldDefinition{Name: "__schema", Type: nonNull(named("__Schema"))}
+	typeMetaField   = &FieldDefinition{
+		Name: "__type",
+		Args: []*InputValue{{Name: "name", Type: nonNull(named("String"))}},
+		Type: named("__Type"),
+	}
+	typenameMetaField = &FieldDefinition{Name: "__typename", Type: nonNull(named("String"))}
+)
+
+// addIntrospectionTypes adds the types used to query the schema itself.
+func (s *Schema) addIntrospectionTypes() {
+	includeDeprecated := []*InputValue{
+		{Name: "includeDeprecated", Type: named("Boolean"), DefaultValue: "false"},
+	}
+	types := []*Type{
+		{
+			Kind: ObjectKind,
+			Name: "__Schema",
+			Fields: []*FieldDefinition{
+				{Name: "types", Type: nonNull(list(nonNull(named("__Type"))))},
+				{Name: "queryType", Type: nonNull(named("__Type"))},
+				{Name: "mutationType", Type: named("__Type")},
+				{Name: "subscriptionType", Type: named("__Type")},
+				{Name: "directives", Type: nonNull(list(nonNull(named("__Directive"))))},
+			},
+		},
+		{
+			Kind: ObjectKind,
+			Name: "__Type",
+			Fields: []*FieldDefinition{
+				{Name: "kind", Type: nonNull(named("__TypeKind"))},
+				{Name: "name", Type: named("String")},
+				{Name: "description", Type: named("String")},
+				{Name: "fields", Args: includeDeprecated,
+					Type: list(nonNull(named("__Field")))},
+				{Name: "interfaces", Type: list(nonNull(named("__Type")))},
+				{Name: "possibleTypes", Type: list(nonNull(named("__Type")))},
+				{Name: "enumValues", Args: includeDeprecated,
+					Type: list(nonNull(named("__EnumValue")))},
+				{Name: "inputFields", Type: list(nonNull(named("__InputValue")))},
+				{Name: "ofType", Type: named("__Type")},
+			},
+		},
+		{
+			Kind: ObjectKind,
+			Name: "__Field",
+			Fields: []*FieldDefinition{
+				{Name: "name", Type: nonNull(named("String"))},
+				{Name: "description", Type: named("String")},
+				{Name: "args", Type: nonNull(list(nonNull(named("__InputValue"))))},
+				{Name: "type", Type: nonNull(named("__Type"))},
+				{Name: "isDeprecated", Type: nonNull(named("Boolean"))},
+				{Name: "deprecationReason", Type: named("String")},
+			},
+		},
+		{
+			Kind: ObjectKind,
+			Name: "__InputValue",
+			Fields: []*FieldDefinition{
+				{Name: "name", Type: nonNull(named("String"))},
+				{Name: "description", Type: named("String")},
+				{Name: "type", Type: nonNull(named("__Type"))},
+				{Name: "defaultValue", Type: named("String")},
+			},
+		},
+		{
+			Kind: ObjectKind,
+			Name: "__EnumValue",
+			Fields: []*FieldDefinition{
+				{Name: "name", Type: nonNull(named("String"))},
+				{Name: "description", Type: named("String")},
+				{Name: "isDeprecated", Type: nonNull(named("Boolean"))},
+				{Name: "deprecationReason", Type: named("String")},
+			},
+		},
+		{
+			Kind: ObjectKind,
+			Name: "__Directive",
+			Fields: []*FieldDefinition{
+				{Name: "name", Type: nonNull(named("String"))},
+				{Name: "description", Type: named("String")},
+				{Name: "locations", Type: nonNull(list(nonNull(named("__DirectiveLocation"))))},
+				{Name: "args", Type: nonNull(list(nonNull(named("__InputValue"))))},
+			},
+		},
+		{
+			Kind: EnumKind,
+			Name: "__TypeKind",
+			EnumValues: []string{"SCALAR", "OBJECT", "INTERFACE", "UNION", "ENUM",
+				"INPUT_OBJECT", "LIST", "NON_NULL"},
+		},
+		{
+			Kind: EnumKind,
+			Name: "__DirectiveLocation",
+			EnumValues: []string{"QUERY", "MUTATION", "SUBSCRIPTION", "FIELD",
+				"FRAGMENT_DEFINITION", "FRAGMENT_SPREAD", "INLINE_FRAGMENT", "SCHEMA", "SCALAR",
+				"OBJECT", "FIELD_DEFINITION", "ARGUMENT_DEFINITION", "INTERFACE", "UNION", "ENUM",
+				"ENUM_VALUE", "INPUT_OBJECT", "INPUT_FIELD_DEFINITION"},
+		},
+	}
+	for _, t := range types {
+		s.types[t.Name] = t
+	}
+}
+
+// introspect resolves the selections on an introspection object. The object is
+// one of *Schema, *TypeRef (for __Type), *FieldDefinition, *InputValue, enumValue
+// or *directive.
+func (s *Schema) introspect(sels []*selection, obj interface{}) orderedMap {
+	out := make(orderedMap, 0, len(sels))
+	for _, sel := range sels {
+		if out.has(sel.key) {
+			continue
+		}
+		var val interface{}
+		if sel.def == typenameMetaField {
+			val = introspectionTypeName(obj)
+		} else {
+			val = s.complete(sel, s.introspectionField(obj, sel))
+		}
+		out = append(out, keyValue{sel.key, val})
+	}
+	return out
+}
+
+// complete resolves the selections on the value of an introspection field, which
+// can be a list of objects, an object or a scalar.
+func (s *Schema) complete(sel *selection, val interface{}) interface{} {
+	if len(sel.children) == 0 || val == nil {
+		return val
+	}
+	if vals, ok := val.([]interface{}); ok {
+		res := make([]interface{}, 0, len(vals))
+		for _, v := range vals {
+			res = append(res, s.introspect(sel.children, v))
+		}
+		return res
+	}
+	return s.introspect(sel.children, val)
+}
+
+func introspectionTypeName(obj interface{}) string {
+	switch obj.(type) {
+	case *Schema:
+		return "__Schema"
+	case *TypeRef:
+		return "__Type"
+	case *FieldDefinition:
+		return "__Field"
+	case *InputValue:
+		return "__InputValue"
+	case enumValue:
+		return "__EnumValue"
+	case *directive:
+		return "__Directive"
+	}
+	return ""
+}
+
+func (s *Schema) introspectionField(obj interface{}, sel *selection) interface{} {
+	switch o := obj.(type) {
+	case *Schema:
+		switch sel.def.Name {
+		case "types":
+			var types []interface{}
+			for _, name := range s.typeNames() {
+				if s.types[name] == s.mutation && len(s.mutation.Fields) == 0 {
+					continue
+				}
+				types = append(types, named(name))
+			}
+			return types
+		case "queryType":
+			return named(s.query.Name)
+		case "mutationType":
+			if len(s.mutation.Fields) == 0 {
+				return nil
+			}
+			return named(s.mutation.Name)
+		case "directives":
+			var dirs []interface{}
+			for _, d := range builtinDirectives {
+				dirs = append(dirs, d)
+			}
+			return dirs
+		}
+	case *TypeRef:
+		return s.typeField(o, sel)
+	case *FieldDefinition:
+		switch sel.def.Name {
+		case "name":
+			return o.Name
+		case "description":
+			return nullable(o.Description)
+		case "args":
+			return inputValues(o.Args)
+		case "type":
+			return o.Type
+		case "isDeprecated":
+			return false
+		}
+	case *InputValue:
+		switch sel.def.Name {
+		case "name":
+			return o.Name
+		case "description":
+			return nullable(o.Description)
+		case "type":
+			return o.Type
+		case "defaultValue":
+			return nullable(o.DefaultValue)
+		}
+	case enumValue:
+		switch sel.def.Name {
+		case "name":
+			return string(o)
+		case "isDeprecated":
+			return false
+		}
+	case *directive:
+		switch sel.def.Name {
+		case "name":
+			return o.name
+		case "description":
+			return nullable(o.description)
+		case "locations":
+			var locs []interface{}
+			for _, loc := range o.locations {
+				locs = append(locs, loc)
+			}
+			return locs
+		case "args":
+			return inputValues(o.args)
+		}
+	}
+	return nil
+}
+
+// typeField resolves a field of __Type. Types wrapped as lists or non-null types
+// are unwrapped one level at a time through ofType.
+func (s *Schema) typeField(ref *TypeRef, sel *selection) interface{} {
+	if ref.NonNull || ref.Elem != nil {
+		switch sel.def.Name {
+		case "kind":
+			if ref.NonNull {
+				return string(NonNullKind)
+			}
+			return string(ListKind)
+		case "ofType":
+			if ref.NonNull {
+				c := *ref
+				c.NonNull = false
+				return &c
+			}
+			return ref.Elem
+		}
+		return nil
+	}
+
+	t := s.types[ref.Name]
+	if t == nil {
+		return nil
+	}
+	switch sel.def.Name {
+	case "kind":
+		return string(t.Kind)
+	case "name":
+		return t.Name
+	case "description":
+		return nullable(t.Description)
+	case "fields":
+		if t.Kind != ObjectKind && t.Kind != InterfaceKind {
+			return nil
+		}
+		fields := make([]interface{}, 0, len(t.Fields))
+		for _, f := range t.Fields {
+			fields = append(fields, f)
+		}
+		return fields
+	case "interfaces":
+		if t.Kind != ObjectKind {
+			return nil
+		}
+		ifaces := make([]interface{}, 0, len(t.Interfaces))
+		for _, name := range t.Interfaces {
+			ifaces = append(ifaces, named(name))
+		}
+		return ifaces
+	case "possibleTypes":
+		if t.Kind != InterfaceKind && t.Kind != UnionKind {
+			return nil
+		}
+		types := make([]interface{}, 0, len(t.PossibleTypes))
+		for _, name := range t.PossibleTypes {
+			types = append(types, named(name))
+		}
+		return types
+	case "enumValues":
+		if t.Kind != EnumKind {
+			return nil
+		}
+		vals := make([]interface{}, 0, len(t.EnumValues))
+		for _, v := range t.EnumValues {
+			vals = append(vals, enumValue(v))
+		}
+		return vals
+	case "inputFields":
+		if t.Kind != InputObjectKind {
+			return nil
+		}
+		return inputValues(t.InputFields)
+	}
+	return nil
+}
+
+func inputValues(vals []*InputValue) []interface{} {
+	res := make([]interface{}, 0, len(vals))
+	for _, v := range vals {
+		res = append(res, v)
+	}
+	return res
+}
+
+func nullable(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
@@ -0,0 +1,231 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"github.com/dgraph-io/dgraph/lex"
+)
+
+// Constants representing type of different GraphQL lexed items.
+const (
+	itemName            lex.ItemType = 5 + iota // names of fields, types, arguments etc.
+	itemInt                                     // integer literal
+	itemFloat                                   // float literal
+	itemString                                  // quoted string literal
+	itemBlockString                             // triple quoted string literal
+	itemLeftCurl                                // left curly bracket
+	itemRightCurl                               // right curly bracket
+	itemLeftRound                               // left round bracket
+	itemRightRound                              // right round bracket
+	itemLeftSquare                              // left square bracket
+	itemRightSquare                             // right square bracket
+	itemColon                                   // colon
+	itemDollar                                  // dollar sign used by variables
+	itemAt                                      // @ used by directives
+	itemEqual                                   // equal sign used by default values
+	itemExclamationMark                         // non-null marker
+	itemSpread                                  // ... used by fragments
+)
+
+// lexTop lexes a GraphQL document as defined by the GraphQL spec. Commas and
+// white space are insignificant and are skipped.
+func lexTop(l *lex.Lexer) lex.StateFn {
+	for {
+		switch r := l.Next(); {
+		case r == lex.EOF:
+			l.Emit(lex.ItemEOF)
+			return nil
+		case isIgnored(r):
+			l.Ignore()
+		case r == '#':
+			return lexComment
+		case isNameBegin(r):
+			return lexName
+		case r == '-' || isDigit(r):
+			l.Backup()
+			return lexNumber
+		case r == '"':
+			return lexString
+		case r == '.':
+			if !isDot(l.Next()) || !isDot(l.Next()) {
+				return l.Errorf("Unexpected '.', did you mean '...'?")
+			}
+			l.Emit(itemSpread)
+		case r == '{':
+			l.Emit(itemLeftCurl)
+		case r == '}':
+			l.Emit(itemRightCurl)
+		case r == '(':
+			l.Emit(itemLeftRound)
+		case r == ')':
+			l.Emit(itemRightRound)
+		case r == '[':
+			l.Emit(itemLeftSquare)
+		case r == ']':
+			l.Emit(itemRightSquare)
+		case r == ':':
+			l.Emit(itemColon)
+		case r == '$':
+			l.Emit(itemDollar)
+		case r == '@':
+			l.Emit(itemAt)
+		case r == '=':
+			l.Emit(itemEqual)
+		case r == '!':
+			l.Emit(itemExclamationMark)
+		default:
+			return l.Errorf("Unexpected character: %q", r)
+		}
+	}
+}
+
+func lexComment(l *lex.Lexer) lex.StateFn {
+	l.AcceptUntil(lex.IsEndOfLine)
+	l.Ignore()
+	return lexTop
+}
+
+func lexName(l *lex.Lexer) lex.StateFn {
+	l.AcceptRun(isNameSuffix)
+	l.Emit(itemName)
+	return lexTop
+}
+
+// lexNumber lexes IntValue and FloatValue tokens.
+func lexNumber(l *lex.Lexer) lex.StateFn {
+	if isMinus(l.Peek()) {
+		l.Next()
+	}
+	if _, ok := l.AcceptRun(isDigit); !ok {
+		return l.Errorf("Invalid number, expected a digit")
+	}
+	typ := itemInt
+	if l.Peek() == '.' {
+		l.Next()
+		if _, ok := l.AcceptRun(isDigit); !ok {
+			return l.Errorf("Invalid number, expected a digit after '.'")
+		}
+		typ = itemFloat
+	}
+	if r := l.Peek(); r == 'e' || r == 'E' {
+		l.Next()
+		if isSign(l.Peek()) {
+			l.Next()
+		}
+		if _, ok := l.AcceptRun(isDigit); !ok {
+			return l.Errorf("Invalid number, expected a digit in exponent")
+		}
+		typ = itemFloat
+	}
+	if r := l.Peek(); isNameBegin(r) || r == '.' {
+		return l.Errorf("Invalid number, unexpected %q", r)
+	}
+	l.Emit(typ)
+	return lexTop
+}
+
+// lexString lexes both regular and block strings. The opening quote has already
+// been consumed.
+func lexString(l *lex.Lexer) lex.StateFn {
+	if l.Peek() == '"' {
+		l.Next()
+		if l.Peek() == '"' {
+			l.Next()
+			return lexBlockString
+		}
+		// This is just an empty string.
+		l.Emit(itemString)
+		return lexTop
+	}
+	for {
+		switch r := l.Next(); {
+		case r == lex.EOF || lex.IsEndOfLine(r):
+			return l.Errorf("Unterminated string")
+		case r == '\\':
+			if r := l.Next(); !isEscChar(r) {
+				return l.Errorf("Invalid escape character: %q", r)
+			}
+		case r == '"':
+			l.Emit(itemString)
+			return lexTop
+		}
+	}
+}
+
+func lexBlockString(l *lex.Lexer) lex.StateFn {
+	for {
+		r := l.Next()
+		switch {
+		case r == lex.EOF:
+			return l.Errorf("Unterminated block string")
+		case r == '\\':
+			// Only \""" is an escape sequence inside block strings.
+			acceptQuotes(l, 3)
+		case r == '"' && acceptQuotes(l, 2) == 2:
+			l.Emit(itemBlockString)
+			return lexTop
+		}
+	}
+}
+
+// acceptQuotes consumes up to n quotes and returns how many were consumed. Unlike
+// AcceptRunTimes, it doesn't give back the last quote when all n are found.
+func acceptQuotes(l *lex.Lexer, n int) int {
+	i := 0
+	for ; i < n && l.Peek() == '"'; i++ {
+		l.Next()
+	}
+	return i
+}
+
+// isIgnored returns true for runes which carry no meaning in GraphQL documents.
+func isIgnored(r rune) bool {
+	return r == ' ' || r == '\t' || r == ',' || r == '\uFEFF' || lex.IsEndOfLine(r)
+}
+
+func isNameBegin(r rune) bool {
+	return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isNameSuffix(r rune) bool {
+	return isNameBegin(r) || isDigit(r)
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isDot(r rune) bool {
+	return r == '.'
+}
+
+// isEscChar returns true for the characters allowed after a backslash in a string.
+func isEscChar(r rune) bool {
+	switch r {
+	case '"', '\\', '/', 'b', 'f', 'n', 'r', 't', 'u':
+		return true
+	}
+	return false
+}
+
+func isMinus(r rune) bool {
+	return r == '-'
+}
+
+func isSign(r rune) bool {
+	return r == '-' || r == '+'
+}
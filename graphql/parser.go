@@ -0,0 +1,682 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dgraph-io/dgraph/lex"
+	"github.com/pkg/errors"
+)
+
+// OperationType is the type of a GraphQL operation.
+type OperationType string
+
+// The operation types defined by the GraphQL spec.
+const (
+	QueryOperation        OperationType = "query"
+	MutationOperation     OperationType = "mutation"
+	SubscriptionOperation OperationType = "subscription"
+)
+
+// Document is a parsed GraphQL request document.
+type Document struct {
+	Operations []*Operation
+	Fragments  map[string]*Fragment
+}
+
+// Operation is a query or a mutation in a GraphQL document.
+type Operation struct {
+	Type         OperationType
+	Name         string
+	Variables    []*VariableDefinition
+	Directives   []*Directive
+	SelectionSet []*Selection
+}
+
+// VariableDefinition declares a variable used by an operation.
+type VariableDefinition struct {
+	Name    string
+	Type    *TypeRef
+	Default *Value
+}
+
+// Fragment is a named fragment definition.
+type Fragment struct {
+	Name          string
+	TypeCondition string
+	Directives    []*Directive
+	SelectionSet  []*Selection
+}
+
+// Selection is a single entry in a selection set. Exactly one of Field, Spread
+// and Inline is set.
+type Selection struct {
+	Field  *Field
+	Spread *FragmentSpread
+	Inline *InlineFragment
+}
+
+// Field is a field selected in a selection set.
+type Field struct {
+	Alias        string
+	Name         string
+	Arguments    []*Argument
+	Directives   []*Directive
+	SelectionSet []*Selection
+}
+
+// ResponseKey returns the key under which the field is returned in the response.
+func (f *Field) ResponseKey() string {
+	if f.Alias != "" {
+		return f.Alias
+	}
+	return f.Name
+}
+
+// Argument returns the value of the argument with the given name or nil.
+func (f *Field) Argument(name string) *Value {
+	for _, arg := range f.Arguments {
+		if arg.Name == name {
+			return arg.Value
+		}
+	}
+	return nil
+}
+
+// FragmentSpread is a reference to a named fragment.
+type FragmentSpread struct {
+	Name       string
+	Directives []*Directive
+}
+
+// InlineFragment is a fragment defined in place, optionally with a type condition.
+type InlineFragment struct {
+	TypeCondition string
+	Directives    []*Directive
+	SelectionSet  []*Selection
+}
+
+// Argument is a named value passed to a field or directive. It's also used to
+// hold the fields of an input object value.
+type Argument struct {
+	Name  string
+	Value *Value
+}
+
+// Directive is a directive such as @skip or @include.
+type Directive struct {
+	Name      string
+	Arguments []*Argument
+}
+
+// ValueKind is the kind of a GraphQL input value.
+type ValueKind int
+
+// The kinds of input values defined by the GraphQL spec.
+const (
+	VariableValue ValueKind = iota
+	IntValue
+	FloatValue
+	StringValue
+	BooleanValue
+	NullValue
+	EnumValue
+	ListValue
+	ObjectValue
+)
+
+// Value is a literal or a variable used as input in a GraphQL document.
+type Value struct {
+	Kind ValueKind
+	// Raw holds the variable name, the enum value or the literal for scalar values.
+	// Strings are stored unquoted.
+	Raw    string
+	List   []*Value
+	Fields []*Argument
+}
+
+// Field returns the value of the given field of an object value or nil.
+func (v *Value) Field(name string) *Value {
+	for _, f := range v.Fields {
+		if f.Name == name {
+			return f.Value
+		}
+	}
+	return nil
+}
+
+// TypeRef is a reference to a named type, possibly wrapped as a list and/or
+// non-null type. Elem is set for list types.
+type TypeRef struct {
+	Name    string
+	Elem    *TypeRef
+	NonNull bool
+}
+
+func (t *TypeRef) String() string {
+	var s string
+	if t.Elem != nil {
+		s = "[" + t.Elem.String() + "]"
+	} else {
+		s = t.Name
+	}
+	if t.NonNull {
+		s += "!"
+	}
+	return s
+}
+
+// NamedType returns the name of the type after removing all the list and non-null wrappers.
+func (t *TypeRef) NamedType() string {
+	if t.Elem != nil {
+		return t.Elem.NamedType()
+	}
+	return t.Name
+}
+
+type parser struct {
+	it *lex.ItemIterator
+}
+
+// Parse parses the given GraphQL request document.
+func Parse(input string) (*Document, error) {
+	l := &lex.Lexer{}
+	l.Reset(input)
+	l.Run(lexTop)
+	if err := l.ValidateResult(); err != nil {
+		return nil, err
+	}
+
+	p := &parser{it: l.NewIterator()}
+	doc := &Document{Fragments: make(map[string]*Fragment)}
+	for p.peek().Typ != lex.ItemEOF {
+		if p.peek().Typ == itemLeftCurl {
+			// Query shorthand.
+			sels, err := p.parseSelectionSet()
+			if err != nil {
+				return nil, err
+			}
+			doc.Operations = append(doc.Operations,
+				&Operation{Type: QueryOperation, SelectionSet: sels})
+			continue
+		}
+
+		item, err := p.expect(itemName, "an operation or a fragment")
+		if err != nil {
+			return nil, err
+		}
+		switch item.Val {
+		case "query", "mutation", "subscription":
+			op, err := p.parseOperation(OperationType(item.Val))
+			if err != nil {
+				return nil, err
+			}
+			doc.Operations = append(doc.Operations, op)
+		case "fragment":
+			frag, err := p.parseFragment()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := doc.Fragments[frag.Name]; ok {
+				return nil, item.Errorf("There can be only one fragment named %q", frag.Name)
+			}
+			doc.Fragments[frag.Name] = frag
+		default:
+			return nil, item.Errorf("Unexpected %q, expected an operation or a fragment",
+				item.Val)
+		}
+	}
+
+	if len(doc.Operations) == 0 {
+		return nil, errors.Errorf("Document does not contain any operation")
+	}
+	names := make(map[string]struct{})
+	for _, op := range doc.Operations {
+		if op.Name == "" && len(doc.Operations) > 1 {
+			return nil, errors.Errorf("Anonymous operation must be the only defined operation")
+		}
+		if _, ok := names[op.Name]; ok {
+			return nil, errors.Errorf("There can be only one operation named %q", op.Name)
+		}
+		names[op.Name] = struct{}{}
+	}
+	return doc, nil
+}
+
+// Operation returns the operation with the given name. The name can be empty
+// if the document has just one operation.
+func (d *Document) Operation(name string) (*Operation, error) {
+	if name == "" {
+		if len(d.Operations) != 1 {
+			return nil, errors.Errorf(
+				"Operation name is required when the document has multiple operations")
+		}
+		return d.Operations[0], nil
+	}
+	for _, op := range d.Operations {
+		if op.Name == name {
+			return op, nil
+		}
+	}
+	return nil, errors.Errorf("Unknown operation named %q", name)
+}
+
+func (p *parser) peek() lex.Item {
+	item, _ := p.it.PeekOne()
+	return item
+}
+
+func (p *parser) next() lex.Item {
+	p.it.Next()
+	return p.it.Item()
+}
+
+// expect consumes the next item and returns an error if it is not of the given type.
+func (p *parser) expect(typ lex.ItemType, what string) (lex.Item, error) {
+	item := p.next()
+	if item.Typ != typ {
+		if item.Typ == lex.ItemEOF {
+			return item, item.Errorf("Unexpected end of input, expected %s", what)
+		}
+		return item, item.Errorf("Unexpected %q, expected %s", item.Val, what)
+	}
+	return item, nil
+}
+
+// skip consumes the next item if it is of the given type.
+func (p *parser) skip(typ lex.ItemType) bool {
+	if p.peek().Typ == typ {
+		p.next()
+		return true
+	}
+	return false
+}
+
+func (p *parser) parseOperation(typ OperationType) (*Operation, error) {
+	op := &Operation{Type: typ}
+	if p.peek().Typ == itemName {
+		op.Name = p.next().Val
+	}
+
+	if p.skip(itemLeftRound) {
+		for !p.skip(itemRightRound) {
+			v, err := p.parseVariableDefinition()
+			if err != nil {
+				return nil, err
+			}
+			op.Variables = append(op.Variables, v)
+		}
+	}
+
+	var err error
+	if op.Directives, err = p.parseDirectives(true); err != nil {
+		return nil, err
+	}
+	if op.SelectionSet, err = p.parseSelectionSet(); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func (p *parser) parseVariableDefinition() (*VariableDefinition, error) {
+	if _, err := p.expect(itemDollar, "a variable definition"); err != nil {
+		return nil, err
+	}
+	name, err := p.expect(itemName, "a variable name")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(itemColon, "':'"); err != nil {
+		return nil, err
+	}
+	v := &VariableDefinition{Name: name.Val}
+	if v.Type, err = p.parseTypeRef(); err != nil {
+		return nil, err
+	}
+	if p.skip(itemEqual) {
+		if v.Default, err = p.parseValue(true); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+func (p *parser) parseTypeRef() (*TypeRef, error) {
+	t := &TypeRef{}
+	if p.skip(itemLeftSquare) {
+		elem, err := p.parseTypeRef()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(itemRightSquare, "']'"); err != nil {
+			return nil, err
+		}
+		t.Elem = elem
+	} else {
+		name, err := p.expect(itemName, "a type")
+		if err != nil {
+			return nil, err
+		}
+		t.Name = name.Val
+	}
+	t.NonNull = p.skip(itemExclamationMark)
+	return t, nil
+}
+
+func (p *parser) parseFragment() (*Fragment, error) {
+	name, err := p.expect(itemName, "a fragment name")
+	if err != nil {
+		return nil, err
+	}
+	if name.Val == "on" {
+		return nil, name.Errorf("Fragment can not be named \"on\"")
+	}
+	on, err := p.expect(itemName, "'on'")
+	if err != nil {
+		return nil, err
+	}
+	if on.Val != "on" {
+		return nil, on.Errorf("Unexpected %q, expected 'on'", on.Val)
+	}
+	cond, err := p.expect(itemName, "a type condition")
+	if err != nil {
+		return nil, err
+	}
+
+	frag := &Fragment{Name: name.Val, TypeCondition: cond.Val}
+	if frag.Directives, err = p.parseDirectives(true); err != nil {
+		return nil, err
+	}
+	if frag.SelectionSet, err = p.parseSelectionSet(); err != nil {
+		return nil, err
+	}
+	return frag, nil
+}
+
+func (p *parser) parseSelectionSet() ([]*Selection, error) {
+	if _, err := p.expect(itemLeftCurl, "'{'"); err != nil {
+		return nil, err
+	}
+	var sels []*Selection
+	for !p.skip(itemRightCurl) {
+		sel, err := p.parseSelection()
+		if err != nil {
+			return nil, err
+		}
+		sels = append(sels, sel)
+	}
+	if len(sels) == 0 {
+		return nil, p.it.Item().Errorf("Selection set can not be empty")
+	}
+	return sels, nil
+}
+
+func (p *parser) parseSelection() (*Selection, error) {
+	if p.skip(itemSpread) {
+		if item := p.peek(); item.Typ == itemName && item.Val != "on" {
+			p.next()
+			dirs, err := p.parseDirectives(true)
+			if err != nil {
+				return nil, err
+			}
+			return &Selection{Spread: &FragmentSpread{Name: item.Val, Directives: dirs}}, nil
+		}
+
+		inline := &InlineFragment{}
+		if item := p.peek(); item.Typ == itemName && item.Val == "on" {
+			p.next()
+			cond, err := p.expect(itemName, "a type condition")
+			if err != nil {
+				return nil, err
+			}
+			inline.TypeCondition = cond.Val
+		}
+		var err error
+		if inline.Directives, err = p.parseDirectives(true); err != nil {
+			return nil, err
+		}
+		if inline.SelectionSet, err = p.parseSelectionSet(); err != nil {
+			return nil, err
+		}
+		return &Selection{Inline: inline}, nil
+	}
+
+	name, err := p.expect(itemName, "a field")
+	if err != nil {
+		return nil, err
+	}
+	f := &Field{Name: name.Val}
+	if p.skip(itemColon) {
+		name, err = p.expect(itemName, "a field")
+		if err != nil {
+			return nil, err
+		}
+		f.Alias, f.Name = f.Name, name.Val
+	}
+	if f.Arguments, err = p.parseArguments(true); err != nil {
+		return nil, err
+	}
+	if f.Directives, err = p.parseDirectives(true); err != nil {
+		return nil, err
+	}
+	if p.peek().Typ == itemLeftCurl {
+		if f.SelectionSet, err = p.parseSelectionSet(); err != nil {
+			return nil, err
+		}
+	}
+	return &Selection{Field: f}, nil
+}
+
+func (p *parser) parseArguments(allowVars bool) ([]*Argument, error) {
+	if !p.skip(itemLeftRound) {
+		return nil, nil
+	}
+	var args []*Argument
+	for !p.skip(itemRightRound) {
+		arg, err := p.parseArgument(allowVars)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range args {
+			if a.Name == arg.Name {
+				return nil, p.it.Item().Errorf("There can be only one argument named %q",
+					arg.Name)
+			}
+		}
+		args = append(args, arg)
+	}
+	if len(args) == 0 {
+		return nil, p.it.Item().Errorf("Argument list can not be empty")
+	}
+	return args, nil
+}
+
+func (p *parser) parseArgument(allowVars bool) (*Argument, error) {
+	name, err := p.expect(itemName, "an argument name")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(itemColon, "':'"); err != nil {
+		return nil, err
+	}
+	val, err := p.parseValue(!allowVars)
+	if err != nil {
+		return nil, err
+	}
+	return &Argument{Name: name.Val, Value: val}, nil
+}
+
+func (p *parser) parseDirectives(allowVars bool) ([]*Directive, error) {
+	var dirs []*Directive
+	for p.skip(itemAt) {
+		name, err := p.expect(itemName, "a directive name")
+		if err != nil {
+			return nil, err
+		}
+		args, err := p.parseArguments(allowVars)
+		if err != nil {
+			return nil, err
+		}
+		dirs = append(dirs, &Directive{Name: name.Val, Arguments: args})
+	}
+	return dirs, nil
+}
+
+// parseValue parses an input value. Variables are not allowed in constant values,
+// such as default values of variables.
+func (p *parser) parseValue(isConst bool) (*Value, error) {
+	item := p.next()
+	switch item.Typ {
+	case itemDollar:
+		if isConst {
+			return nil, item.Errorf("Variables are not allowed in constant values")
+		}
+		name, err := p.expect(itemName, "a variable name")
+		if err != nil {
+			return nil, err
+		}
+		return &Value{Kind: VariableValue, Raw: name.Val}, nil
+	case itemInt:
+		return &Value{Kind: IntValue, Raw: item.Val}, nil
+	case itemFloat:
+		return &Value{Kind: FloatValue, Raw: item.Val}, nil
+	case itemString:
+		s, err := unquote(item.Val)
+		if err != nil {
+			return nil, item.Errorf("Invalid string %s: %v", item.Val, err)
+		}
+		return &Value{Kind: StringValue, Raw: s}, nil
+	case itemBlockString:
+		return &Value{Kind: StringValue, Raw: blockStringValue(item.Val)}, nil
+	case itemName:
+		switch item.Val {
+		case "true", "false":
+			return &Value{Kind: BooleanValue, Raw: item.Val}, nil
+		case "null":
+			return &Value{Kind: NullValue, Raw: item.Val}, nil
+		}
+		return &Value{Kind: EnumValue, Raw: item.Val}, nil
+	case itemLeftSquare:
+		val := &Value{Kind: ListValue}
+		for !p.skip(itemRightSquare) {
+			elem, err := p.parseValue(isConst)
+			if err != nil {
+				return nil, err
+			}
+			val.List = append(val.List, elem)
+		}
+		return val, nil
+	case itemLeftCurl:
+		val := &Value{Kind: ObjectValue}
+		for !p.skip(itemRightCurl) {
+			f, err := p.parseArgument(!isConst)
+			if err != nil {
+				return nil, err
+			}
+			if val.Field(f.Name) != nil {
+				return nil, item.Errorf("There can be only one input field named %q", f.Name)
+			}
+			val.Fields = append(val.Fields, f)
+		}
+		return val, nil
+	case lex.ItemEOF:
+		return nil, item.Errorf("Unexpected end of input, expected a value")
+	}
+	return nil, item.Errorf("Unexpected %q, expected a value", item.Val)
+}
+
+// unquote returns the value of a quoted GraphQL string literal.
+func unquote(s string) (string, error) {
+	s = s[1 : len(s)-1]
+	if !strings.ContainsRune(s, '\\') {
+		return s, nil
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			sb.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'b':
+			sb.WriteByte('\b')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 't':
+			sb.WriteByte('\t')
+		case 'u':
+			if i+4 >= len(s) {
+				return "", errors.Errorf("Invalid unicode escape sequence")
+			}
+			code, err := strconv.ParseUint(s[i+1:i+5], 16, 32)
+			if err != nil {
+				return "", errors.Wrapf(err, "while parsing unicode escape sequence")
+			}
+			var buf [utf8.UTFMax]byte
+			sb.Write(buf[:utf8.EncodeRune(buf[:], rune(code))])
+			i += 4
+		default:
+			// The lexer only allows ", \ and / to be escaped otherwise.
+			sb.WriteByte(s[i])
+		}
+	}
+	return sb.String(), nil
+}
+
+// blockStringValue returns the value of a block string by removing the common
+// indentation and the leading and trailing blank lines as defined by the spec.
+func blockStringValue(s string) string {
+	s = strings.Replace(s[3:len(s)-3], `\"""`, `"""`, -1)
+	lines := strings.Split(strings.Replace(s, "\r\n", "\n", -1), "\n")
+
+	indent := -1
+	for _, line := range lines[1:] {
+		trimmed := strings.TrimLeft(line, " \t")
+		if len(trimmed) == 0 {
+			continue
+		}
+		if n := len(line) - len(trimmed); indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	if indent > 0 {
+		for i := 1; i < len(lines); i++ {
+			if len(lines[i]) >= indent {
+				lines[i] = lines[i][indent:]
+			} else {
+				lines[i] = strings.TrimLeft(lines[i], " \t")
+			}
+		}
+	}
+
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,155 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryShorthand(t *testing.T) {
+	doc, err := Parse(`{ me: getPerson(uid: "0x1") { name, friends(first: 10) { name } } }`)
+	require.NoError(t, err)
+	require.Len(t, doc.Operations, 1)
+
+	op := doc.Operations[0]
+	require.Equal(t, QueryOperation, op.Type)
+	require.Len(t, op.SelectionSet, 1)
+
+	f := op.SelectionSet[0].Field
+	require.Equal(t, "me", f.Alias)
+	require.Equal(t, "getPerson", f.Name)
+	require.Equal(t, "me", f.ResponseKey())
+	require.Equal(t, &Value{Kind: StringValue, Raw: "0x1"}, f.Argument("uid"))
+	require.Len(t, f.SelectionSet, 2)
+
+	friends := f.SelectionSet[1].Field
+	require.Equal(t, "friends", friends.ResponseKey())
+	require.Equal(t, &Value{Kind: IntValue, Raw: "10"}, friends.Argument("first"))
+}
+
+func TestParseOperationWithVariables(t *testing.T) {
+	doc, err := Parse(`
+	# Find people by name.
+	query people($name: String! = "Alice", $ids: [ID!], $limit: Int = 5) {
+		queryPerson(filter: {name: {eq: $name}, uid: $ids}, first: $limit) {
+			...personFields
+			... on Person @include(if: true) { age }
+		}
+	}
+
+	fragment personFields on Person {
+		uid
+		name
+	}`)
+	require.NoError(t, err)
+
+	op, err := doc.Operation("people")
+	require.NoError(t, err)
+	require.Len(t, op.Variables, 3)
+	require.Equal(t, "String!", op.Variables[0].Type.String())
+	require.Equal(t, &Value{Kind: StringValue, Raw: "Alice"}, op.Variables[0].Default)
+	require.Equal(t, "[ID!]", op.Variables[1].Type.String())
+	require.Equal(t, "ID", op.Variables[1].Type.NamedType())
+
+	f := op.SelectionSet[0].Field
+	filter := f.Argument("filter")
+	require.Equal(t, ObjectValue, filter.Kind)
+	require.Equal(t, &Value{Kind: VariableValue, Raw: "name"},
+		filter.Field("name").Field("eq"))
+	require.Equal(t, &Value{Kind: VariableValue, Raw: "ids"}, filter.Field("uid"))
+
+	require.Equal(t, "personFields", f.SelectionSet[0].Spread.Name)
+	inline := f.SelectionSet[1].Inline
+	require.Equal(t, "Person", inline.TypeCondition)
+	require.Equal(t, "include", inline.Directives[0].Name)
+
+	frag := doc.Fragments["personFields"]
+	require.Equal(t, "Person", frag.TypeCondition)
+	require.Len(t, frag.SelectionSet, 2)
+}
+
+func TestParseValues(t *testing.T) {
+	doc, err := Parse(`{
+		f(a: -12, b: 1.5e3, c: true, d: null, e: ENUM, f: [1, "two"], g: "esc\"aped\né",
+			h: """
+				block
+				  string
+			""")
+	}`)
+	require.NoError(t, err)
+
+	f := doc.Operations[0].SelectionSet[0].Field
+	require.Equal(t, &Value{Kind: IntValue, Raw: "-12"}, f.Argument("a"))
+	require.Equal(t, &Value{Kind: FloatValue, Raw: "1.5e3"}, f.Argument("b"))
+	require.Equal(t, &Value{Kind: BooleanValue, Raw: "true"}, f.Argument("c"))
+	require.Equal(t, &Value{Kind: NullValue, Raw: "null"}, f.Argument("d"))
+	require.Equal(t, &Value{Kind: EnumValue, Raw: "ENUM"}, f.Argument("e"))
+	require.Equal(t, &Value{Kind: ListValue, List: []*Value{
+		{Kind: IntValue, Raw: "1"},
+		{Kind: StringValue, Raw: "two"},
+	}}, f.Argument("f"))
+	require.Equal(t, "esc\"aped\né", f.Argument("g").Raw)
+	require.Equal(t, "block\n  string", f.Argument("h").Raw)
+}
+
+func TestParseMutation(t *testing.T) {
+	doc, err := Parse(`mutation {
+		addPerson(input: [{name: "Alice"}]) { numUids }
+	}`)
+	require.NoError(t, err)
+	require.Equal(t, MutationOperation, doc.Operations[0].Type)
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		in  string
+		err string
+	}{
+		{in: ``, err: "Document does not contain any operation"},
+		{in: `{ name `, err: "Unexpected end of input"},
+		{in: `{ }`, err: "Selection set can not be empty"},
+		{in: `{ f(a: 1, a: 2) }`, err: `There can be only one argument named "a"`},
+		{in: `{ f(a: 01x) }`, err: "Invalid number"},
+		{in: `{ f(a: "unterminated) }`, err: "Unterminated string"},
+		{in: `{ f(a: "\q") }`, err: "Invalid escape character"},
+		{in: `{ a } { b }`, err: "Anonymous operation must be the only defined operation"},
+		{in: `query q { a } query q { b }`, err: `There can be only one operation named "q"`},
+		{in: `query q($a: Int = $b) { a }`, err: "Variables are not allowed in constant values"},
+		{in: `fragment on on T { a }`, err: `Fragment can not be named "on"`},
+		{in: `{ a.b }`, err: "did you mean '...'"},
+	}
+	for _, tc := range tests {
+		_, err := Parse(tc.in)
+		require.Error(t, err, tc.in)
+		require.Contains(t, err.Error(), tc.err, tc.in)
+	}
+}
+
+func TestDocumentOperation(t *testing.T) {
+	doc, err := Parse(`query a { x } mutation b { y }`)
+	require.NoError(t, err)
+
+	_, err = doc.Operation("")
+	require.Error(t, err)
+	op, err := doc.Operation("b")
+	require.NoError(t, err)
+	require.Equal(t, MutationOperation, op.Type)
+	_, err = doc.Operation("c")
+	require.Error(t, err)
+}
@@ -0,0 +1,848 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/pkg/errors"
+)
+
+// Request is a GraphQL request as sent by clients over HTTP.
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Error is an error reported in the errors list of a GraphQL response.
+type Error struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+// Response is the result of executing a GraphQL request. Data is nil if the
+// request failed before execution started.
+type Response struct {
+	Data   orderedMap
+	Errors []*Error
+}
+
+// MarshalJSON encodes the response in the format defined by the GraphQL spec.
+func (r *Response) MarshalJSON() ([]byte, error) {
+	var out orderedMap
+	if len(r.Errors) > 0 {
+		out = append(out, keyValue{"errors", r.Errors})
+	}
+	if r.Data != nil || len(r.Errors) == 0 {
+		out = append(out, keyValue{"data", r.Data})
+	}
+	return out.MarshalJSON()
+}
+
+func errorResponse(err error) *Response {
+	return &Response{Errors: []*Error{{Message: err.Error()}}}
+}
+
+// Executor runs GraphQL+- queries and mutations. It's implemented by edgraph.Server.
+type Executor interface {
+	Query(ctx context.Context, req *api.Request) (*api.Response, error)
+}
+
+// keyValue is an entry of an orderedMap.
+type keyValue struct {
+	key string
+	val interface{}
+}
+
+// orderedMap is a JSON object that preserves the order of its keys, as required
+// for the results of GraphQL selection sets.
+type orderedMap []keyValue
+
+func (m orderedMap) has(key string) bool {
+	for _, kv := range m {
+		if kv.key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON encodes the entries in order.
+func (m orderedMap) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return []byte("null"), nil
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, kv := range m {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(kv.key)
+		if err != nil {
+			return nil, err
+		}
+		val, err := json.Marshal(kv.val)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(val)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// selection is a field of a selection set after fragments have been expanded and
+// fields have been validated against the schema.
+type selection struct {
+	key   string
+	field *Field
+	def   *FieldDefinition
+	args  map[string]interface{}
+	// typeCond is set for fields selected through a fragment on an object type while
+	// the field returns an interface. It restricts the field to objects of that type.
+	typeCond string
+	// alias is the name under which Dgraph returns the field.
+	alias    string
+	children []*selection
+}
+
+// Resolve executes the GraphQL request against the schema, running the generated
+// GraphQL+- queries and mutations with the given executor.
+func Resolve(ctx context.Context, s *Schema, exec Executor, req *Request) *Response {
+	doc, err := Parse(req.Query)
+	if err != nil {
+		return errorResponse(err)
+	}
+	op, err := doc.Operation(req.OperationName)
+	if err != nil {
+		return errorResponse(err)
+	}
+
+	var root *Type
+	switch op.Type {
+	case QueryOperation:
+		root = s.query
+	case MutationOperation:
+		if len(s.mutation.Fields) == 0 {
+			return errorResponse(errors.Errorf("Schema does not support mutations"))
+		}
+		root = s.mutation
+	default:
+		return errorResponse(errors.Errorf("Operation type %s is not supported", op.Type))
+	}
+
+	r := &resolver{schema: s, doc: doc, exec: exec}
+	if r.vars, err = s.coerceVariables(op, req.Variables); err != nil {
+		return errorResponse(err)
+	}
+	sels, err := r.plan(root, op.SelectionSet, make(map[string]bool))
+	if err != nil {
+		return errorResponse(err)
+	}
+
+	if op.Type == MutationOperation {
+		return r.resolveMutations(ctx, sels)
+	}
+	return r.resolveQueries(ctx, sels)
+}
+
+type resolver struct {
+	schema *Schema
+	doc    *Document
+	exec   Executor
+	vars   map[string]interface{}
+}
+
+// collectedField is a field found while expanding the fragments of a selection set.
+type collectedField struct {
+	field    *Field
+	typeCond string
+}
+
+// plan validates the selection set against the type and returns the selections
+// with fragments expanded and fields with the same response key merged.
+func (r *resolver) plan(typ *Type, set []*Selection, visited map[string]bool) (
+	[]*selection, error) {
+
+	var fields []collectedField
+	if err := r.collectFields(typ, "", set, visited, &fields); err != nil {
+		return nil, err
+	}
+
+	// Group the fields by response key and type condition, preserving the order in
+	// which they were first seen.
+	var groups [][]collectedField
+	index := make(map[string]int)
+	for _, f := range fields {
+		id := f.field.ResponseKey() + "\x00" + f.typeCond
+		i, ok := index[id]
+		if !ok {
+			i = len(groups)
+			index[id] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], f)
+	}
+
+	aliases := map[string]bool{"uid": true}
+	var sels []*selection
+	for _, group := range groups {
+		first := group[0].field
+		var set []*Selection
+		for _, f := range group {
+			if f.field.Name != first.Name {
+				return nil, errors.Errorf("Fields %q conflict because %s and %s are different "+
+					"fields", first.ResponseKey(), first.Name, f.field.Name)
+			}
+			set = append(set, f.field.SelectionSet...)
+		}
+
+		sel, err := r.planField(typ, group[0].typeCond, first, set, visited)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case sel.def.predicate == "uid":
+			sel.alias = "uid"
+		case sel.def.predicate != "":
+			sel.alias = sel.key
+			for i := 1; aliases[sel.alias]; i++ {
+				sel.alias = sel.key + "_" + strconv.Itoa(i)
+			}
+			aliases[sel.alias] = true
+		}
+		sels = append(sels, sel)
+	}
+	return sels, nil
+}
+
+func (r *resolver) collectFields(typ *Type, typeCond string, set []*Selection,
+	visited map[string]bool, out *[]collectedField) error {
+
+	for _, sel := range set {
+		var dirs []*Directive
+		switch {
+		case sel.Field != nil:
+			dirs = sel.Field.Directives
+		case sel.Spread != nil:
+			dirs = sel.Spread.Directives
+		default:
+			dirs = sel.Inline.Directives
+		}
+		include, err := r.shouldInclude(dirs)
+		if err != nil {
+			return err
+		}
+		if !include {
+			continue
+		}
+
+		switch {
+		case sel.Field != nil:
+			*out = append(*out, collectedField{field: sel.Field, typeCond: typeCond})
+		case sel.Spread != nil:
+			frag, ok := r.doc.Fragments[sel.Spread.Name]
+			if !ok {
+				return errors.Errorf("Unknown fragment %q", sel.Spread.Name)
+			}
+			if visited[frag.Name] {
+				return errors.Errorf("Cannot spread fragment %q within itself", frag.Name)
+			}
+			visited[frag.Name] = true
+			cond, ok, err := r.fragmentCondition(typ, typeCond, frag.TypeCondition)
+			if err == nil && ok {
+				err = r.collectFields(typ, cond, frag.SelectionSet, visited, out)
+			}
+			delete(visited, frag.Name)
+			if err != nil {
+				return err
+			}
+		default:
+			cond, ok, err := r.fragmentCondition(typ, typeCond, sel.Inline.TypeCondition)
+			if err != nil {
+				return err
+			}
+			if ok {
+				if err := r.collectFields(typ, cond, sel.Inline.SelectionSet, visited,
+					out); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// fragmentCondition returns the type condition to apply to the fields of a fragment
+// on the given type and whether the fragment can apply at all.
+func (r *resolver) fragmentCondition(typ *Type, typeCond, cond string) (string, bool, error) {
+	if cond == "" || cond == typ.Name {
+		return typeCond, true, nil
+	}
+	t := r.schema.Type(cond)
+	if t == nil {
+		return "", false, errors.Errorf("Unknown type %q", cond)
+	}
+	switch {
+	case typ.Kind == ObjectKind:
+		// A fragment on an interface implemented by the object always applies.
+		for _, iface := range typ.Interfaces {
+			if iface == cond {
+				return typeCond, true, nil
+			}
+		}
+		return "", false, nil
+	case t.Kind == ObjectKind:
+		if typeCond != "" && typeCond != cond {
+			return "", false, nil
+		}
+		for _, possible := range typ.PossibleTypes {
+			if possible == cond {
+				return cond, true, nil
+			}
+		}
+	}
+	return "", false, nil
+}
+
+func (r *resolver) shouldInclude(dirs []*Directive) (bool, error) {
+	for _, dir := range dirs {
+		if dir.Name != "skip" && dir.Name != "include" {
+			return false, errors.Errorf("Unknown directive %q", dir.Name)
+		}
+		def := builtinDirectives[0]
+		if dir.Name == "include" {
+			def = builtinDirectives[1]
+		}
+		args, err := r.schema.coerceArguments(def.args, dir.Arguments, r.vars,
+			"directive @"+dir.Name)
+		if err != nil {
+			return false, err
+		}
+		if args["if"].(bool) == (dir.Name == "skip") {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (r *resolver) planField(typ *Type, typeCond string, field *Field, set []*Selection,
+	visited map[string]bool) (*selection, error) {
+
+	parent := typ
+	if typeCond != "" {
+		parent = r.schema.Type(typeCond)
+	}
+	def := parent.Field(field.Name)
+	switch {
+	case field.Name == "__typename":
+		def = typenameMetaField
+	case typ == r.schema.query && field.Name == "__schema":
+		def = schemaMetaField
+	case typ == r.schema.query && field.Name == "__type":
+		def = typeMetaField
+	}
+	if def == nil {
+		return nil, errors.Errorf("Cannot query field %q on type %q", field.Name, parent.Name)
+	}
+
+	sel := &selection{key: field.ResponseKey(), field: field, def: def, typeCond: typeCond}
+	var err error
+	if sel.args, err = r.schema.coerceArguments(def.Args, field.Arguments, r.vars,
+		"field "+parent.Name+"."+def.Name); err != nil {
+		return nil, err
+	}
+
+	fieldType := r.schema.Type(def.Type.NamedType())
+	switch fieldType.Kind {
+	case ObjectKind, InterfaceKind, UnionKind:
+		if len(set) == 0 {
+			return nil, errors.Errorf("Field %q of type %q must have a selection of subfields",
+				field.Name, def.Type)
+		}
+		if sel.children, err = r.plan(fieldType, set, visited); err != nil {
+			return nil, err
+		}
+	default:
+		if len(set) > 0 {
+			return nil, errors.Errorf("Field %q must not have a selection since type %q "+
+				"has no subfields", field.Name, def.Type)
+		}
+	}
+	return sel, nil
+}
+
+// resolveQueries runs all the query fields as a single GraphQL+- query.
+func (r *resolver) resolveQueries(ctx context.Context, sels []*selection) *Response {
+	var queries []*gql.GraphQuery
+	for _, sel := range sels {
+		if sel.def.op == opNone {
+			continue
+		}
+		q, err := r.rootQuery(sel)
+		if err != nil {
+			return errorResponse(err)
+		}
+		queries = append(queries, q)
+	}
+
+	var result map[string]interface{}
+	if len(queries) > 0 {
+		resp, err := r.exec.Query(ctx, &api.Request{Query: asString(queries), ReadOnly: true})
+		if err != nil {
+			return errorResponse(err)
+		}
+		if result, err = decode(resp.Json); err != nil {
+			return errorResponse(err)
+		}
+	}
+
+	data := make(orderedMap, 0, len(sels))
+	for _, sel := range sels {
+		if data.has(sel.key) {
+			continue
+		}
+		var val interface{}
+		switch sel.def {
+		case typenameMetaField:
+			val = queryTypeName
+		case schemaMetaField:
+			val = r.schema.introspect(sel.children, r.schema)
+		case typeMetaField:
+			if name := sel.args["name"].(string); r.schema.Type(name) != nil {
+				val = r.schema.introspect(sel.children, named(name))
+			}
+		default:
+			val = r.completeValue(sel, sel.def.Type, result[sel.alias])
+		}
+		data = append(data, keyValue{sel.key, val})
+	}
+	return &Response{Data: data}
+}
+
+// rootQuery returns the GraphQL+- query block for a field of the Query type.
+func (r *resolver) rootQuery(sel *selection) (*gql.GraphQuery, error) {
+	sel.alias = sel.key
+	q := &gql.GraphQuery{Alias: sel.alias, Attr: sel.alias}
+	obj := sel.def.object
+	switch sel.def.op {
+	case opGet:
+		uid, err := parseUid(sel.args["uid"].(string))
+		if err != nil {
+			return nil, err
+		}
+		q.Func = &gql.Function{Name: "uid", UID: []uint64{uid}}
+		q.Filter = typeFilter(obj)
+	case opQuery:
+		q.Func = &gql.Function{Name: "type", Args: []gql.Arg{{Value: obj.dgraphType}}}
+		if err := r.applyListArgs(q, obj, sel.args); err != nil {
+			return nil, err
+		}
+	}
+	if err := r.addChildren(q, sel.children); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// addChildren adds the children needed to return the selections to the query.
+func (r *resolver) addChildren(q *gql.GraphQuery, sels []*selection) error {
+	q.Children = append(q.Children, &gql.GraphQuery{Attr: "dgraph.type"})
+	hasUid := false
+	for _, sel := range sels {
+		switch {
+		case sel.def.predicate == "":
+		case sel.def.predicate == "uid":
+			if !hasUid {
+				q.Children = append(q.Children, &gql.GraphQuery{Attr: "uid"})
+				hasUid = true
+			}
+		default:
+			child := &gql.GraphQuery{Alias: sel.alias, Attr: sel.def.predicate}
+			if len(sel.children) > 0 {
+				target := r.schema.Type(sel.def.Type.NamedType())
+				if err := r.applyListArgs(child, target, sel.args); err != nil {
+					return err
+				}
+				if err := r.addChildren(child, sel.children); err != nil {
+					return err
+				}
+			}
+			q.Children = append(q.Children, child)
+		}
+	}
+	return nil
+}
+
+// applyListArgs applies the filter, order and pagination arguments to the query.
+func (r *resolver) applyListArgs(q *gql.GraphQuery, obj *Type, args map[string]interface{}) error {
+	if filter, ok := args["filter"].(map[string]interface{}); ok {
+		tree, err := r.schema.buildFilter(obj, filter)
+		if err != nil {
+			return err
+		}
+		q.Filter = tree
+	}
+	for order, ok := args["order"].(map[string]interface{}); ok; {
+		for _, dir := range []string{"asc", "desc"} {
+			if name, ok := order[dir].(string); ok {
+				q.Order = append(q.Order, orderBy(obj, name, dir == "desc"))
+			}
+		}
+		order, ok = order["then"].(map[string]interface{})
+	}
+	for _, arg := range []string{"first", "offset"} {
+		if val, ok := args[arg].(int64); ok {
+			if q.Args == nil {
+				q.Args = make(map[string]string)
+			}
+			q.Args[arg] = strconv.FormatInt(val, 10)
+		}
+	}
+	return nil
+}
+
+// completeValue converts the value returned by Dgraph for the selection into a value
+// of the given GraphQL type.
+func (r *resolver) completeValue(sel *selection, typ *TypeRef, val interface{}) interface{} {
+	if val == nil {
+		return nil
+	}
+	if typ.Elem != nil {
+		vals, ok := val.([]interface{})
+		if !ok {
+			vals = []interface{}{val}
+		}
+		res := make([]interface{}, 0, len(vals))
+		for _, v := range vals {
+			res = append(res, r.completeValue(sel, typ.Elem, v))
+		}
+		return res
+	}
+	if vals, ok := val.([]interface{}); ok {
+		// Dgraph returns a list for uid edges, even if just one node is expected.
+		if len(vals) == 0 {
+			return nil
+		}
+		val = vals[0]
+	}
+	if len(sel.children) == 0 {
+		return val
+	}
+	obj, ok := val.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return r.completeObject(r.schema.Type(typ.Name), sel.children, obj)
+}
+
+func (r *resolver) completeObject(typ *Type, sels []*selection,
+	obj map[string]interface{}) orderedMap {
+
+	runtime := typ.Name
+	if typ.Kind != ObjectKind {
+		runtime = r.runtimeType(obj)
+	}
+	out := make(orderedMap, 0, len(sels))
+	for _, sel := range sels {
+		if (sel.typeCond != "" && sel.typeCond != runtime) || out.has(sel.key) {
+			continue
+		}
+		var val interface{}
+		if sel.def == typenameMetaField {
+			val = runtime
+		} else {
+			val = r.completeValue(sel, sel.def.Type, obj[sel.alias])
+		}
+		out = append(out, keyValue{sel.key, val})
+	}
+	return out
+}
+
+// runtimeType returns the name of the object type of a node returned for an interface,
+// based on its dgraph.type values.
+func (r *resolver) runtimeType(obj map[string]interface{}) string {
+	types, _ := obj["dgraph.type"].([]interface{})
+	for _, t := range types {
+		if name, ok := t.(string); ok {
+			if typ := r.schema.Type(name); typ != nil && typ.dgraphType != "" {
+				return name
+			}
+		}
+	}
+	return nodeTypeName
+}
+
+// resolveMutations runs the mutation fields one after the other, as required by the spec.
+func (r *resolver) resolveMutations(ctx context.Context, sels []*selection) *Response {
+	resp := &Response{Data: make(orderedMap, 0, len(sels))}
+	for _, sel := range sels {
+		if resp.Data.has(sel.key) {
+			continue
+		}
+		var val interface{}
+		var err error
+		switch sel.def.op {
+		case opAdd:
+			val, err = r.add(ctx, sel)
+		case opUpdate:
+			val, err = r.update(ctx, sel)
+		case opDelete:
+			val, err = r.delete(ctx, sel)
+		default:
+			val = mutationTypeName
+		}
+		if err != nil {
+			resp.Errors = append(resp.Errors,
+				&Error{Message: err.Error(), Path: []interface{}{sel.key}})
+		}
+		resp.Data = append(resp.Data, keyValue{sel.key, val})
+	}
+	return resp
+}
+
+func (r *resolver) add(ctx context.Context, sel *selection) (interface{}, error) {
+	obj := sel.def.object
+	inputs := sel.args["input"].([]interface{})
+	objects := make([]map[string]interface{}, 0, len(inputs))
+	for i, in := range inputs {
+		node := mutationObject(r.schema.Type(obj.Name+"Input"), in.(map[string]interface{}))
+		node["uid"] = "_:" + newNodeName(i)
+		node["dgraph.type"] = obj.dgraphType
+		objects = append(objects, node)
+	}
+	setJson, err := json.Marshal(objects)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.exec.Query(ctx, &api.Request{
+		Mutations: []*api.Mutation{{SetJson: setJson}},
+		CommitNow: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	uids := make([]string, 0, len(inputs))
+	for i := range inputs {
+		uids = append(uids, resp.Uids[newNodeName(i)])
+	}
+	return r.payload(ctx, sel, uids)
+}
+
+func (r *resolver) update(ctx context.Context, sel *selection) (interface{}, error) {
+	obj := sel.def.object
+	input := sel.args["input"].(map[string]interface{})
+	query, err := r.upsertQuery(obj, input["filter"].(map[string]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	patchType := r.schema.Type(obj.Name + "Patch")
+	mu := &api.Mutation{}
+	if set, ok := input["set"].(map[string]interface{}); ok && len(set) > 0 {
+		node := mutationObject(patchType, set)
+		node["uid"] = "uid(" + upsertVar + ")"
+		if mu.SetJson, err = json.Marshal(node); err != nil {
+			return nil, err
+		}
+	}
+	if remove, ok := input["remove"].(map[string]interface{}); ok && len(remove) > 0 {
+		node := mutationObject(patchType, remove)
+		node["uid"] = "uid(" + upsertVar + ")"
+		if mu.DeleteJson, err = json.Marshal(node); err != nil {
+			return nil, err
+		}
+	}
+
+	uids, err := r.upsert(ctx, query, mu)
+	if err != nil {
+		return nil, err
+	}
+	return r.payload(ctx, sel, uids)
+}
+
+func (r *resolver) delete(ctx context.Context, sel *selection) (interface{}, error) {
+	query, err := r.upsertQuery(sel.def.object, sel.args["filter"].(map[string]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	mu := &api.Mutation{DeleteJson: []byte(`{"uid": "uid(` + upsertVar + `)"}`)}
+	uids, err := r.upsert(ctx, query, mu)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(orderedMap, 0, len(sel.children))
+	for _, child := range sel.children {
+		if out.has(child.key) {
+			continue
+		}
+		var val interface{}
+		switch child.def.Name {
+		case "__typename":
+			val = r.schema.Type(sel.def.Type.Name).Name
+		case "msg":
+			val = "Deleted"
+		case "numUids":
+			val = len(uids)
+		}
+		out = append(out, keyValue{child.key, val})
+	}
+	return out, nil
+}
+
+// upsert runs the mutation for the nodes matched by the query and returns their uids.
+func (r *resolver) upsert(ctx context.Context, query *gql.GraphQuery,
+	mu *api.Mutation) ([]string, error) {
+
+	req := &api.Request{Query: asString([]*gql.GraphQuery{query}), CommitNow: true}
+	if len(mu.SetJson) > 0 || len(mu.DeleteJson) > 0 {
+		req.Mutations = []*api.Mutation{mu}
+	}
+	resp, err := r.exec.Query(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := decode(resp.Json)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, _ := result[query.Alias].([]interface{})
+	uids := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		if m, ok := node.(map[string]interface{}); ok {
+			if uid, ok := m["uid"].(string); ok {
+				uids = append(uids, uid)
+			}
+		}
+	}
+	return uids, nil
+}
+
+// payload returns the result of an add or update mutation, querying the mutated nodes
+// if the selection asks for them.
+func (r *resolver) payload(ctx context.Context, sel *selection,
+	uids []string) (interface{}, error) {
+
+	out := make(orderedMap, 0, len(sel.children))
+	for _, child := range sel.children {
+		if out.has(child.key) {
+			continue
+		}
+		var val interface{}
+		switch child.def.Name {
+		case "__typename":
+			val = r.schema.Type(sel.def.Type.Name).Name
+		case "numUids":
+			val = len(uids)
+		default:
+			nodes, err := r.queryNodes(ctx, sel.def.object, child, uids)
+			if err != nil {
+				return nil, err
+			}
+			val = nodes
+		}
+		out = append(out, keyValue{child.key, val})
+	}
+	return out, nil
+}
+
+// queryNodes queries the nodes with the given uids for the selection.
+func (r *resolver) queryNodes(ctx context.Context, obj *Type, sel *selection,
+	uids []string) (interface{}, error) {
+
+	if len(uids) == 0 {
+		return []interface{}{}, nil
+	}
+	q := &gql.GraphQuery{Alias: "nodes", Attr: "nodes", Func: &gql.Function{Name: "uid"}}
+	for _, s := range uids {
+		uid, err := parseUid(s)
+		if err != nil {
+			return nil, err
+		}
+		q.Func.UID = append(q.Func.UID, uid)
+	}
+	if err := r.applyListArgs(q, obj, sel.args); err != nil {
+		return nil, err
+	}
+	if err := r.addChildren(q, sel.children); err != nil {
+		return nil, err
+	}
+
+	resp, err := r.exec.Query(ctx, &api.Request{Query: asString([]*gql.GraphQuery{q})})
+	if err != nil {
+		return nil, err
+	}
+	result, err := decode(resp.Json)
+	if err != nil {
+		return nil, err
+	}
+	return r.completeValue(sel, sel.def.Type, result[q.Alias]), nil
+}
+
+// mutationObject converts an input object into the JSON object used to mutate a node.
+func mutationObject(typ *Type, in map[string]interface{}) map[string]interface{} {
+	node := make(map[string]interface{}, len(in))
+	for name, val := range in {
+		if f := typ.InputField(name); f != nil {
+			node[f.predicate] = val
+		}
+	}
+	return node
+}
+
+func newNodeName(i int) string {
+	return "node" + strconv.Itoa(i)
+}
+
+// decode parses the JSON returned by Dgraph, keeping numbers as json.Number so
+// that integers are returned unchanged.
+func decode(js []byte) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	if len(js) == 0 {
+		return result, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(js))
+	dec.UseNumber()
+	if err := dec.Decode(&result); err != nil {
+		return nil, errors.Wrapf(err, "while decoding response")
+	}
+	return result, nil
+}
+
+// sortedKeys returns the keys of the map in sorted order, so that the generated
+// queries are deterministic.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,319 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/stretchr/testify/require"
+)
+
+// testExecutor records the requests it receives and replies with canned responses.
+type testExecutor struct {
+	reqs  []*api.Request
+	resps []*api.Response
+}
+
+func (e *testExecutor) Query(ctx context.Context, req *api.Request) (*api.Response, error) {
+	e.reqs = append(e.reqs, req)
+	resp := e.resps[0]
+	e.resps = e.resps[1:]
+	return resp, nil
+}
+
+func testSchema() *Schema {
+	types := []*pb.TypeUpdate{
+		{
+			TypeName: "Person",
+			Fields: []*pb.SchemaUpdate{
+				{Predicate: "name"},
+				{Predicate: "age"},
+				{Predicate: "friend"},
+				{Predicate: "~friend"},
+				{Predicate: "secret"},
+			},
+		},
+		{
+			TypeName: "Company",
+			Fields:   []*pb.SchemaUpdate{{Predicate: "name"}},
+		},
+		{TypeName: "dgraph.type.User"},
+	}
+	preds := []*pb.SchemaNode{
+		{Predicate: "name", Type: "string", Index: true, Tokenizer: []string{"exact"}},
+		{Predicate: "age", Type: "int"},
+		{Predicate: "friend", Type: "uid", List: true, Reverse: true},
+		{Predicate: "secret", Type: "password"},
+	}
+	return NewSchema(types, preds)
+}
+
+func resolve(t *testing.T, exec *testExecutor, query string,
+	vars map[string]interface{}) string {
+
+	resp := Resolve(context.Background(), testSchema(), exec,
+		&Request{Query: query, Variables: vars})
+	js, err := json.Marshal(resp)
+	require.NoError(t, err)
+	return string(js)
+}
+
+func TestSchemaGeneration(t *testing.T) {
+	s := testSchema()
+	require.Nil(t, s.Type("dgraph.type.User"))
+
+	person := s.Type("Person")
+	require.Equal(t, ObjectKind, person.Kind)
+	require.Equal(t, []string{"Node"}, person.Interfaces)
+	var fields []string
+	for _, f := range person.Fields {
+		fields = append(fields, f.Name+": "+f.Type.String())
+	}
+	require.Equal(t, []string{"uid: ID!", "name: String", "age: Int", "friend: [Node]"}, fields)
+
+	filter := s.Type("PersonFilter")
+	require.NotNil(t, filter.InputField("name"))
+	require.Nil(t, filter.InputField("age"), "age isn't indexed")
+	require.Equal(t, []string{"name", "age"}, s.Type("PersonOrderable").EnumValues)
+	require.Equal(t, "[NodeRef!]", s.Type("PersonInput").InputField("friend").Type.String())
+
+	require.NotNil(t, s.query.Field("getPerson"))
+	require.NotNil(t, s.query.Field("queryCompany"))
+	require.NotNil(t, s.mutation.Field("addPerson"))
+	require.NotNil(t, s.mutation.Field("updatePerson"))
+	require.NotNil(t, s.mutation.Field("deleteCompany"))
+
+	sdl := s.String()
+	require.Contains(t, sdl, "type Person implements Node {\n\tuid: ID!\n")
+	require.Contains(t, sdl,
+		"\tqueryPerson(filter: PersonFilter, order: PersonOrder, first: Int, offset: Int): [Person]\n")
+	require.NotContains(t, sdl, "__Type")
+}
+
+func TestResolveQuery(t *testing.T) {
+	exec := &testExecutor{resps: []*api.Response{{Json: []byte(`{"people": [
+		{"dgraph.type": ["Person"], "uid": "0x1", "name": "Alice", "years": 23,
+		 "friend": [{"dgraph.type": ["Company"], "name": "Acme"},
+		            {"dgraph.type": ["Person"], "name": "Bob", "age": 30}]}]}`)}}}
+
+	out := resolve(t, exec, `query q($name: String) {
+		people: queryPerson(filter: {name: {eq: $name}}, order: {desc: age}, first: 2) {
+			__typename
+			name
+			years: age
+			friend {
+				uid
+				__typename
+				... on Person { name age }
+			}
+		}
+	}`, map[string]interface{}{"name": "Alice"})
+
+	require.Len(t, exec.reqs, 1)
+	require.True(t, exec.reqs[0].ReadOnly)
+	require.Equal(t, `{
+	people(func: type("Person"), orderdesc: age, first: 2) @filter(eq(name, "Alice")) {
+		dgraph.type
+		name
+		years : age
+		friend {
+			dgraph.type
+			uid
+			name
+			age
+		}
+	}
+}`, exec.reqs[0].Query)
+	require.JSONEq(t, `{"data": {"people": [{
+		"__typename": "Person", "name": "Alice", "years": 23,
+		"friend": [
+			{"uid": null, "__typename": "Company"},
+			{"uid": null, "__typename": "Person", "name": "Bob", "age": 30}
+		]}]}}`, out)
+	// The order of the keys has to follow the selection set.
+	require.True(t, strings.Index(out, `"years"`) > strings.Index(out, `"name"`))
+}
+
+func TestResolveGet(t *testing.T) {
+	exec := &testExecutor{resps: []*api.Response{{Json: []byte(`{"getPerson": []}`)}}}
+	out := resolve(t, exec, `{ getPerson(uid: "0x1") { name } }`, nil)
+	require.Equal(t, `{
+	getPerson(func: uid(0x1)) @filter(type("Person")) {
+		dgraph.type
+		name
+	}
+}`, exec.reqs[0].Query)
+	require.JSONEq(t, `{"data": {"getPerson": null}}`, out)
+}
+
+func TestResolveFilters(t *testing.T) {
+	exec := &testExecutor{resps: []*api.Response{{Json: []byte(`{}`)}}}
+	resolve(t, exec, `{
+		queryPerson(filter: {
+			uid: ["0x1", "0x2"],
+			or: [{name: {in: ["a", "b"]}}, {has: [age]}],
+			not: {name: {regexp: "/^A/i"}}
+		}) { name }
+	}`, nil)
+	require.Contains(t, exec.reqs[0].Query, `@filter((NOT (regexp(name, /^A/i))) AND `+
+		`((eq(name, ["a", "b"])) OR (has(age))) AND (uid(0x1, 0x2)))`)
+}
+
+func TestResolveRegexpFilter(t *testing.T) {
+	exec := &testExecutor{resps: []*api.Response{{Json: []byte(`{}`)}}}
+	resolve(t, exec, `query($re: String) { queryPerson(filter: {name: {regexp: $re}}) { name } }`,
+		map[string]interface{}{"re": `/a/b\/c/i`})
+	require.Contains(t, exec.reqs[0].Query, `@filter(regexp(name, /a\/b\/c/i))`)
+
+	// The slashes of the pattern are escaped, so the value can't end the regexp early.
+	exec = &testExecutor{resps: []*api.Response{{Json: []byte(`{}`)}}}
+	resolve(t, exec, `query($re: String) { queryPerson(filter: {name: {regexp: $re}}) { name } }`,
+		map[string]interface{}{"re": `/x/ } q2(func: has(secret)) { secret } #/`})
+	res, err := gql.Parse(gql.Request{Str: exec.reqs[0].Query})
+	require.NoError(t, err)
+	require.Len(t, res.Query, 1)
+
+	for _, re := range []string{
+		`/x/) } q2(func: has(password)) { password } #`,
+		`/x/i) } q2(func: has(password)) { password } #/`,
+		`^A`,
+		`/A\/`,
+		`/(/`,
+	} {
+		exec = &testExecutor{}
+		out := resolve(t, exec, `mutation($re: String) {
+			deletePerson(filter: {name: {regexp: $re}}) { numUids }
+		}`, map[string]interface{}{"re": re})
+		require.Empty(t, exec.reqs, re)
+		require.Contains(t, out, "regexp", re)
+	}
+}
+
+func TestResolveIntrospection(t *testing.T) {
+	exec := &testExecutor{}
+	out := resolve(t, exec, `{
+		__schema { queryType { name } mutationType { name } }
+		__type(name: "PersonInput") {
+			kind
+			inputFields { name type { kind name ofType { kind name } } }
+		}
+		unknown: __type(name: "Unknown") { name }
+	}`, nil)
+	require.Empty(t, exec.reqs)
+	require.JSONEq(t, `{"data": {
+		"__schema": {"queryType": {"name": "Query"}, "mutationType": {"name": "Mutation"}},
+		"__type": {"kind": "INPUT_OBJECT", "inputFields": [
+			{"name": "name", "type": {"kind": "SCALAR", "name": "String", "ofType": null}},
+			{"name": "age", "type": {"kind": "SCALAR", "name": "Int", "ofType": null}},
+			{"name": "friend", "type": {"kind": "LIST", "name": null,
+				"ofType": {"kind": "NON_NULL", "name": null}}}
+		]},
+		"unknown": null
+	}}`, out)
+}
+
+func TestResolveAdd(t *testing.T) {
+	exec := &testExecutor{resps: []*api.Response{
+		{Uids: map[string]string{"node0": "0x5"}},
+		{Json: []byte(`{"nodes": [{"dgraph.type": ["Person"], "uid": "0x5", "name": "Alice"}]}`)},
+	}}
+	out := resolve(t, exec, `mutation {
+		addPerson(input: [{name: "Alice", age: 3, friend: [{uid: "0x2"}]}]) {
+			numUids
+			person { uid name }
+		}
+	}`, nil)
+
+	require.Len(t, exec.reqs, 2)
+	require.True(t, exec.reqs[0].CommitNow)
+	require.JSONEq(t, `[{"uid": "_:node0", "dgraph.type": "Person", "name": "Alice", "age": 3,
+		"friend": [{"uid": "0x2"}]}]`, string(exec.reqs[0].Mutations[0].SetJson))
+	require.Contains(t, exec.reqs[1].Query, "nodes(func: uid(0x5))")
+	require.JSONEq(t, `{"data": {"addPerson": {"numUids": 1,
+		"person": [{"uid": "0x5", "name": "Alice"}]}}}`, out)
+}
+
+func TestResolveUpdateAndDelete(t *testing.T) {
+	exec := &testExecutor{resps: []*api.Response{
+		{Json: []byte(`{"nodes": [{"uid": "0x1"}, {"uid": "0x2"}]}`)},
+		{Json: []byte(`{"nodes": [{"uid": "0x1"}]}`)},
+	}}
+	out := resolve(t, exec, `mutation {
+		updatePerson(input: {filter: {name: {eq: "Alice"}}, set: {age: 4}, remove: {friend: null}}) {
+			numUids
+		}
+		deletePerson(filter: {uid: ["0x1"]}) { msg numUids }
+	}`, nil)
+
+	require.Len(t, exec.reqs, 2)
+	require.Equal(t, `{
+	nodes(func: type("Person")) @filter(eq(name, "Alice")) {
+		x as uid
+	}
+}`, exec.reqs[0].Query)
+	require.JSONEq(t, `{"uid": "uid(x)", "age": 4}`, string(exec.reqs[0].Mutations[0].SetJson))
+	require.JSONEq(t, `{"uid": "uid(x)", "friend": null}`,
+		string(exec.reqs[0].Mutations[0].DeleteJson))
+	require.JSONEq(t, `{"uid": "uid(x)"}`, string(exec.reqs[1].Mutations[0].DeleteJson))
+	require.JSONEq(t, `{"data": {"updatePerson": {"numUids": 2},
+		"deletePerson": {"msg": "Deleted", "numUids": 1}}}`, out)
+}
+
+func TestResolveValidationErrors(t *testing.T) {
+	tests := []struct {
+		query string
+		vars  map[string]interface{}
+		err   string
+	}{
+		{query: `{ queryPerson { secret } }`, err: `Cannot query field "secret" on type "Person"`},
+		{query: `{ queryPerson }`, err: "must have a selection of subfields"},
+		{query: `{ queryPerson { name { x } } }`, err: "must not have a selection"},
+		{query: `{ getPerson { name } }`, err: `Argument "uid" of required type "ID!"`},
+		{query: `{ queryPerson(limit: 1) { name } }`, err: `Unknown argument "limit"`},
+		{query: `{ queryPerson(first: "1") { name } }`, err: `Expected a value of type "Int"`},
+		{query: `{ queryPerson(order: {asc: secret}) { name } }`,
+			err: `Expected a value of enum "PersonOrderable"`},
+		{query: `query($n: Int!) { queryPerson(first: $n) { name } }`,
+			err: `Variable $n of required type "Int!" was not provided`},
+		{query: `{ queryPerson { ...f } }`, err: `Unknown fragment "f"`},
+		{query: `{ queryPerson { ...f } } fragment f on Person { ...f }`,
+			err: `Cannot spread fragment "f" within itself`},
+		{query: `{ queryPerson { a: name a: age } }`, err: `Fields "a" conflict`},
+		{query: `subscription { queryPerson { name } }`, err: "not supported"},
+	}
+	for _, tc := range tests {
+		resp := Resolve(context.Background(), testSchema(), &testExecutor{},
+			&Request{Query: tc.query, Variables: tc.vars})
+		require.Nil(t, resp.Data, tc.query)
+		require.Len(t, resp.Errors, 1, tc.query)
+		require.Contains(t, resp.Errors[0].Message, tc.err, tc.query)
+	}
+}
+
+func TestResolveSkipInclude(t *testing.T) {
+	exec := &testExecutor{resps: []*api.Response{{Json: []byte(`{"queryPerson": [{"name": "A"}]}`)}}}
+	out := resolve(t, exec, `query($skip: Boolean!) {
+		queryPerson { name age @skip(if: $skip) ... @include(if: false) { uid } }
+	}`, map[string]interface{}{"skip": true})
+	require.JSONEq(t, `{"data": {"queryPerson": [{"name": "A"}]}}`, out)
+}
@@ -0,0 +1,594 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/golang/glog"
+)
+
+// TypeKind is the kind of a type as reported by introspection.
+type TypeKind string
+
+// The type kinds defined by the GraphQL spec.
+const (
+	ScalarKind      TypeKind = "SCALAR"
+	ObjectKind      TypeKind = "OBJECT"
+	InterfaceKind   TypeKind = "INTERFACE"
+	UnionKind       TypeKind = "UNION"
+	EnumKind        TypeKind = "ENUM"
+	InputObjectKind TypeKind = "INPUT_OBJECT"
+	ListKind        TypeKind = "LIST"
+	NonNullKind     TypeKind = "NON_NULL"
+)
+
+// Type is a named type of a GraphQL schema.
+type Type struct {
+	Kind        TypeKind
+	Name        string
+	Description string
+	// Fields are the fields of objects and interfaces.
+	Fields []*FieldDefinition
+	// Interfaces are the interfaces implemented by an object.
+	Interfaces []string
+	// PossibleTypes are the objects implementing an interface.
+	PossibleTypes []string
+	// InputFields are the fields of input objects.
+	InputFields []*InputValue
+	EnumValues  []string
+
+	// dgraphType is the name of the Dgraph type an object was generated from.
+	dgraphType string
+}
+
+// Field returns the definition of the field with the given name or nil.
+func (t *Type) Field(name string) *FieldDefinition {
+	for _, f := range t.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// InputField returns the definition of the input field with the given name or nil.
+func (t *Type) InputField(name string) *InputValue {
+	for _, f := range t.InputFields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// fieldOp identifies how the fields of the Query and Mutation types are resolved.
+type fieldOp int
+
+const (
+	opNone fieldOp = iota
+	opGet
+	opQuery
+	opAdd
+	opUpdate
+	opDelete
+)
+
+// FieldDefinition is a field of an object or interface type.
+type FieldDefinition struct {
+	Name        string
+	Description string
+	Args        []*InputValue
+	Type        *TypeRef
+
+	// predicate is the Dgraph predicate storing the field and indexed is set if
+	// the predicate can be used in filters.
+	predicate string
+	indexed   bool
+	// op and object are set for the fields of the Query and Mutation types.
+	op     fieldOp
+	object *Type
+}
+
+// Arg returns the definition of the argument with the given name or nil.
+func (f *FieldDefinition) Arg(name string) *InputValue {
+	for _, arg := range f.Args {
+		if arg.Name == name {
+			return arg
+		}
+	}
+	return nil
+}
+
+// InputValue is an argument or a field of an input object.
+type InputValue struct {
+	Name         string
+	Description  string
+	Type         *TypeRef
+	DefaultValue string
+
+	// predicate is the Dgraph predicate the input field refers to.
+	predicate string
+}
+
+// Schema is a GraphQL schema derived from the types stored in Dgraph.
+type Schema struct {
+	types    map[string]*Type
+	query    *Type
+	mutation *Type
+}
+
+// Type returns the named type or nil if no such type exists.
+func (s *Schema) Type(name string) *Type {
+	return s.types[name]
+}
+
+// Names of the types that are always part of the schema.
+const (
+	queryTypeName    = "Query"
+	mutationTypeName = "Mutation"
+	nodeTypeName     = "Node"
+	nodeRefTypeName  = "NodeRef"
+)
+
+// scalarTypes maps the Dgraph scalar types to the GraphQL types used to represent them.
+var scalarTypes = map[string]string{
+	"default":  "String",
+	"string":   "String",
+	"int":      "Int",
+	"float":    "Float",
+	"bool":     "Boolean",
+	"datetime": "DateTime",
+	"geo":      "Geo",
+}
+
+// filterFunctions lists the functions that can be used to filter on each scalar type.
+// Whether a function can be used on a particular predicate depends on its tokenizers,
+// which is checked by Dgraph when the query is processed.
+var filterFunctions = map[string][]string{
+	"String": {"eq", "in", "le", "lt", "ge", "gt", "anyofterms", "allofterms", "anyoftext",
+		"alloftext", "regexp"},
+	"Int":      {"eq", "in", "le", "lt", "ge", "gt"},
+	"Float":    {"eq", "in", "le", "lt", "ge", "gt"},
+	"DateTime": {"eq", "in", "le", "lt", "ge", "gt"},
+	"Boolean":  {"eq"},
+}
+
+// NewSchema derives a GraphQL schema from the given Dgraph types and the schema of
+// the predicates used by them. Each Dgraph type with a valid GraphQL name becomes an
+// object implementing the Node interface, along with the input types needed to
+// query and mutate it.
+func NewSchema(types []*pb.TypeUpdate, preds []*pb.SchemaNode) *Schema {
+	s := &Schema{types: make(map[string]*Type)}
+	s.addBuiltins()
+
+	predMap := make(map[string]*pb.SchemaNode, len(preds))
+	for _, pred := range preds {
+		predMap[pred.Predicate] = pred
+	}
+
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].TypeName < types[j].TypeName
+	})
+	var objects []*Type
+	var defs []*pb.TypeUpdate
+	for _, typ := range types {
+		if !isValidName(typ.TypeName) || strings.HasPrefix(typ.TypeName, "__") {
+			continue
+		}
+		if _, ok := s.types[typ.TypeName]; ok {
+			glog.Warningf("Type %s conflicts with a built-in GraphQL type and will be ignored",
+				typ.TypeName)
+			continue
+		}
+		obj := &Type{
+			Kind:       ObjectKind,
+			Name:       typ.TypeName,
+			Interfaces: []string{nodeTypeName},
+			dgraphType: typ.TypeName,
+		}
+		s.types[obj.Name] = obj
+		objects = append(objects, obj)
+		defs = append(defs, typ)
+	}
+
+	node := s.types[nodeTypeName]
+	for i, obj := range objects {
+		node.PossibleTypes = append(node.PossibleTypes, obj.Name)
+		s.addObjectFields(obj, defs[i], predMap)
+	}
+	for _, obj := range objects {
+		s.addObjectOperations(obj)
+	}
+	return s
+}
+
+func (s *Schema) addBuiltins() {
+	for _, name := range []string{"Int", "Float", "String", "Boolean", "ID"} {
+		s.types[name] = &Type{Kind: ScalarKind, Name: name}
+	}
+	s.types["DateTime"] = &Type{
+		Kind:        ScalarKind,
+		Name:        "DateTime",
+		Description: "An RFC 3339 date and time.",
+	}
+	s.types["Geo"] = &Type{
+		Kind:        ScalarKind,
+		Name:        "Geo",
+		Description: "A GeoJSON geometry.",
+	}
+
+	s.types[nodeTypeName] = &Type{
+		Kind:        InterfaceKind,
+		Name:        nodeTypeName,
+		Description: "Node is implemented by every type stored in Dgraph.",
+		Fields:      []*FieldDefinition{uidField()},
+	}
+	s.types[nodeRefTypeName] = &Type{
+		Kind:        InputObjectKind,
+		Name:        nodeRefTypeName,
+		Description: "A reference to an existing node.",
+		InputFields: []*InputValue{{Name: "uid", Type: nonNull(named("ID"))}},
+	}
+
+	for scalar, fns := range filterFunctions {
+		filter := &Type{Kind: InputObjectKind, Name: scalar + "Filter"}
+		for _, fn := range fns {
+			typ := named(scalar)
+			if fn == "in" {
+				typ = list(nonNull(typ))
+			}
+			filter.InputFields = append(filter.InputFields, &InputValue{Name: fn, Type: typ})
+		}
+		s.types[filter.Name] = filter
+	}
+
+	s.query = &Type{Kind: ObjectKind, Name: queryTypeName}
+	s.mutation = &Type{Kind: ObjectKind, Name: mutationTypeName}
+	s.types[s.query.Name] = s.query
+	s.types[s.mutation.Name] = s.mutation
+
+	s.addIntrospectionTypes()
+}
+
+// addObjectFields adds the fields of the Dgraph type to the object. Fields whose
+// names aren't valid GraphQL names, such as reverse edges, are skipped.
+func (s *Schema) addObjectFields(obj *Type, typ *pb.TypeUpdate,
+	preds map[string]*pb.SchemaNode) {
+
+	obj.Fields = append(obj.Fields, uidField())
+	for _, field := range typ.Fields {
+		pred, ok := preds[field.Predicate]
+		if !ok || !isValidName(field.Predicate) || field.Predicate == "uid" {
+			continue
+		}
+
+		def := &FieldDefinition{
+			Name:      field.Predicate,
+			predicate: field.Predicate,
+			indexed:   pred.Index,
+		}
+		if pred.Type == "uid" {
+			target := nodeTypeName
+			if t, ok := s.types[field.ObjectTypeName]; ok && t.dgraphType != "" {
+				target = t.Name
+			}
+			def.Type = named(target)
+			if pred.List {
+				def.Type = list(def.Type)
+				def.Args = s.listArgs(target)
+			}
+		} else {
+			scalar, ok := scalarTypes[pred.Type]
+			if !ok {
+				continue
+			}
+			def.Type = named(scalar)
+			if pred.List {
+				def.Type = list(def.Type)
+			}
+		}
+		obj.Fields = append(obj.Fields, def)
+	}
+}
+
+// listArgs returns the arguments accepted by fields returning a list of the named
+// type. Filtering and ordering is only possible when the type of the objects is known.
+func (s *Schema) listArgs(typeName string) []*InputValue {
+	var args []*InputValue
+	if typeName != nodeTypeName {
+		args = append(args, &InputValue{Name: "filter", Type: named(typeName + "Filter")})
+		if _, ok := s.types[typeName+"Orderable"]; ok {
+			args = append(args, &InputValue{Name: "order", Type: named(typeName + "Order")})
+		}
+	}
+	return append(args,
+		&InputValue{Name: "first", Type: named("Int")},
+		&InputValue{Name: "offset", Type: named("Int")})
+}
+
+// addObjectOperations adds the input types used to filter, order and mutate objects
+// of the given type and the corresponding fields to the Query and Mutation types.
+func (s *Schema) addObjectOperations(obj *Type) {
+	name := obj.Name
+	filter := &Type{Kind: InputObjectKind, Name: name + "Filter"}
+	has := &Type{Kind: EnumKind, Name: name + "Field"}
+	orderable := &Type{Kind: EnumKind, Name: name + "Orderable"}
+	input := &Type{Kind: InputObjectKind, Name: name + "Input"}
+	patch := &Type{Kind: InputObjectKind, Name: name + "Patch"}
+
+	filter.InputFields = append(filter.InputFields,
+		&InputValue{Name: "uid", Type: list(nonNull(named("ID")))})
+	for _, f := range obj.Fields[1:] {
+		scalar := f.Type.NamedType()
+		has.EnumValues = append(has.EnumValues, f.Name)
+		if _, ok := filterFunctions[scalar]; ok && f.Type.Elem == nil {
+			if f.indexed {
+				filter.InputFields = append(filter.InputFields, &InputValue{
+					Name:      f.Name,
+					Type:      named(scalar + "Filter"),
+					predicate: f.predicate,
+				})
+			}
+			if scalar != "Boolean" {
+				orderable.EnumValues = append(orderable.EnumValues, f.Name)
+			}
+		}
+
+		typ := named(scalar)
+		if t := s.types[scalar]; t.Kind != ScalarKind {
+			typ = named(nodeRefTypeName)
+		}
+		if f.Type.Elem != nil {
+			typ = list(nonNull(typ))
+		}
+		in := &InputValue{Name: f.Name, Type: typ, predicate: f.predicate}
+		input.InputFields = append(input.InputFields, in)
+		patch.InputFields = append(patch.InputFields, in)
+	}
+	filter.InputFields = append(filter.InputFields,
+		&InputValue{Name: "and", Type: list(nonNull(named(filter.Name)))},
+		&InputValue{Name: "or", Type: list(nonNull(named(filter.Name)))},
+		&InputValue{Name: "not", Type: named(filter.Name)})
+	s.types[filter.Name] = filter
+
+	if len(has.EnumValues) > 0 {
+		filter.InputFields = append(filter.InputFields,
+			&InputValue{Name: "has", Type: list(nonNull(named(has.Name)))})
+		s.types[has.Name] = has
+	}
+	if len(orderable.EnumValues) > 0 {
+		order := &Type{Kind: InputObjectKind, Name: name + "Order"}
+		order.InputFields = []*InputValue{
+			{Name: "asc", Type: named(orderable.Name)},
+			{Name: "desc", Type: named(orderable.Name)},
+			{Name: "then", Type: named(order.Name)},
+		}
+		s.types[orderable.Name] = orderable
+		s.types[order.Name] = order
+	}
+	if len(input.InputFields) > 0 {
+		s.types[input.Name] = input
+		s.types[patch.Name] = patch
+	}
+
+	// Now that the filter and order types exist, fix up arguments of edges pointing to
+	// this type that were added before they were known.
+	for _, t := range s.types {
+		for _, f := range t.Fields {
+			if f.Type.Elem != nil && f.Type.NamedType() == name && f.predicate != "" {
+				f.Args = s.listArgs(name)
+			}
+		}
+	}
+
+	s.query.Fields = append(s.query.Fields, &FieldDefinition{
+		Name:   "get" + name,
+		Args:   []*InputValue{{Name: "uid", Type: nonNull(named("ID"))}},
+		Type:   named(name),
+		op:     opGet,
+		object: obj,
+	}, &FieldDefinition{
+		Name:   "query" + name,
+		Args:   s.listArgs(name),
+		Type:   list(named(name)),
+		op:     opQuery,
+		object: obj,
+	})
+
+	payload := &Type{
+		Kind: ObjectKind,
+		Name: "Add" + name + "Payload",
+		Fields: []*FieldDefinition{
+			{Name: lowerFirst(name), Args: s.listArgs(name), Type: list(named(name))},
+			{Name: "numUids", Type: named("Int")},
+		},
+	}
+	s.types[payload.Name] = payload
+	if len(input.InputFields) > 0 {
+		s.mutation.Fields = append(s.mutation.Fields, &FieldDefinition{
+			Name:   "add" + name,
+			Args:   []*InputValue{{Name: "input", Type: nonNull(list(nonNull(named(input.Name))))}},
+			Type:   named(payload.Name),
+			op:     opAdd,
+			object: obj,
+		})
+
+		update := &Type{
+			Kind: InputObjectKind,
+			Name: "Update" + name + "Input",
+			InputFields: []*InputValue{
+				{Name: "filter", Type: nonNull(named(filter.Name))},
+				{Name: "set", Type: named(patch.Name)},
+				{Name: "remove", Type: named(patch.Name)},
+			},
+		}
+		updatePayload := &Type{Kind: ObjectKind, Name: "Update" + name + "Payload",
+			Fields: payload.Fields}
+		s.types[update.Name] = update
+		s.types[updatePayload.Name] = updatePayload
+		s.mutation.Fields = append(s.mutation.Fields, &FieldDefinition{
+			Name:   "update" + name,
+			Args:   []*InputValue{{Name: "input", Type: nonNull(named(update.Name))}},
+			Type:   named(updatePayload.Name),
+			op:     opUpdate,
+			object: obj,
+		})
+	} else {
+		delete(s.types, payload.Name)
+	}
+
+	deletePayload := &Type{
+		Kind: ObjectKind,
+		Name: "Delete" + name + "Payload",
+		Fields: []*FieldDefinition{
+			{Name: "msg", Type: named("String")},
+			{Name: "numUids", Type: named("Int")},
+		},
+	}
+	s.types[deletePayload.Name] = deletePayload
+	s.mutation.Fields = append(s.mutation.Fields, &FieldDefinition{
+		Name:   "delete" + name,
+		Args:   []*InputValue{{Name: "filter", Type: nonNull(named(filter.Name))}},
+		Type:   named(deletePayload.Name),
+		op:     opDelete,
+		object: obj,
+	})
+}
+
+// String returns the schema in the GraphQL schema definition language.
+func (s *Schema) String() string {
+	var sb strings.Builder
+	sb.WriteString("schema {\n\tquery: Query\n")
+	if len(s.mutation.Fields) > 0 {
+		sb.WriteString("\tmutation: Mutation\n")
+	}
+	sb.WriteString("}\n")
+
+	for _, name := range s.typeNames() {
+		t := s.types[name]
+		if strings.HasPrefix(name, "__") || isBuiltinScalar(name) ||
+			(t == s.mutation && len(t.Fields) == 0) {
+			continue
+		}
+
+		sb.WriteString("\n")
+		writeDescription(&sb, "", t.Description)
+		switch t.Kind {
+		case ScalarKind:
+			sb.WriteString("scalar " + name + "\n")
+		case EnumKind:
+			sb.WriteString("enum " + name + " {\n")
+			for _, v := range t.EnumValues {
+				sb.WriteString("\t" + v + "\n")
+			}
+			sb.WriteString("}\n")
+		case InputObjectKind:
+			sb.WriteString("input " + name + " {\n")
+			for _, f := range t.InputFields {
+				writeDescription(&sb, "\t", f.Description)
+				sb.WriteString("\t" + f.Name + ": " + f.Type.String() + "\n")
+			}
+			sb.WriteString("}\n")
+		case ObjectKind, InterfaceKind:
+			if t.Kind == ObjectKind {
+				sb.WriteString("type " + name)
+			} else {
+				sb.WriteString("interface " + name)
+			}
+			if len(t.Interfaces) > 0 {
+				sb.WriteString(" implements " + strings.Join(t.Interfaces, " & "))
+			}
+			sb.WriteString(" {\n")
+			for _, f := range t.Fields {
+				writeDescription(&sb, "\t", f.Description)
+				sb.WriteString("\t" + f.Name)
+				if len(f.Args) > 0 {
+					args := make([]string, 0, len(f.Args))
+					for _, arg := range f.Args {
+						args = append(args, arg.Name+": "+arg.Type.String())
+					}
+					sb.WriteString("(" + strings.Join(args, ", ") + ")")
+				}
+				sb.WriteString(": " + f.Type.String() + "\n")
+			}
+			sb.WriteString("}\n")
+		}
+	}
+	return sb.String()
+}
+
+func (s *Schema) typeNames() []string {
+	names := make([]string, 0, len(s.types))
+	for name := range s.types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func writeDescription(sb *strings.Builder, indent, desc string) {
+	if desc == "" {
+		return
+	}
+	sb.WriteString(indent + `"""` + desc + `"""` + "\n")
+}
+
+func uidField() *FieldDefinition {
+	return &FieldDefinition{Name: "uid", Type: nonNull(named("ID")), predicate: "uid"}
+}
+
+func named(name string) *TypeRef {
+	return &TypeRef{Name: name}
+}
+
+func list(elem *TypeRef) *TypeRef {
+	return &TypeRef{Elem: elem}
+}
+
+func nonNull(t *TypeRef) *TypeRef {
+	c := *t
+	c.NonNull = true
+	return &c
+}
+
+func isBuiltinScalar(name string) bool {
+	switch name {
+	case "Int", "Float", "String", "Boolean", "ID":
+		return true
+	}
+	return false
+}
+
+// isValidName returns true if the name can be used for a GraphQL type or field.
+func isValidName(name string) bool {
+	if len(name) == 0 || !isNameBegin(rune(name[0])) {
+		return false
+	}
+	for _, r := range name {
+		if !isNameSuffix(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func lowerFirst(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
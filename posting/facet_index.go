@@ -0,0 +1,239 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posting
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/golang/glog"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/types/facets"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// facetRefsKey is the key of the facet of the facet index entries counting the postings of the
+// subject having the facet value, so that an entry is only deleted along with the last of them.
+const facetRefsKey = "refs"
+
+// facetIndexUpdate holds the facet index tokens of the posting of an edge before and after its
+// mutation. The index entries of a token point to the subjects having an edge with the facet value.
+type facetIndexUpdate struct {
+	add []string
+	del []string
+}
+
+// facetTokens returns the index tokens of the facets with the given keys of the posting uid.
+func (l *List) facetTokens(readTs, uid uint64, keys []string) ([]string, error) {
+	found, p, err := l.findStoredPosting(readTs, uid)
+	if err != nil || !found {
+		return nil, err
+	}
+	return facets.IndexTokens(p.Facets, keys)
+}
+
+// facetRefs returns the number of postings counted by the entry of the subject in the facet index
+// list, along with the expiration of the entry. It returns 0 if the subject has no entry.
+func (l *List) facetRefs(readTs, uid uint64) (int64, int64, error) {
+	l.RLock()
+	defer l.RUnlock()
+	found, p, err := l.findStoredPosting(readTs, uid)
+	if err != nil || !found {
+		return 0, 0, err
+	}
+	for _, f := range p.Facets {
+		if f.Key != facetRefsKey {
+			continue
+		}
+		val, err := facets.ValFor(f)
+		if err != nil {
+			return 0, 0, err
+		}
+		return val.Value.(int64), p.ExpireAt, nil
+	}
+	return 1, p.ExpireAt, nil
+}
+
+// facetRefsEdge sets the number of postings counted by the index entry of the edge.
+func facetRefsEdge(edge *pb.DirectedEdge, refs int64) error {
+	f, err := facets.ToBinary(facetRefsKey, refs, api.Facet_INT)
+	if err != nil {
+		return err
+	}
+	edge.Facets = []*api.Facet{f}
+	return nil
+}
+
+// updateFacetIndex applies the update of the facet index for the subject of the edge. Only the
+// index lists of the tokens of the update are read, to update the number of postings counted by
+// the entries of the subject. The tokens kept are added again, which updates the expiration of
+// their entries.
+func (txn *Txn) updateFacetIndex(ctx context.Context, edge *pb.DirectedEdge,
+	fu facetIndexUpdate) error {
+	diff := make(map[string]int64)
+	for _, token := range fu.del {
+		diff[token]--
+	}
+	for _, token := range fu.add {
+		diff[token]++
+	}
+	tokens := make([]string, 0, len(diff))
+	for token := range diff {
+		tokens = append(tokens, token)
+	}
+	sort.Strings(tokens)
+
+	for _, token := range tokens {
+		l, err := txn.Get(x.IndexKey(edge.Attr, token))
+		if err != nil {
+			return err
+		}
+		refs, expireAt, err := l.facetRefs(txn.StartTs, edge.Entity)
+		if err != nil {
+			return err
+		}
+		delta := diff[token]
+		indexEdge := &pb.DirectedEdge{
+			ValueId:  edge.Entity,
+			Attr:     edge.Attr,
+			Op:       pb.DirectedEdge_SET,
+			ExpireAt: edge.ExpireAt,
+		}
+		switch refs += delta; {
+		case delta < 0 && refs <= 0:
+			indexEdge.Op = pb.DirectedEdge_DEL
+			indexEdge.ExpireAt = 0
+		case delta < 0:
+			// The entry is only kept for the other postings.
+			indexEdge.ExpireAt = expireAt
+		case refs <= 0:
+			// The posting kept the value, but its entry is missing.
+			refs = 1
+		}
+		if indexEdge.Op == pb.DirectedEdge_SET {
+			if err := facetRefsEdge(indexEdge, refs); err != nil {
+				return err
+			}
+		}
+		if err := txn.addIndexMutation(ctx, indexEdge, token); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteFacetIndex deletes the index of the facet key of the given attribute.
+func deleteFacetIndex(attr, key string) error {
+	pk := x.ParsedKey{Attr: attr}
+	prefix := append(pk.IndexPrefix(), facets.IndexKeyPrefix(key)...)
+	if err := pstore.DropPrefix(prefix); err != nil {
+		return err
+	}
+
+	// Also delete all the parts of any list that has been split into multiple parts.
+	prefix = pk.IndexPrefix()
+	prefix[len(prefix)-1] = x.ByteSplit
+	prefix = append(prefix, facets.IndexKeyPrefix(key)...)
+	return pstore.DropPrefix(prefix)
+}
+
+// needsFacetIndexRebuild returns the keys of the facets whose index has to be deleted, and the
+// keys of the ones whose index has to be built.
+func (rb *IndexRebuild) needsFacetIndexRebuild() (toDelete, toBuild []string) {
+	x.AssertTruef(rb.CurrentSchema != nil, "Current schema cannot be nil.")
+
+	var old []string
+	if rb.OldSchema != nil {
+		old = rb.OldSchema.FacetIndex
+	}
+	has := func(keys []string, key string) bool {
+		for _, k := range keys {
+			if k == key {
+				return true
+			}
+		}
+		return false
+	}
+	for _, key := range old {
+		if !has(rb.CurrentSchema.FacetIndex, key) {
+			toDelete = append(toDelete, key)
+		}
+	}
+	for _, key := range rb.CurrentSchema.FacetIndex {
+		if !has(old, key) {
+			toBuild = append(toBuild, key)
+		}
+	}
+	return toDelete, toBuild
+}
+
+// rebuildFacetIndex deletes the index of the facets which are no longer indexed, and builds the
+// index of the newly indexed ones.
+func rebuildFacetIndex(ctx context.Context, rb *IndexRebuild) error {
+	toDelete, toBuild := rb.needsFacetIndexRebuild()
+	for _, key := range append(toDelete, toBuild...) {
+		glog.Infof("Deleting index of facet %s of %s", key, rb.Attr)
+		if err := deleteFacetIndex(rb.Attr, key); err != nil {
+			return err
+		}
+	}
+	if len(toBuild) == 0 {
+		return nil
+	}
+
+	glog.Infof("Rebuilding index of facets %v of %s", toBuild, rb.Attr)
+	pk := x.ParsedKey{Attr: rb.Attr}
+	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs}
+	builder.fn = func(uid uint64, pl *List, txn *Txn) error {
+		// The entries count the postings of the subject having the value.
+		refs := make(map[string]int64)
+		expireAt := make(map[string]int64)
+		err := pl.Iterate(txn.StartTs, 0, func(p *pb.Posting) error {
+			tokens, err := facets.IndexTokens(p.Facets, toBuild)
+			for _, token := range tokens {
+				refs[token]++
+				expireAt[token] = p.ExpireAt
+			}
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		for token, n := range refs {
+			edge := &pb.DirectedEdge{ValueId: uid, Attr: rb.Attr, Op: pb.DirectedEdge_SET,
+				ExpireAt: expireAt[token]}
+			if err := facetRefsEdge(edge, n); err != nil {
+				return err
+			}
+			for {
+				err := txn.addIndexMutation(ctx, edge, token)
+				if err != ErrRetry {
+					if err != nil {
+						return err
+					}
+					break
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+		}
+		return nil
+	}
+	return builder.Run(ctx)
+}
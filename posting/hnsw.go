@@ -0,0 +1,471 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posting
+
+import (
+	"container/heap"
+	"context"
+	"encoding/binary"
+	"math"
+	"sort"
+	"sync"
+
+	farm "github.com/dgryski/go-farm"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// The hnsw index of a predicate is a hierarchical navigable small world graph: every node is
+// linked to the nodes with the closest vectors, at level 0 and at each level up to the top level
+// of the node. Searches go from the sparse top levels down to level 0, getting closer to the
+// query vector at every step. The graph is stored in index posting lists of the hnsw tokenizer,
+// holding the neighbors of a node at a level, and the nodes whose top level is a level.
+const (
+	// hnswM is the number of neighbors a node is linked to when it's inserted. Nodes can have up
+	// to hnswM neighbors at the upper levels, and twice as many at level 0.
+	hnswM              = 16
+	hnswMaxLevel       = 16
+	hnswEfConstruction = 100
+	hnswEfSearch       = 64
+)
+
+var (
+	// hnswLocks serializes the updates of the index of each predicate, since inserting a node
+	// reads and updates the neighbors of many others.
+	hnswLocks   = make(map[string]*sync.Mutex)
+	hnswLocksMu sync.Mutex
+)
+
+func hnswLock(attr string) *sync.Mutex {
+	hnswLocksMu.Lock()
+	defer hnswLocksMu.Unlock()
+	mu, ok := hnswLocks[attr]
+	if !ok {
+		mu = &sync.Mutex{}
+		hnswLocks[attr] = mu
+	}
+	return mu
+}
+
+func hnswLevelKey(attr string, level int) []byte {
+	return x.IndexKey(attr, string([]byte{tok.IdentHNSW, 'l', byte(level)}))
+}
+
+func hnswNeighborsKey(attr string, level int, uid uint64) []byte {
+	token := make([]byte, 11)
+	token[0], token[1], token[2] = tok.IdentHNSW, 'n', byte(level)
+	binary.BigEndian.PutUint64(token[3:], uid)
+	return x.IndexKey(attr, string(token))
+}
+
+// hnswLevel returns the top level of the node. Levels follow a geometric distribution, with one
+// node in hnswM reaching the next level. They're derived from the uid, so they don't need to be
+// stored.
+func hnswLevel(uid uint64) int {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uid)
+	// A uniform number in (0, 1].
+	u := (float64(farm.Fingerprint64(b[:])>>11) + 1) / (1 << 53)
+	level := int(-math.Log(u) / math.Log(hnswM))
+	if level > hnswMaxLevel {
+		level = hnswMaxLevel
+	}
+	return level
+}
+
+func squaredDistance(a, b []float32) float64 {
+	var sum float64
+	for i := range a {
+		d := float64(a[i]) - float64(b[i])
+		sum += d * d
+	}
+	return sum
+}
+
+// VectorDistance returns the euclidean distance between the vectors.
+func VectorDistance(a, b []float32) (float64, error) {
+	if len(a) != len(b) {
+		return 0, errors.Errorf("Vectors of %d and %d dimensions can't be compared",
+			len(a), len(b))
+	}
+	return math.Sqrt(squaredDistance(a, b)), nil
+}
+
+type candidate struct {
+	uid  uint64
+	dist float64
+}
+
+// candidateQueue is a min-heap of candidates, closest first.
+type candidateQueue []candidate
+
+func (q candidateQueue) Len() int            { return len(q) }
+func (q candidateQueue) Less(i, j int) bool  { return q[i].dist < q[j].dist }
+func (q candidateQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *candidateQueue) Push(c interface{}) { *q = append(*q, c.(candidate)) }
+func (q *candidateQueue) Pop() interface{} {
+	old := *q
+	c := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return c
+}
+
+// hnsw reads the graph of a predicate at a timestamp.
+type hnsw struct {
+	attr   string
+	readTs uint64
+	get    func(key []byte) (*List, error)
+	// vectors caches the vectors read while searching the graph. Deleted nodes have nil vectors.
+	vectors map[uint64][]float32
+}
+
+func newHnsw(attr string, readTs uint64, cache *LocalCache) *hnsw {
+	return &hnsw{
+		attr:    attr,
+		readTs:  readTs,
+		get:     cache.Get,
+		vectors: make(map[uint64][]float32),
+	}
+}
+
+func (h *hnsw) uids(key []byte) ([]uint64, error) {
+	pl, err := h.get(key)
+	if err != nil {
+		return nil, err
+	}
+	list, err := pl.Uids(ListOptions{ReadTs: h.readTs})
+	if err != nil {
+		return nil, err
+	}
+	return list.Uids, nil
+}
+
+func (h *hnsw) vector(uid uint64) ([]float32, error) {
+	if v, ok := h.vectors[uid]; ok {
+		return v, nil
+	}
+	pl, err := h.get(x.DataKey(h.attr, uid))
+	if err != nil {
+		return nil, err
+	}
+	val, err := pl.Value(h.readTs)
+	switch {
+	case err == ErrNoValue:
+		h.vectors[uid] = nil
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	v, err := types.Convert(val, types.VFloatID)
+	if err != nil {
+		return nil, err
+	}
+	h.vectors[uid] = v.Value.([]float32)
+	return h.vectors[uid], nil
+}
+
+// entryPoint returns a node of the highest level of the graph and its level, or -1 if the graph
+// is empty.
+func (h *hnsw) entryPoint() (uint64, int, error) {
+	for level := hnswMaxLevel; level >= 0; level-- {
+		uids, err := h.uids(hnswLevelKey(h.attr, level))
+		if err != nil {
+			return 0, -1, err
+		}
+		for _, uid := range uids {
+			v, err := h.vector(uid)
+			if err != nil {
+				return 0, -1, err
+			}
+			if v != nil {
+				return uid, level, nil
+			}
+		}
+	}
+	return 0, -1, nil
+}
+
+// searchLayer returns the ef closest nodes to the vector found at the level, starting from the
+// entry points, closest first.
+func (h *hnsw) searchLayer(ctx context.Context, vec []float32, entries []candidate, ef,
+	level int) ([]candidate, error) {
+
+	visited := make(map[uint64]bool)
+	queue := &candidateQueue{}
+	var results []candidate
+	add := func(c candidate) {
+		heap.Push(queue, c)
+		i := sort.Search(len(results), func(i int) bool { return results[i].dist > c.dist })
+		results = append(results, candidate{})
+		copy(results[i+1:], results[i:])
+		results[i] = c
+		if len(results) > ef {
+			results = results[:ef]
+		}
+	}
+	for _, e := range entries {
+		visited[e.uid] = true
+		add(e)
+	}
+
+	for queue.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		c := heap.Pop(queue).(candidate)
+		if len(results) >= ef && c.dist > results[len(results)-1].dist {
+			break
+		}
+		neighbors, err := h.uids(hnswNeighborsKey(h.attr, level, c.uid))
+		if err != nil {
+			return nil, err
+		}
+		for _, uid := range neighbors {
+			if visited[uid] {
+				continue
+			}
+			visited[uid] = true
+			v, err := h.vector(uid)
+			if err != nil {
+				return nil, err
+			}
+			if len(v) != len(vec) {
+				// The node was deleted.
+				continue
+			}
+			d := squaredDistance(vec, v)
+			if len(results) < ef || d < results[len(results)-1].dist {
+				add(candidate{uid: uid, dist: d})
+			}
+		}
+	}
+	return results, nil
+}
+
+// search returns the k nodes of the graph closest to the vector, closest first, looking at ef
+// candidates at level 0.
+func (h *hnsw) search(ctx context.Context, vec []float32, k, ef int) ([]candidate, error) {
+	entry, top, err := h.entryPoint()
+	if err != nil || top < 0 {
+		return nil, err
+	}
+	ev, err := h.vector(entry)
+	if err != nil {
+		return nil, err
+	}
+	if len(ev) != len(vec) {
+		return nil, errors.Errorf("Vector of %d dimensions can't be compared to vectors of %d "+
+			"dimensions of predicate %s", len(vec), len(ev), h.attr)
+	}
+
+	eps := []candidate{{uid: entry, dist: squaredDistance(vec, ev)}}
+	for level := top; level > 0; level-- {
+		if eps, err = h.searchLayer(ctx, vec, eps, 1, level); err != nil {
+			return nil, err
+		}
+	}
+	if ef < k {
+		ef = k
+	}
+	res, err := h.searchLayer(ctx, vec, eps, ef, 0)
+	if len(res) > k {
+		res = res[:k]
+	}
+	return res, err
+}
+
+// SearchVectorIndex returns the uids of the k nodes with the vectors of the predicate closest to
+// the given vector, closest first, using the hnsw index of the predicate. The result is
+// approximate.
+func SearchVectorIndex(ctx context.Context, cache *LocalCache, attr string, readTs uint64,
+	vec []float32, k int) ([]uint64, error) {
+
+	h := newHnsw(attr, readTs, cache)
+	res, err := h.search(ctx, vec, k, hnswEfSearch)
+	if err != nil {
+		return nil, err
+	}
+	uids := make([]uint64, 0, len(res))
+	for _, c := range res {
+		uids = append(uids, c.uid)
+	}
+	return uids, nil
+}
+
+func (txn *Txn) setHnswEdge(ctx context.Context, key []byte, uid uint64,
+	op pb.DirectedEdge_Op) error {
+
+	pl, err := txn.cache.Get(key)
+	if err != nil {
+		return err
+	}
+	pk, err := x.Parse(key)
+	if err != nil {
+		return err
+	}
+	return pl.addMutation(ctx, txn, &pb.DirectedEdge{ValueId: uid, Attr: pk.Attr, Op: op})
+}
+
+// addVectorIndexMutation inserts the node in the hnsw index of its predicate, or removes it.
+func (txn *Txn) addVectorIndexMutation(ctx context.Context, info *indexMutationInfo) error {
+	attr := info.edge.Attr
+	uid := info.edge.Entity
+	mu := hnswLock(attr)
+	mu.Lock()
+	defer mu.Unlock()
+
+	// The lists are read from disk, unlike the other index lists which are only written.
+	h := newHnsw(attr, txn.StartTs, txn.cache)
+	if info.op == pb.DirectedEdge_DEL {
+		return h.remove(ctx, txn, uid)
+	}
+	v, err := types.Convert(info.val, types.VFloatID)
+	if err != nil {
+		return err
+	}
+	return h.insert(ctx, txn, uid, v.Value.([]float32))
+}
+
+func (h *hnsw) insert(ctx context.Context, txn *Txn, uid uint64, vec []float32) error {
+	entry, top, err := h.entryPoint()
+	if err != nil {
+		return err
+	}
+	level := hnswLevel(uid)
+	if err := txn.setHnswEdge(ctx, hnswLevelKey(h.attr, level), uid,
+		pb.DirectedEdge_SET); err != nil {
+		return err
+	}
+	h.vectors[uid] = vec
+	if top < 0 || entry == uid {
+		return nil
+	}
+	ev, err := h.vector(entry)
+	if err != nil {
+		return err
+	}
+	if len(ev) != len(vec) {
+		return errors.Errorf("Vector of %d dimensions can't be indexed with vectors of %d "+
+			"dimensions of predicate %s", len(vec), len(ev), h.attr)
+	}
+
+	eps := []candidate{{uid: entry, dist: squaredDistance(vec, ev)}}
+	for l := top; l > level; l-- {
+		if eps, err = h.searchLayer(ctx, vec, eps, 1, l); err != nil {
+			return err
+		}
+	}
+	if level < top {
+		top = level
+	}
+	for l := top; l >= 0; l-- {
+		if eps, err = h.searchLayer(ctx, vec, eps, hnswEfConstruction, l); err != nil {
+			return err
+		}
+		linked := 0
+		for _, c := range eps {
+			if linked == hnswM {
+				break
+			}
+			if c.uid == uid {
+				continue
+			}
+			if err := txn.setHnswEdge(ctx, hnswNeighborsKey(h.attr, l, uid), c.uid,
+				pb.DirectedEdge_SET); err != nil {
+				return err
+			}
+			if err := txn.setHnswEdge(ctx, hnswNeighborsKey(h.attr, l, c.uid), uid,
+				pb.DirectedEdge_SET); err != nil {
+				return err
+			}
+			if err := h.prune(ctx, txn, c.uid, l); err != nil {
+				return err
+			}
+			linked++
+		}
+	}
+	return nil
+}
+
+// prune unlinks the node from its farthest neighbors at the level, if it has too many.
+func (h *hnsw) prune(ctx context.Context, txn *Txn, uid uint64, level int) error {
+	max := hnswM
+	if level == 0 {
+		max = 2 * hnswM
+	}
+	key := hnswNeighborsKey(h.attr, level, uid)
+	neighbors, err := h.uids(key)
+	if err != nil || len(neighbors) <= max {
+		return err
+	}
+	vec, err := h.vector(uid)
+	if err != nil {
+		return err
+	}
+
+	cands := make([]candidate, 0, len(neighbors))
+	for _, n := range neighbors {
+		v, err := h.vector(n)
+		if err != nil {
+			return err
+		}
+		// Deleted nodes are unlinked first.
+		d := math.Inf(1)
+		if len(v) == len(vec) {
+			d = squaredDistance(vec, v)
+		}
+		cands = append(cands, candidate{uid: n, dist: d})
+	}
+	sort.Slice(cands, func(i, j int) bool { return cands[i].dist < cands[j].dist })
+	for _, c := range cands[max:] {
+		if err := txn.setHnswEdge(ctx, key, c.uid, pb.DirectedEdge_DEL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// remove unlinks the node from the graph. The nodes which were linked to it, but weren't its
+// neighbors, keep a link to it until they're pruned; searches skip it since it has no vector.
+func (h *hnsw) remove(ctx context.Context, txn *Txn, uid uint64) error {
+	level := hnswLevel(uid)
+	if err := txn.setHnswEdge(ctx, hnswLevelKey(h.attr, level), uid,
+		pb.DirectedEdge_DEL); err != nil {
+		return err
+	}
+	for l := 0; l <= level; l++ {
+		key := hnswNeighborsKey(h.attr, l, uid)
+		neighbors, err := h.uids(key)
+		if err != nil {
+			return err
+		}
+		for _, n := range neighbors {
+			if err := txn.setHnswEdge(ctx, hnswNeighborsKey(h.attr, l, n), uid,
+				pb.DirectedEdge_DEL); err != nil {
+				return err
+			}
+			if err := txn.setHnswEdge(ctx, key, n, pb.DirectedEdge_DEL); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posting
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestHnswLevel(t *testing.T) {
+	counts := make([]int, hnswMaxLevel+1)
+	for uid := uint64(1); uid <= 10000; uid++ {
+		level := hnswLevel(uid)
+		require.Equal(t, level, hnswLevel(uid))
+		counts[level]++
+	}
+	// About one node in hnswM reaches the next level.
+	require.InDelta(t, 10000*(hnswM-1)/hnswM, counts[0], 200)
+	require.True(t, counts[1] > counts[2])
+}
+
+func TestVectorDistance(t *testing.T) {
+	d, err := VectorDistance([]float32{1, 2}, []float32{4, 6})
+	require.NoError(t, err)
+	require.Equal(t, 5.0, d)
+	_, err = VectorDistance([]float32{1, 2}, []float32{1})
+	require.Error(t, err)
+}
+
+// nearest returns the k uids of the grid closest to the vector, by brute force.
+func nearest(grid map[uint64][]float32, vec []float32, k int) []uint64 {
+	var uids []uint64
+	for uid := range grid {
+		uids = append(uids, uid)
+	}
+	sort.Slice(uids, func(i, j int) bool {
+		return squaredDistance(grid[uids[i]], vec) < squaredDistance(grid[uids[j]], vec)
+	})
+	return uids[:k]
+}
+
+func TestVectorIndex(t *testing.T) {
+	// The nodes are the points of a 10x10 grid.
+	grid := make(map[uint64][]float32)
+	for i := 0; i < 100; i++ {
+		uid := uint64(1000 + i)
+		grid[uid] = []float32{float32(i / 10), float32(i % 10)}
+		addEdgeToValue(t, "emb", uid, fmt.Sprintf("[%d, %d]", i/10, i%10),
+			uint64(2*i+1), uint64(2*i+2))
+	}
+
+	require.NoError(t, schema.ParseBytes([]byte("emb: float32vector @index(hnsw) ."), 1))
+	currentSchema, _ := schema.State().Get("emb")
+	rb := IndexRebuild{
+		Attr:          "emb",
+		StartTs:       201,
+		CurrentSchema: &currentSchema,
+	}
+	require.NoError(t, rebuildIndex(context.Background(), &rb))
+
+	ctx := context.Background()
+	for _, vec := range [][]float32{{3.2, 4.1}, {0.1, 0.3}, {8.6, 1.7}, {20, 19}} {
+		uids, err := SearchVectorIndex(ctx, NewLocalCache(202), "emb", 202, vec, 3)
+		require.NoError(t, err)
+		require.Equal(t, nearest(grid, vec, 3), uids, "%v", vec)
+	}
+	_, err := SearchVectorIndex(ctx, NewLocalCache(202), "emb", 202, []float32{1}, 3)
+	require.Error(t, err)
+
+	// Once deleted, the closest node isn't found anymore.
+	l, err := GetNoStore(x.DataKey("emb", 1034))
+	require.NoError(t, err)
+	addMutation(t, l, &pb.DirectedEdge{
+		Value:  []byte("[3, 4]"),
+		Attr:   "emb",
+		Entity: 1034,
+	}, Del, 203, 204, true)
+	delete(grid, 1034)
+
+	vec := []float32{3.2, 4.1}
+	uids, err := SearchVectorIndex(ctx, NewLocalCache(205), "emb", 205, vec, 3)
+	require.NoError(t, err)
+	require.Equal(t, nearest(grid, vec, 3), uids)
+}
@@ -22,9 +22,11 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math"
+	"sync/atomic"
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
 	ostats "go.opencensus.io/stats"
 	otrace "go.opencensus.io/trace"
 
@@ -34,6 +36,7 @@ import (
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/types/facets"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/pkg/errors"
 )
@@ -58,7 +61,7 @@ func indexTokens(info *indexMutationInfo) ([]string, error) {
 		return nil, errors.Errorf("Cannot index attribute %s of type object.", attr)
 	}
 
-	if !schema.State().IsIndexed(attr) {
+	if !schema.State().IsIndexedForMutation(attr) {
 		return nil, errors.Errorf("Attribute %s is not indexed.", attr)
 	}
 	sv, err := types.Convert(info.val, schemaType)
@@ -82,7 +85,7 @@ func indexTokens(info *indexMutationInfo) ([]string, error) {
 // TODO - See if we need to pass op as argument as t should already have Op.
 func (txn *Txn) addIndexMutations(ctx context.Context, info *indexMutationInfo) error {
 	if info.tokenizers == nil {
-		info.tokenizers = schema.State().Tokenizer(info.edge.Attr)
+		info.tokenizers = schema.State().MutationTokenizer(info.edge.Attr)
 	}
 
 	attr := info.edge.Attr
@@ -97,9 +100,10 @@ func (txn *Txn) addIndexMutations(ctx context.Context, info *indexMutationInfo)
 
 	// Create a value token -> uid edge.
 	edge := &pb.DirectedEdge{
-		ValueId: uid,
-		Attr:    attr,
-		Op:      info.op,
+		ValueId:  uid,
+		Attr:     attr,
+		Op:       info.op,
+		ExpireAt: info.edge.ExpireAt,
 	}
 
 	for _, token := range tokens {
@@ -107,6 +111,13 @@ func (txn *Txn) addIndexMutations(ctx context.Context, info *indexMutationInfo)
 			return err
 		}
 	}
+
+	// The hnsw tokenizer doesn't build tokens, the node is linked to the graph of the index.
+	for _, it := range info.tokenizers {
+		if it.Identifier() == tok.IdentHNSW {
+			return txn.addVectorIndexMutation(ctx, info)
+		}
+	}
 	return nil
 }
 
@@ -143,7 +154,9 @@ func (txn *Txn) addReverseMutationHelper(ctx context.Context, plist *List,
 	countBefore, countAfter := 0, 0
 
 	if hasCountIndex {
-		countBefore = plist.Length(txn.StartTs, 0)
+		plist.RLock()
+		countBefore = plist.storedLength(txn.StartTs, 0)
+		plist.RUnlock()
 		if countBefore == -1 {
 			return emptyCountParams, ErrTsTooOld
 		}
@@ -152,7 +165,9 @@ func (txn *Txn) addReverseMutationHelper(ctx context.Context, plist *List,
 		return emptyCountParams, err
 	}
 	if hasCountIndex {
-		countAfter = plist.Length(txn.StartTs, 0)
+		plist.RLock()
+		countAfter = plist.storedLength(txn.StartTs, 0)
+		plist.RUnlock()
 		if countAfter == -1 {
 			return emptyCountParams, ErrTsTooOld
 		}
@@ -188,11 +203,12 @@ func (txn *Txn) addReverseMutation(ctx context.Context, t *pb.DirectedEdge) erro
 	x.AssertTrue(plist != nil)
 	// We must create a copy here.
 	edge := &pb.DirectedEdge{
-		Entity:  t.ValueId,
-		ValueId: t.Entity,
-		Attr:    t.Attr,
-		Op:      t.Op,
-		Facets:  t.Facets,
+		Entity:   t.ValueId,
+		ValueId:  t.Entity,
+		Attr:     t.Attr,
+		Op:       t.Op,
+		Facets:   t.Facets,
+		ExpireAt: t.ExpireAt,
 	}
 
 	cp, err := txn.addReverseMutationHelper(ctx, plist, hasCountIndex, edge)
@@ -212,17 +228,28 @@ func (txn *Txn) addReverseMutation(ctx context.Context, t *pb.DirectedEdge) erro
 func (l *List) handleDeleteAll(ctx context.Context, edge *pb.DirectedEdge,
 	txn *Txn) error {
 	isReversed := schema.State().IsReversed(edge.Attr)
-	isIndexed := schema.State().IsIndexed(edge.Attr)
+	isIndexed := schema.State().IsIndexedForMutation(edge.Attr)
 	hasCount := schema.State().HasCount(edge.Attr)
 	delEdge := &pb.DirectedEdge{
 		Attr:   edge.Attr,
 		Op:     edge.Op,
 		Entity: edge.Entity,
 	}
+	facetKeys := schema.State().FacetIndexes(edge.Attr)
+	var fu facetIndexUpdate
 	// To calculate length of posting list. Used for deletion of count index.
+	// The expired postings are deleted too, along with their index entries.
 	var plen int
-	err := l.Iterate(txn.StartTs, 0, func(p *pb.Posting) error {
+	l.RLock()
+	err := l.iterateAll(txn.StartTs, 0, func(p *pb.Posting) error {
 		plen++
+		if len(facetKeys) > 0 {
+			tokens, err := facets.IndexTokens(p.Facets, facetKeys)
+			if err != nil {
+				return err
+			}
+			fu.del = append(fu.del, tokens...)
+		}
 		switch {
 		case isReversed:
 			// Delete reverse edge for each posting.
@@ -235,7 +262,7 @@ func (l *List) handleDeleteAll(ctx context.Context, edge *pb.DirectedEdge,
 				Value: p.Value,
 			}
 			return txn.addIndexMutations(ctx, &indexMutationInfo{
-				tokenizers: schema.State().Tokenizer(edge.Attr),
+				tokenizers: schema.State().MutationTokenizer(edge.Attr),
 				edge:       edge,
 				val:        val,
 				op:         pb.DirectedEdge_DEL,
@@ -244,9 +271,13 @@ func (l *List) handleDeleteAll(ctx context.Context, edge *pb.DirectedEdge,
 			return nil
 		}
 	})
+	l.RUnlock()
 	if err != nil {
 		return err
 	}
+	if err := txn.updateFacetIndex(ctx, edge, fu); err != nil {
+		return err
+	}
 	if hasCount {
 		// Delete uid from count index. Deletion of reverses is taken care by addReverseMutation
 		// above.
@@ -303,9 +334,11 @@ func (txn *Txn) updateCount(ctx context.Context, params countParams) error {
 }
 
 func (txn *Txn) addMutationHelper(ctx context.Context, l *List, doUpdateIndex bool,
-	hasCountIndex bool, t *pb.DirectedEdge) (types.Val, bool, countParams, error) {
+	hasCountIndex bool, facetKeys []string, t *pb.DirectedEdge) (types.Val, bool, countParams,
+	facetIndexUpdate, error) {
 	var val types.Val
 	var found bool
+	var fu facetIndexUpdate
 	var err error
 
 	t1 := time.Now()
@@ -319,14 +352,14 @@ func (txn *Txn) addMutationHelper(ctx context.Context, l *List, doUpdateIndex bo
 	}
 
 	if err := l.canMutateUid(txn, t); err != nil {
-		return val, found, emptyCountParams, err
+		return val, found, emptyCountParams, fu, err
 	}
 
 	if doUpdateIndex {
 		// Check original value BEFORE any mutation actually happens.
-		val, found, err = l.findValue(txn.StartTs, fingerprintEdge(t))
+		val, found, err = l.findStoredValue(txn.StartTs, fingerprintEdge(t))
 		if err != nil {
-			return val, found, emptyCountParams, err
+			return val, found, emptyCountParams, fu, err
 		}
 	}
 
@@ -334,9 +367,9 @@ func (txn *Txn) addMutationHelper(ctx context.Context, l *List, doUpdateIndex bo
 	// a value that does not match the existing value.
 	if !schema.State().IsList(t.Attr) && t.Op == pb.DirectedEdge_DEL && string(t.Value) != x.Star {
 		newPost := NewPosting(t)
-		pFound, currPost, err := l.findPosting(txn.StartTs, fingerprintEdge(t))
+		pFound, currPost, err := l.findStoredPosting(txn.StartTs, fingerprintEdge(t))
 		if err != nil {
-			return val, found, emptyCountParams, err
+			return val, found, emptyCountParams, fu, err
 		}
 
 		// This is a scalar value of non-list type and a delete edge mutation, so if the value
@@ -347,33 +380,53 @@ func (txn *Txn) addMutationHelper(ctx context.Context, l *List, doUpdateIndex bo
 		// return found to be true.
 		if pFound && !(bytes.Equal(currPost.Value, newPost.Value) &&
 			types.TypeID(currPost.ValType) == types.TypeID(newPost.ValType)) {
-			return val, false, emptyCountParams, nil
+			return val, false, emptyCountParams, fu, nil
+		}
+	}
+
+	var uid uint64
+	var facetsBefore []string
+	if len(facetKeys) > 0 {
+		// The uid of the posting written by the edge (see addMutationInternal).
+		uid = t.ValueId
+		if len(t.Lang) > 0 || t.ValueId == 0 {
+			uid = fingerprintEdge(t)
+		}
+		if facetsBefore, err = l.facetTokens(txn.StartTs, uid, facetKeys); err != nil {
+			return val, found, emptyCountParams, fu, err
 		}
 	}
 
 	countBefore, countAfter := 0, 0
 	if hasCountIndex {
-		countBefore = l.length(txn.StartTs, 0)
+		countBefore = l.storedLength(txn.StartTs, 0)
 		if countBefore == -1 {
-			return val, found, emptyCountParams, ErrTsTooOld
+			return val, found, emptyCountParams, fu, ErrTsTooOld
 		}
 	}
 	if err = l.addMutationInternal(ctx, txn, t); err != nil {
-		return val, found, emptyCountParams, err
+		return val, found, emptyCountParams, fu, err
+	}
+	if len(facetKeys) > 0 {
+		facetsAfter, err := l.facetTokens(txn.StartTs, uid, facetKeys)
+		if err != nil {
+			return val, found, emptyCountParams, fu, err
+		}
+		fu = facetIndexUpdate{add: facetsAfter, del: facetsBefore}
 	}
 	if hasCountIndex {
-		countAfter = l.length(txn.StartTs, 0)
+		countAfter = l.storedLength(txn.StartTs, 0)
 		if countAfter == -1 {
-			return val, found, emptyCountParams, ErrTsTooOld
+			return val, found, emptyCountParams, fu, ErrTsTooOld
 		}
 		return val, found, countParams{
 			attr:        t.Attr,
 			countBefore: countBefore,
 			countAfter:  countAfter,
 			entity:      t.Entity,
-		}, nil
+		}, fu, nil
 	}
-	return val, found, emptyCountParams, nil
+	return val, found, emptyCountParams, fu, nil
 }
 
 // AddMutationWithIndex is addMutation with support for indexing. It also
@@ -389,9 +442,14 @@ func (l *List) AddMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge,
 		return l.handleDeleteAll(ctx, edge, txn)
 	}
 
-	doUpdateIndex := pstore != nil && schema.State().IsIndexed(edge.Attr)
+	doUpdateIndex := pstore != nil && schema.State().IsIndexedForMutation(edge.Attr)
 	hasCountIndex := schema.State().HasCount(edge.Attr)
-	val, found, cp, err := txn.addMutationHelper(ctx, l, doUpdateIndex, hasCountIndex, edge)
+	var facetKeys []string
+	if pstore != nil {
+		facetKeys = schema.State().FacetIndexes(edge.Attr)
+	}
+	val, found, cp, fu, err := txn.addMutationHelper(ctx, l, doUpdateIndex, hasCountIndex,
+		facetKeys, edge)
 	if err != nil {
 		return err
 	}
@@ -401,11 +459,14 @@ func (l *List) AddMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge,
 			return err
 		}
 	}
+	if err := txn.updateFacetIndex(ctx, edge, fu); err != nil {
+		return err
+	}
 	if doUpdateIndex {
 		// Exact matches.
 		if found && val.Value != nil {
 			if err := txn.addIndexMutations(ctx, &indexMutationInfo{
-				tokenizers: schema.State().Tokenizer(edge.Attr),
+				tokenizers: schema.State().MutationTokenizer(edge.Attr),
 				edge:       edge,
 				val:        val,
 				op:         pb.DirectedEdge_DEL,
@@ -413,13 +474,15 @@ func (l *List) AddMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge,
 				return err
 			}
 		}
-		if edge.Op == pb.DirectedEdge_SET {
+		// The values of a partial index are indexed once all the edges of the mutation are
+		// applied, depending on the condition of their node (see UpdatePartialIndexes).
+		if edge.Op == pb.DirectedEdge_SET && schema.State().IndexCondition(edge.Attr) == nil {
 			val = types.Val{
 				Tid:   types.TypeID(edge.ValueType),
 				Value: edge.Value,
 			}
 			if err := txn.addIndexMutations(ctx, &indexMutationInfo{
-				tokenizers: schema.State().Tokenizer(edge.Attr),
+				tokenizers: schema.State().MutationTokenizer(edge.Attr),
 				edge:       edge,
 				val:        val,
 				op:         pb.DirectedEdge_SET,
@@ -438,6 +501,123 @@ func (l *List) AddMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge,
 	return nil
 }
 
+// UpdatePartialIndexes adds or deletes the index entries of the values of the predicates with a
+// partial index, for the nodes whose values or whose condition are changed by the edges. It must
+// be called once all the edges are applied, so that the values and the conditions checked are
+// the ones left by the mutation.
+func (txn *Txn) UpdatePartialIndexes(ctx context.Context, edges []*pb.DirectedEdge) error {
+	type node struct {
+		attr string
+		uid  uint64
+	}
+	seen := make(map[node]struct{})
+	var nodes []node
+	add := func(attr string, uid uint64) {
+		n := node{attr: attr, uid: uid}
+		if _, ok := seen[n]; !ok {
+			seen[n] = struct{}{}
+			nodes = append(nodes, n)
+		}
+	}
+	// The predicates whose partial index is affected by the edges of each predicate.
+	affected := make(map[string][]string)
+	for _, edge := range edges {
+		attrs, ok := affected[edge.Attr]
+		if !ok {
+			attrs = schema.State().PartialIndexesOn(edge.Attr)
+			if schema.State().IndexCondition(edge.Attr) != nil {
+				attrs = append(attrs, edge.Attr)
+			}
+			affected[edge.Attr] = attrs
+		}
+		for _, attr := range attrs {
+			add(attr, edge.Entity)
+		}
+	}
+
+	for _, n := range nodes {
+		for {
+			err := txn.updatePartialIndex(ctx, n.attr, n.uid)
+			if err == nil {
+				break
+			}
+			if err != ErrRetry {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// updatePartialIndex adds the index entries of the values of attr for the node if it matches
+// the condition of the partial index, and deletes them otherwise.
+func (txn *Txn) updatePartialIndex(ctx context.Context, attr string, uid uint64) error {
+	where := schema.State().IndexCondition(attr)
+	if where == nil || !schema.State().IsIndexedForMutation(attr) {
+		return nil
+	}
+	matches, err := txn.matchesIndexCondition(where, uid)
+	if err != nil {
+		return err
+	}
+	op := pb.DirectedEdge_DEL
+	if matches {
+		op = pb.DirectedEdge_SET
+	}
+
+	l, err := txn.Get(x.DataKey(attr, uid))
+	if err != nil {
+		return err
+	}
+	return l.Iterate(txn.StartTs, 0, func(p *pb.Posting) error {
+		return txn.addIndexMutations(ctx, &indexMutationInfo{
+			tokenizers: schema.State().MutationTokenizer(attr),
+			edge: &pb.DirectedEdge{
+				Attr:     attr,
+				Entity:   uid,
+				Lang:     string(p.LangTag),
+				ExpireAt: p.ExpireAt,
+			},
+			val: types.Val{Tid: types.TypeID(p.ValType), Value: p.Value},
+			op:  op,
+		})
+	})
+}
+
+// matchesIndexCondition returns true if the node matches the condition of a partial index, as
+// seen by the transaction.
+func (txn *Txn) matchesIndexCondition(where *pb.IndexCondition, uid uint64) (bool, error) {
+	l, err := txn.Get(x.DataKey(where.Predicate, uid))
+	if err != nil {
+		return false, err
+	}
+	typ, err := schema.State().TypeOf(where.Predicate)
+	if err != nil {
+		// The predicate has no value yet.
+		return false, nil
+	}
+	var want types.Val
+	if where.FuncName == "eq" {
+		src := types.Val{Tid: types.StringID, Value: []byte(where.Value)}
+		if want, err = types.Convert(src, typ); err != nil {
+			return false, err
+		}
+	}
+
+	var matches bool
+	err = l.Iterate(txn.StartTs, 0, func(p *pb.Posting) error {
+		if where.FuncName == "eq" {
+			val, err := types.Convert(types.Val{Tid: types.TypeID(p.ValType), Value: p.Value}, typ)
+			if err != nil || !types.CompareVals("eq", val, want) {
+				return nil
+			}
+		}
+		matches = true
+		return ErrStopIteration
+	})
+	return matches, err
+}
+
 // deleteTokensFor deletes the index for the given attribute and token.
 func deleteTokensFor(attr, tokenizerName string) error {
 	pk := x.ParsedKey{Attr: attr}
@@ -612,7 +792,10 @@ func (rb *IndexRebuild) Run(ctx context.Context) error {
 	if err := rebuildReverseEdges(ctx, rb); err != nil {
 		return err
 	}
-	return rebuildCountIndex(ctx, rb)
+	if err := rebuildCountIndex(ctx, rb); err != nil {
+		return err
+	}
+	return rebuildFacetIndex(ctx, rb)
 }
 
 type indexRebuildInfo struct {
@@ -663,6 +846,15 @@ func (rb *IndexRebuild) needsIndexRebuild() indexRebuildInfo {
 		}
 	}
 
+	// All tokenizers need to be rebuilt too if the condition of the partial index has changed.
+	if !proto.Equal(rb.CurrentSchema.Where, old.Where) {
+		return indexRebuildInfo{
+			op:                  indexRebuild,
+			tokenizersToDelete:  old.Tokenizer,
+			tokenizersToRebuild: rb.CurrentSchema.Tokenizer,
+		}
+	}
+
 	// Index needs to be rebuilt if the tokenizers have changed
 	prevTokens := make(map[string]struct{})
 	for _, t := range old.Tokenizer {
@@ -730,13 +922,29 @@ func rebuildIndex(ctx context.Context, rb *IndexRebuild) error {
 	if err != nil {
 		return err
 	}
+	return buildIndex(ctx, rb.Attr, rb.StartTs, tokenizers, rb.CurrentSchema.Where, nil)
+}
 
-	pk := x.ParsedKey{Attr: rb.Attr}
-	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs}
+// buildIndex adds the index entries of the tokenizers for the values of attr as of startTs,
+// only for the nodes matching the condition where, if it's not nil. The nodes indexed so far
+// are counted in indexed, if it's not nil.
+func buildIndex(ctx context.Context, attr string, startTs uint64, tokenizers []tok.Tokenizer,
+	where *pb.IndexCondition, indexed *uint64) error {
+	pk := x.ParsedKey{Attr: attr}
+	builder := rebuilder{attr: attr, prefix: pk.DataPrefix(), startTs: startTs}
 	builder.fn = func(uid uint64, pl *List, txn *Txn) error {
-		edge := pb.DirectedEdge{Attr: rb.Attr, Entity: uid}
+		if indexed != nil {
+			defer atomic.AddUint64(indexed, 1)
+		}
+		if where != nil {
+			if matches, err := txn.matchesIndexCondition(where, uid); err != nil || !matches {
+				return err
+			}
+		}
+		edge := pb.DirectedEdge{Attr: attr, Entity: uid}
 		return pl.Iterate(txn.StartTs, 0, func(p *pb.Posting) error {
 			// Add index entries based on p.
+			edge.ExpireAt = p.ExpireAt
 			val := types.Val{
 				Value: p.Value,
 				Tid:   types.TypeID(p.ValType),
@@ -761,6 +969,67 @@ func rebuildIndex(ctx context.Context, rb *IndexRebuild) error {
 	return builder.Run(ctx)
 }
 
+// BackgroundTokenizers returns the tokenizers added by the schema change if the index entries
+// of these tokenizers are all that has to be built, which can be done while the predicate keeps
+// being mutated (see IndexBuild). It returns nil if anything else has to be rebuilt.
+func (rb *IndexRebuild) BackgroundTokenizers() []string {
+	// The entries of a partial index depend on the condition, which is only checked while
+	// rebuilding the index.
+	if rb.OldSchema == nil || rb.CurrentSchema.Where != nil {
+		return nil
+	}
+	old, cur := *rb.OldSchema, *rb.CurrentSchema
+	if old.Directive == pb.SchemaUpdate_NONE && cur.Directive == pb.SchemaUpdate_INDEX {
+		old.Directive = cur.Directive
+	}
+	old.Tokenizer = cur.Tokenizer
+	if !proto.Equal(&old, &cur) {
+		return nil
+	}
+
+	info := rb.needsIndexRebuild()
+	if info.op != indexRebuild || len(info.tokenizersToDelete) > 0 {
+		return nil
+	}
+	return info.tokenizersToRebuild
+}
+
+// IndexBuild builds the index entries of new tokenizers of a predicate in the background. The
+// values are read as of StartTs, and the entries are written at StartTs, while the mutations
+// committed after StartTs add their own entries for the tokenizers. So, the mutations of the
+// predicate must use a schema with the tokenizers before the build is prepared, and the list
+// rollups must not go past StartTs until the build is done.
+type IndexBuild struct {
+	Attr       string
+	StartTs    uint64
+	Tokenizers []string
+
+	// Indexed counts the nodes indexed so far. It's updated atomically while the build runs.
+	Indexed uint64
+}
+
+// Prepare deletes the entries left for the tokenizers by a previous build. It must be called
+// before the mutations using the tokenizers are applied.
+func (b *IndexBuild) Prepare() error {
+	for _, tokenizer := range b.Tokenizers {
+		if err := deleteTokensFor(b.Attr, tokenizer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run adds the index entries for the values of the predicate as of StartTs.
+func (b *IndexBuild) Run(ctx context.Context) error {
+	tokenizers, err := tok.GetTokenizers(b.Tokenizers)
+	if err != nil {
+		return err
+	}
+	glog.Infof("Building index for attr %s and tokenizers %s in background", b.Attr,
+		b.Tokenizers)
+	return buildIndex(ctx, b.Attr, b.StartTs, tokenizers, nil, &b.Indexed)
+}
+
 func (rb *IndexRebuild) needsCountIndexRebuild() indexOp {
 	x.AssertTruef(rb.CurrentSchema != nil, "Current schema cannot be nil.")
 
@@ -899,6 +1168,7 @@ func rebuildReverseEdges(ctx context.Context, rb *IndexRebuild) error {
 			edge.Op = pb.DirectedEdge_SET
 			edge.Facets = pp.Facets
 			edge.Label = pp.Label
+			edge.ExpireAt = pp.ExpireAt
 
 			for {
 				err := txn.addReverseMutation(ctx, &edge)
@@ -985,6 +1255,120 @@ func rebuildListType(ctx context.Context, rb *IndexRebuild) error {
 	return builder.Run(ctx)
 }
 
+// Migration holds the info needed to move the values of a predicate into another predicate, or
+// to convert them in place, to the value type of the schema of the target predicate.
+type Migration struct {
+	From    string
+	To      string
+	StartTs uint64
+
+	// Migrated counts the nodes whose values have been migrated so far. It's updated atomically
+	// while the migration runs.
+	Migrated uint64
+}
+
+// Run writes the values of the predicate From, converted to the value type of the schema of
+// To, under the predicate To at StartTs. The schema of To must have been set already. Nothing
+// is written if any of the values can't be converted. The index, reverse and count entries of
+// To are left to IndexRebuild.
+func (m *Migration) Run(ctx context.Context) error {
+	typ, err := schema.State().TypeOf(m.To)
+	if err != nil {
+		return err
+	}
+	rename := m.From != m.To
+
+	pk := x.ParsedKey{Attr: m.From}
+	builder := rebuilder{attr: m.From, prefix: pk.DataPrefix(), startTs: m.StartTs}
+	builder.fn = func(uid uint64, pl *List, txn *Txn) error {
+		var edges []*pb.DirectedEdge
+		err := pl.Iterate(txn.StartTs, 0, func(p *pb.Posting) error {
+			if !rename && (p.PostingType == pb.Posting_REF || types.TypeID(p.ValType) == typ) {
+				// The posting has the right type already.
+				return nil
+			}
+			edge, err := migratedEdge(m.To, uid, p, typ)
+			if err != nil {
+				return errors.Wrapf(err, "while migrating the value of %s for uid %#x",
+					m.From, uid)
+			}
+			if !rename {
+				// The converted value might get a different value id, delete the old one.
+				edges = append(edges, &pb.DirectedEdge{
+					Attr:    m.To,
+					Entity:  uid,
+					ValueId: p.Uid,
+					Op:      pb.DirectedEdge_DEL,
+				})
+			}
+			edges = append(edges, edge)
+			return nil
+		})
+		if err != nil || len(edges) == 0 {
+			return err
+		}
+
+		if rename {
+			if pl, err = txn.GetFromDelta(x.DataKey(m.To, uid)); err != nil {
+				return err
+			}
+		} else {
+			// Ensure that list is in the cache run by txn. Otherwise, nothing would
+			// get updated.
+			pl = txn.cache.SetIfAbsent(string(pl.key), pl)
+		}
+		for _, edge := range edges {
+			if err := pl.addMutation(ctx, txn, edge); err != nil {
+				return err
+			}
+		}
+		atomic.AddUint64(&m.Migrated, 1)
+		return nil
+	}
+	return builder.Run(ctx)
+}
+
+// migratedEdge returns the edge setting the value of the posting, converted to the type typ, for
+// the predicate attr.
+func migratedEdge(attr string, uid uint64, p *pb.Posting, typ types.TypeID) (*pb.DirectedEdge,
+	error) {
+	edge := &pb.DirectedEdge{
+		Attr:     attr,
+		Entity:   uid,
+		Lang:     string(p.LangTag),
+		Label:    p.Label,
+		Facets:   p.Facets,
+		ExpireAt: p.ExpireAt,
+		Op:       pb.DirectedEdge_SET,
+	}
+	if p.PostingType == pb.Posting_REF {
+		if typ != types.UidID {
+			return nil, errors.Errorf("Cannot convert uid %#x to type %s", p.Uid, typ.Name())
+		}
+		edge.ValueId = p.Uid
+		edge.ValueType = pb.Posting_UID
+		return edge, nil
+	}
+
+	if types.TypeID(p.ValType) == typ {
+		edge.Value = p.Value
+		edge.ValueType = p.ValType
+		return edge, nil
+	}
+	src := types.Val{Tid: types.TypeID(p.ValType), Value: p.Value}
+	dst, err := types.Convert(src, typ)
+	if err != nil {
+		return nil, err
+	}
+	b := types.ValueForType(types.BinaryID)
+	if err := types.Marshal(dst, &b); err != nil {
+		return nil, err
+	}
+	edge.Value = b.Value.([]byte)
+	edge.ValueType = typ.Enum()
+	return edge, nil
+}
+
 // DeleteAll deletes all entries in the posting list.
 func DeleteAll() error {
 	return pstore.DropAll()
@@ -995,6 +1379,33 @@ func DeleteData() error {
 	return pstore.DropPrefix([]byte{x.DefaultPrefix})
 }
 
+// DeleteNamespace deletes all entries and indices for the predicates of the namespace ns.
+// Unless the schema is kept, the schema of the predicates and the types of the namespace are
+// deleted too.
+func DeleteNamespace(ctx context.Context, ns uint64, keepSchema bool) error {
+	glog.Infof("Dropping namespace: [%d]", ns)
+	for _, attr := range schema.State().NamespacePredicates(ns) {
+		if keepSchema {
+			if err := pstore.DropPrefix(x.PredicatePrefix(attr)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := DeletePredicate(ctx, attr); err != nil {
+			return err
+		}
+	}
+	if keepSchema {
+		return nil
+	}
+	for _, typeName := range schema.State().NamespaceTypes(ns) {
+		if err := schema.State().DeleteType(typeName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // DeletePredicate deletes all entries and indices for a given predicate.
 func DeletePredicate(ctx context.Context, attr string) error {
 	glog.Infof("Dropping predicate: [%s]", attr)
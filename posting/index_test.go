@@ -24,11 +24,13 @@ import (
 	"time"
 
 	"github.com/dgraph-io/badger/v2"
+	"github.com/dgraph-io/dgo/v2/protos/api"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/types/facets"
 	"github.com/dgraph-io/dgraph/x"
 )
 
@@ -202,6 +204,53 @@ func TestTokensTable(t *testing.T) {
 	require.EqualValues(t, []string{"\x01david"}, tokensForTest("name"))
 }
 
+func TestIndexExpiredValue(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte("session: string @index(exact) @ttl(1h) ."), 1))
+	tokens, err := indexTokensForTest("session", "",
+		types.Val{Tid: types.StringID, Value: []byte("abc")})
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	indexKey := x.IndexKey("session", tokens[0])
+
+	l, err := GetNoStore(x.DataKey("session", 5))
+	require.NoError(t, err)
+	addMutation(t, l, &pb.DirectedEdge{
+		Value:    []byte("abc"),
+		Attr:     "session",
+		Entity:   5,
+		ExpireAt: time.Now().Add(-time.Second).Unix(),
+	}, Set, 301, 302, true)
+
+	// Both the value and its index entry are hidden.
+	_, err = l.Value(303)
+	require.Equal(t, ErrNoValue, err)
+	il, err := GetNoStore(indexKey)
+	require.NoError(t, err)
+	require.Empty(t, uids(il, 303))
+	expired, err := il.ExpiredPostings(303, time.Now())
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+
+	// Deleting the expired value deletes its index entry.
+	l, err = GetNoStore(x.DataKey("session", 5))
+	require.NoError(t, err)
+	addMutation(t, l, &pb.DirectedEdge{
+		Value:  []byte("abc"),
+		Attr:   "session",
+		Entity: 5,
+	}, Del, 303, 304, true)
+	il, err = GetNoStore(indexKey)
+	require.NoError(t, err)
+	expired, err = il.ExpiredPostings(305, time.Now())
+	require.NoError(t, err)
+	require.Empty(t, expired)
+	l, err = GetNoStore(x.DataKey("session", 5))
+	require.NoError(t, err)
+	expired, err = l.ExpiredPostings(305, time.Now())
+	require.NoError(t, err)
+	require.Empty(t, expired)
+}
+
 // tokensForTest returns keys for a table. This is just for testing / debugging.
 func tokensForTest(attr string) []string {
 	pk := x.ParsedKey{Attr: attr}
@@ -415,6 +464,247 @@ func TestRebuildReverseEdges(t *testing.T) {
 	require.EqualValues(t, 1, uids1[0])
 }
 
+func TestBackgroundTokenizers(t *testing.T) {
+	old := &pb.SchemaUpdate{Predicate: "name", ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"exact"}}
+	for _, test := range []struct {
+		current    pb.SchemaUpdate
+		tokenizers []string
+	}{
+		{pb.SchemaUpdate{Predicate: "name", ValueType: pb.Posting_STRING,
+			Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"exact", "term"}},
+			[]string{"term"}},
+		{pb.SchemaUpdate{Predicate: "name", ValueType: pb.Posting_STRING,
+			Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"term"}}, nil},
+		{pb.SchemaUpdate{Predicate: "name", ValueType: pb.Posting_STRING,
+			Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"exact", "term"}, Count: true},
+			nil},
+		{pb.SchemaUpdate{Predicate: "name", ValueType: pb.Posting_DEFAULT,
+			Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"exact", "term"}}, nil},
+	} {
+		rb := IndexRebuild{Attr: "name", OldSchema: old, CurrentSchema: &test.current}
+		require.Equal(t, test.tokenizers, rb.BackgroundTokenizers(), "%+v", test.current)
+	}
+
+	rb := IndexRebuild{
+		Attr:      "name",
+		OldSchema: &pb.SchemaUpdate{Predicate: "name", ValueType: pb.Posting_STRING},
+		CurrentSchema: &pb.SchemaUpdate{Predicate: "name", ValueType: pb.Posting_STRING,
+			Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"exact"}},
+	}
+	require.Equal(t, []string{"exact"}, rb.BackgroundTokenizers())
+}
+
+func TestIndexBuild(t *testing.T) {
+	addEdgeToValue(t, "name3", 91, "Michonne", uint64(1), uint64(2))
+
+	schema.State().Set("name3", pb.SchemaUpdate{Predicate: "name3", ValueType: pb.Posting_STRING})
+	schema.State().SetIndexing("name3", pb.SchemaUpdate{Predicate: "name3",
+		ValueType: pb.Posting_STRING, Directive: pb.SchemaUpdate_INDEX,
+		Tokenizer: []string{"exact"}})
+	defer schema.State().DoneIndexing("name3")
+	require.False(t, schema.State().IsIndexed("name3"))
+
+	build := IndexBuild{Attr: "name3", StartTs: 3, Tokenizers: []string{"exact"}}
+	require.NoError(t, build.Prepare())
+
+	// A mutation committed while the index is being built adds its own entry.
+	l, err := GetNoStore(x.DataKey("name3", 92))
+	require.NoError(t, err)
+	addMutation(t, l, &pb.DirectedEdge{
+		Value:  []byte("David"),
+		Attr:   "name3",
+		Entity: 92,
+	}, Set, 4, 5, true)
+
+	require.NoError(t, build.Run(context.Background()))
+	require.EqualValues(t, 1, build.Indexed)
+
+	for uid, token := range map[uint64]string{91: "Michonne", 92: "David"} {
+		l, err := GetNoStore(x.IndexKey("name3", "\x02"+token))
+		require.NoError(t, err)
+		require.Equal(t, []uint64{uid}, uids(l, 6), token)
+	}
+}
+
+// applyEdges applies the edges in a transaction, like the mutations proposed to a group.
+func applyEdges(t *testing.T, edges []*pb.DirectedEdge, startTs, commitTs uint64) {
+	txn := Oracle().RegisterStartTs(startTs)
+	for _, edge := range edges {
+		l, err := txn.Get(x.DataKey(edge.Attr, edge.Entity))
+		require.NoError(t, err)
+		require.NoError(t, l.AddMutationWithIndex(context.Background(), edge, txn))
+	}
+	require.NoError(t, txn.UpdatePartialIndexes(context.Background(), edges))
+
+	txn.Update()
+	writer := NewTxnWriter(pstore)
+	require.NoError(t, txn.CommitToDisk(writer, commitTs))
+	require.NoError(t, writer.Flush())
+}
+
+func TestPartialIndex(t *testing.T) {
+	addEdgeToValue(t, "active3", 91, "true", uint64(1), uint64(2))
+	addEdgeToValue(t, "active3", 92, "false", uint64(1), uint64(2))
+	addEdgeToValue(t, "email3", 91, "alice@dgraph.io", uint64(1), uint64(2))
+	addEdgeToValue(t, "email3", 92, "bob@dgraph.io", uint64(1), uint64(2))
+
+	schema.State().Set("active3", pb.SchemaUpdate{Predicate: "active3",
+		ValueType: pb.Posting_BOOL})
+	email := pb.SchemaUpdate{Predicate: "email3", ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"exact"},
+		Where: &pb.IndexCondition{FuncName: "eq", Predicate: "active3", Value: "true"}}
+	schema.State().Set("email3", email)
+	defer schema.State().Delete("email3")
+	require.Equal(t, []string{"email3"}, schema.State().PartialIndexesOn("active3"))
+
+	indexed := func(email string, readTs uint64) []uint64 {
+		l, err := GetNoStore(x.IndexKey("email3", "\x02"+email))
+		require.NoError(t, err)
+		return uids(l, readTs)
+	}
+
+	// Only the values of the active nodes are indexed.
+	rb := IndexRebuild{Attr: "email3", StartTs: 3, CurrentSchema: &email}
+	require.NoError(t, rebuildIndex(context.Background(), &rb))
+	require.Equal(t, []uint64{91}, indexed("alice@dgraph.io", 4))
+	require.Empty(t, indexed("bob@dgraph.io", 4))
+
+	// The values set on inactive nodes aren't indexed.
+	applyEdges(t, []*pb.DirectedEdge{
+		{Attr: "email3", Entity: 92, Value: []byte("carol@dgraph.io"), Op: pb.DirectedEdge_SET},
+	}, 5, 6)
+	require.Empty(t, indexed("carol@dgraph.io", 7))
+
+	// Changing the condition of the nodes indexes or unindexes their values.
+	applyEdges(t, []*pb.DirectedEdge{
+		{Attr: "active3", Entity: 91, Value: []byte("false"), Op: pb.DirectedEdge_SET},
+		{Attr: "active3", Entity: 92, Value: []byte("true"), Op: pb.DirectedEdge_SET},
+	}, 8, 9)
+	require.Empty(t, indexed("alice@dgraph.io", 10))
+	require.Equal(t, []uint64{92}, indexed("carol@dgraph.io", 10))
+}
+
+func TestFacetIndex(t *testing.T) {
+	since := func(val string) []*api.Facet {
+		f, err := facets.FacetFor("since", val)
+		require.NoError(t, err)
+		return []*api.Facet{f}
+	}
+	friend := func(entity, friend uint64, fs []*api.Facet) *pb.DirectedEdge {
+		return &pb.DirectedEdge{Attr: "friend3", Entity: entity, ValueId: friend,
+			ValueType: pb.Posting_UID, Facets: fs, Op: pb.DirectedEdge_SET}
+	}
+	indexed := func(val string, readTs uint64) []uint64 {
+		v, err := facets.ValFor(since(val)[0])
+		require.NoError(t, err)
+		token, err := facets.IndexToken("since", v)
+		require.NoError(t, err)
+		l, err := GetNoStore(x.IndexKey("friend3", token))
+		require.NoError(t, err)
+		return uids(l, readTs)
+	}
+
+	schema.State().Set("friend3", pb.SchemaUpdate{Predicate: "friend3",
+		ValueType: pb.Posting_UID, List: true})
+	applyEdges(t, []*pb.DirectedEdge{
+		friend(91, 1, since("2019-01-01")),
+		friend(91, 2, since("2019-01-01")),
+		friend(92, 3, since("2018-05-01")),
+		friend(93, 4, nil),
+	}, 1, 2)
+
+	// The index is built for the edges already there.
+	su := pb.SchemaUpdate{Predicate: "friend3", ValueType: pb.Posting_UID, List: true,
+		FacetIndex: []string{"since"}}
+	rb := IndexRebuild{Attr: "friend3", StartTs: 3, CurrentSchema: &su}
+	require.NoError(t, rebuildFacetIndex(context.Background(), &rb))
+	schema.State().Set("friend3", su)
+	defer schema.State().Delete("friend3")
+	require.Equal(t, []uint64{91}, indexed("2019-01-01", 4))
+	require.Equal(t, []uint64{92}, indexed("2018-05-01", 4))
+	refs := func(val string, readTs uint64) int64 {
+		v, err := facets.ValFor(since(val)[0])
+		require.NoError(t, err)
+		token, err := facets.IndexToken("since", v)
+		require.NoError(t, err)
+		l, err := GetNoStore(x.IndexKey("friend3", token))
+		require.NoError(t, err)
+		n, _, err := l.facetRefs(readTs, 91)
+		require.NoError(t, err)
+		return n
+	}
+	require.Equal(t, int64(2), refs("2019-01-01", 4))
+
+	// The subject is still indexed while another edge has the facet value.
+	del := friend(91, 1, nil)
+	del.Op = pb.DirectedEdge_DEL
+	applyEdges(t, []*pb.DirectedEdge{del}, 5, 6)
+	require.Equal(t, []uint64{91}, indexed("2019-01-01", 7))
+	require.Equal(t, int64(1), refs("2019-01-01", 7))
+
+	// Changing the facet of the last edge with the value moves the subject.
+	applyEdges(t, []*pb.DirectedEdge{friend(91, 2, since("2018-05-01"))}, 8, 9)
+	require.Empty(t, indexed("2019-01-01", 10))
+	require.Equal(t, []uint64{91, 92}, indexed("2018-05-01", 10))
+
+	// Deleting all the edges of a subject deletes its entries.
+	delAll := &pb.DirectedEdge{Attr: "friend3", Entity: 92, Value: []byte(x.Star),
+		Op: pb.DirectedEdge_DEL}
+	applyEdges(t, []*pb.DirectedEdge{delAll}, 11, 12)
+	require.Equal(t, []uint64{91}, indexed("2018-05-01", 13))
+}
+
+func TestMigration(t *testing.T) {
+	addEdgeToValue(t, "age2", 91, "42", uint64(1), uint64(2))
+	addEdgeToValue(t, "age2", 92, "7", uint64(3), uint64(4))
+
+	schema.State().Set("age2", pb.SchemaUpdate{Predicate: "age2", ValueType: pb.Posting_INT})
+	m := Migration{From: "age2", To: "age2", StartTs: 5}
+	require.NoError(t, m.Run(context.Background()))
+	require.EqualValues(t, 2, m.Migrated)
+
+	l, err := GetNoStore(x.DataKey("age2", 91))
+	require.NoError(t, err)
+	val, err := l.Value(6)
+	require.NoError(t, err)
+	require.Equal(t, types.IntID, val.Tid)
+	val, err = types.Convert(val, types.IntID)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), val.Value)
+
+	// Renaming converts the values into the new predicate.
+	schema.State().Set("height2", pb.SchemaUpdate{Predicate: "height2",
+		ValueType: pb.Posting_FLOAT})
+	m = Migration{From: "age2", To: "height2", StartTs: 7}
+	require.NoError(t, m.Run(context.Background()))
+
+	l, err = GetNoStore(x.DataKey("height2", 92))
+	require.NoError(t, err)
+	val, err = l.Value(8)
+	require.NoError(t, err)
+	require.Equal(t, types.FloatID, val.Tid)
+	val, err = types.Convert(val, types.FloatID)
+	require.NoError(t, err)
+	require.Equal(t, float64(7), val.Value)
+}
+
+func TestMigrationConversionError(t *testing.T) {
+	addEdgeToValue(t, "code2", 91, "12", uint64(1), uint64(2))
+	addEdgeToValue(t, "code2", 92, "twelve", uint64(3), uint64(4))
+
+	schema.State().Set("code2", pb.SchemaUpdate{Predicate: "code2", ValueType: pb.Posting_INT})
+	m := Migration{From: "code2", To: "code2", StartTs: 5}
+	require.Error(t, m.Run(context.Background()))
+
+	// None of the values have been written.
+	l, err := GetNoStore(x.DataKey("code2", 91))
+	require.NoError(t, err)
+	val, err := l.Value(6)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultID, val.Tid)
+}
+
 func TestNeedsIndexRebuild(t *testing.T) {
 	rb := IndexRebuild{}
 	rb.OldSchema = &pb.SchemaUpdate{ValueType: pb.Posting_UID}
@@ -467,6 +757,17 @@ func TestNeedsIndexRebuild(t *testing.T) {
 	require.Equal(t, indexOp(indexDelete), rebuildInfo.op)
 	require.Equal(t, []string{"exact"}, rebuildInfo.tokenizersToDelete)
 	require.Equal(t, []string(nil), rebuildInfo.tokenizersToRebuild)
+
+	rb.OldSchema = &pb.SchemaUpdate{ValueType: pb.Posting_STRING, Directive: pb.SchemaUpdate_INDEX,
+		Tokenizer: []string{"exact"}}
+	rb.CurrentSchema = &pb.SchemaUpdate{ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX,
+		Tokenizer: []string{"exact"},
+		Where:     &pb.IndexCondition{FuncName: "has", Predicate: "active"}}
+	rebuildInfo = rb.needsIndexRebuild()
+	require.Equal(t, indexOp(indexRebuild), rebuildInfo.op)
+	require.Equal(t, []string{"exact"}, rebuildInfo.tokenizersToDelete)
+	require.Equal(t, []string{"exact"}, rebuildInfo.tokenizersToRebuild)
 }
 
 func TestNeedsCountIndexRebuild(t *testing.T) {
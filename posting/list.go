@@ -23,6 +23,7 @@ import (
 	"math"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/dgryski/go-farm"
 
@@ -59,6 +60,10 @@ const (
 
 	// BitSchemaPosting signals that the value stores a schema or type.
 	BitSchemaPosting byte = 0x01
+	// BitCDCEntry signals that the value stores the changes made by a committed transaction.
+	BitCDCEntry byte = 0x02
+	// BitTimeIndex signals that the value stores the max assigned timestamp at a given time.
+	BitTimeIndex byte = 0x20
 	// BitDeltaPosting signals that the value stores the delta of a posting list.
 	BitDeltaPosting byte = 0x04
 	// BitCompletePosting signals that the values stores a complete posting list.
@@ -295,6 +300,7 @@ func NewPosting(t *pb.DirectedEdge) *pb.Posting {
 		Label:       t.Label,
 		Op:          op,
 		Facets:      t.Facets,
+		ExpireAt:    t.ExpireAt,
 	}
 	return p
 }
@@ -595,7 +601,26 @@ func (l *List) pickPostings(readTs uint64) (uint64, []*pb.Posting) {
 	return deleteBelowTs, posts
 }
 
+// isExpired returns whether the posting has expired at the given unix time.
+func isExpired(p *pb.Posting, now int64) bool {
+	return p.ExpireAt > 0 && p.ExpireAt <= now
+}
+
+// iterate calls f with the postings visible at readTs, skipping the ones which had expired by
+// the time readTs was assigned, so that reads at readTs are repeatable.
 func (l *List) iterate(readTs uint64, afterUid uint64, f func(obj *pb.Posting) error) error {
+	now := o.TimeAt(readTs)
+	return l.iterateAll(readTs, afterUid, func(p *pb.Posting) error {
+		if isExpired(p, now) {
+			return nil
+		}
+		return f(p)
+	})
+}
+
+// iterateAll is like iterate, but it also calls f with the expired postings. These are kept in
+// the list until they're deleted along with their index entries.
+func (l *List) iterateAll(readTs uint64, afterUid uint64, f func(obj *pb.Posting) error) error {
 	l.AssertRLock()
 
 	deleteBelowTs, mposts := l.pickPostings(readTs)
@@ -703,6 +728,20 @@ func (l *List) length(readTs, afterUid uint64) int {
 	return count
 }
 
+// storedLength is like length, but it also counts the expired postings.
+func (l *List) storedLength(readTs, afterUid uint64) int {
+	l.AssertRLock()
+	count := 0
+	err := l.iterateAll(readTs, afterUid, func(p *pb.Posting) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		return -1
+	}
+	return count
+}
+
 // Length iterates over the mutation layer and counts number of elements.
 func (l *List) Length(readTs, afterUid uint64) int {
 	l.RLock()
@@ -840,7 +879,8 @@ func (l *List) rollup(readTs uint64) (*rollupOutput, error) {
 		initializeSplit()
 	}
 
-	err := l.iterate(readTs, 0, func(p *pb.Posting) error {
+	// Expired postings are kept until they're deleted, so their index entries get deleted too.
+	err := l.iterateAll(readTs, 0, func(p *pb.Posting) error {
 		if p.Uid > endUid {
 			plist.Pack = enc.Done()
 			out.parts[startUid] = plist
@@ -850,7 +890,8 @@ func (l *List) rollup(readTs uint64) (*rollupOutput, error) {
 		}
 
 		enc.Add(p.Uid)
-		if p.Facets != nil || p.PostingType != pb.Posting_REF || len(p.Label) != 0 {
+		if p.Facets != nil || p.PostingType != pb.Posting_REF || len(p.Label) != 0 ||
+			p.ExpireAt != 0 {
 			plist.Postings = append(plist.Postings, p)
 		}
 		return nil
@@ -901,7 +942,8 @@ func (l *List) Uids(opt ListOptions) (*pb.List, error) {
 	// Use approximate length for initial capacity.
 	res := make([]uint64, 0, len(l.mutationMap)+codec.ApproxLen(l.plist.Pack))
 	out := &pb.List{}
-	if len(l.mutationMap) == 0 && opt.Intersect != nil && len(l.plist.Splits) == 0 {
+	if len(l.mutationMap) == 0 && opt.Intersect != nil && len(l.plist.Splits) == 0 &&
+		!l.hasExpiringUids() {
 		if opt.ReadTs < l.minTs {
 			l.RUnlock()
 			return out, ErrTsTooOld
@@ -930,6 +972,32 @@ func (l *List) Uids(opt ListOptions) (*pb.List, error) {
 	return out, nil
 }
 
+// hasExpiringUids returns whether some uids of the immutable layer can expire, in which case the
+// pack alone doesn't tell which uids are visible.
+func (l *List) hasExpiringUids() bool {
+	for _, p := range l.plist.Postings {
+		if p.ExpireAt != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpiredPostings returns the postings which are visible at readTs but expired at the given time.
+func (l *List) ExpiredPostings(readTs uint64, now time.Time) ([]*pb.Posting, error) {
+	l.RLock()
+	defer l.RUnlock()
+
+	var expired []*pb.Posting
+	err := l.iterateAll(readTs, 0, func(p *pb.Posting) error {
+		if isExpired(p, now.Unix()) {
+			expired = append(expired, p)
+		}
+		return nil
+	})
+	return expired, err
+}
+
 // Postings calls postFn with the postings that are common with
 // UIDs in the opt ListOptions.
 func (l *List) Postings(opt ListOptions, postFn func(*pb.Posting) error) error {
@@ -1124,6 +1192,31 @@ func (l *List) findValue(readTs, uid uint64) (rval types.Val, found bool, err er
 	return valueToTypesVal(p), true, nil
 }
 
+// findStoredValue is like findValue, but it also finds the value if it has expired.
+func (l *List) findStoredValue(readTs, uid uint64) (rval types.Val, found bool, err error) {
+	l.AssertRLock()
+	found, p, err := l.findStoredPosting(readTs, uid)
+	if !found {
+		return rval, found, err
+	}
+
+	return valueToTypesVal(p), true, nil
+}
+
+// findStoredPosting is like findPosting, but it also finds the posting if it has expired.
+func (l *List) findStoredPosting(readTs uint64, uid uint64) (found bool, pos *pb.Posting,
+	err error) {
+	err = l.iterateAll(readTs, uid-1, func(p *pb.Posting) error {
+		if p.Uid == uid {
+			pos = p
+			found = true
+		}
+		return ErrStopIteration
+	})
+
+	return found, pos, err
+}
+
 func (l *List) findPosting(readTs uint64, uid uint64) (found bool, pos *pb.Posting, err error) {
 	// Iterate starts iterating after the given argument, so we pass UID - 1
 	err = l.iterate(readTs, uid-1, func(p *pb.Posting) error {
@@ -25,6 +25,7 @@ import (
 	"sort"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/dgraph-io/badger/v2"
 	bpb "github.com/dgraph-io/badger/v2/pb"
@@ -190,6 +191,62 @@ func TestAddMutation_Value(t *testing.T) {
 	checkValue(t, ol, "119", txn.StartTs)
 }
 
+func TestExpiredPostings(t *testing.T) {
+	key := x.DataKey("expiring", 1)
+	ol, err := getNew(key, ps)
+	require.NoError(t, err)
+	past := time.Now().Add(-time.Minute).Unix()
+	future := time.Now().Add(time.Hour).Unix()
+
+	txn := &Txn{StartTs: 1}
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 2, ExpireAt: past}, Set, txn)
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 3, ExpireAt: future}, Set, txn)
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 4}, Set, txn)
+	ol.commitMutation(1, 2)
+	require.Equal(t, []uint64{3, 4}, listToArray(t, 0, ol, 3))
+
+	// The expired posting survives the rollup, until it gets deleted.
+	kvs, err := ol.Rollup()
+	require.NoError(t, err)
+	require.NoError(t, writePostingListToDisk(kvs))
+	ol, err = getNew(key, ps)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 4}, listToArray(t, 0, ol, 3))
+	uids, err := ol.Uids(ListOptions{ReadTs: 3, Intersect: &pb.List{Uids: []uint64{2, 3}}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3}, uids.Uids)
+
+	expired, err := ol.ExpiredPostings(3, time.Now())
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	require.Equal(t, uint64(2), expired[0].Uid)
+	require.Equal(t, past, expired[0].ExpireAt)
+
+	txn = &Txn{StartTs: 3}
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 2}, Del, txn)
+	ol.commitMutation(3, 4)
+	expired, err = ol.ExpiredPostings(5, time.Now())
+	require.NoError(t, err)
+	require.Empty(t, expired)
+	require.Equal(t, []uint64{3, 4}, listToArray(t, 0, ol, 5))
+}
+
+func TestOracleTimeAt(t *testing.T) {
+	var od oracle
+	od.init()
+	now := time.Now().Unix()
+	od.ProcessDelta(&pb.OracleDelta{MaxAssigned: 10, UnixTime: now - 60})
+	od.ProcessDelta(&pb.OracleDelta{MaxAssigned: 20, UnixTime: now - 30})
+	od.ProcessDelta(&pb.OracleDelta{MaxAssigned: 25, UnixTime: now - 30})
+
+	require.Equal(t, now-60, od.TimeAt(5))
+	require.Equal(t, now-60, od.TimeAt(10))
+	require.Equal(t, now-30, od.TimeAt(11))
+	require.Equal(t, now-30, od.TimeAt(25))
+	// Timestamps ahead of the known ones are read as of now.
+	require.GreaterOrEqual(t, od.TimeAt(26), now)
+}
+
 func TestAddMutation_jchiu1(t *testing.T) {
 	key := x.DataKey("value", 12)
 	ol, err := GetNoStore(key)
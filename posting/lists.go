@@ -23,6 +23,7 @@ import (
 	"os"
 	"os/exec"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -295,6 +296,28 @@ func (lc *LocalCache) UpdateDeltasAndDiscardLists() {
 	lc.plists = make(map[string]*List)
 }
 
+// subjects returns the nodes modified by the transaction, sorted, so the constraints of their
+// types can be checked before it commits.
+func (lc *LocalCache) subjects() []uint64 {
+	lc.RLock()
+	defer lc.RUnlock()
+	seen := make(map[uint64]struct{})
+	var uids []uint64
+	for key := range lc.deltas {
+		pk, err := x.Parse([]byte(key))
+		x.Check(err)
+		if !pk.IsData() {
+			continue
+		}
+		if _, ok := seen[pk.Uid]; !ok {
+			seen[pk.Uid] = struct{}{}
+			uids = append(uids, pk.Uid)
+		}
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	return uids
+}
+
 func (lc *LocalCache) fillPreds(ctx *api.TxnContext, gid uint32) {
 	lc.RLock()
 	defer lc.RUnlock()
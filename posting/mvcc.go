@@ -27,6 +27,7 @@ import (
 	"github.com/dgraph-io/dgo/v2/protos/api"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/x"
+	farm "github.com/dgryski/go-farm"
 	"github.com/pkg/errors"
 )
 
@@ -54,6 +55,12 @@ func (txn *Txn) addConflictKey(conflictKey uint64) {
 	}
 }
 
+// AddKeyConflict makes the transaction conflict with any other transaction modifying the key,
+// whatever the uids they add or remove.
+func (txn *Txn) AddKeyConflict(key []byte) {
+	txn.addConflictKey(farm.Fingerprint64(key))
+}
+
 // FillContext updates the given transaction context with data from this transaction.
 func (txn *Txn) FillContext(ctx *api.TxnContext, gid uint32) {
 	txn.Lock()
@@ -73,6 +80,11 @@ func (txn *Txn) FillContext(ctx *api.TxnContext, gid uint32) {
 	txn.cache.fillPreds(ctx, gid)
 }
 
+// Subjects returns the nodes modified by the transaction.
+func (txn *Txn) Subjects() []uint64 {
+	return txn.cache.subjects()
+}
+
 // CommitToDisk commits a transaction to disk.
 // This function only stores deltas to the commit timestamps. It does not try to generate a state.
 // State generation is done via rollups, which happen when a snapshot is created.
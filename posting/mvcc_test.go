@@ -96,3 +96,19 @@ func TestPostingListRead(t *testing.T) {
 	addEdgeToUID(t, "emptypl", 1, 7, 15, 16)
 	assertLength(17, 3)
 }
+
+func TestTxnSubjects(t *testing.T) {
+	txn := NewTxn(20)
+	for _, key := range [][]byte{
+		x.DataKey("subjects", 3),
+		x.DataKey("subjects.other", 1),
+		x.DataKey("subjects.other", 3),
+		x.IndexKey("subjects", "token"),
+	} {
+		l, err := txn.Get(key)
+		require.NoError(t, err)
+		addMutationHelper(t, l, &pb.DirectedEdge{ValueId: 5}, Set, txn)
+	}
+	txn.Update()
+	require.Equal(t, []uint64{1, 3}, txn.Subjects())
+}
@@ -19,6 +19,7 @@ package posting
 import (
 	"context"
 	"math"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -108,8 +109,22 @@ type oracle struct {
 	// Used for waiting logic for transactions with startTs > maxpending so that we don't read an
 	// uncommitted transaction.
 	waiters map[uint64][]chan struct{}
+
+	// Keeps track of the wall clock time at which Zero sent the max assigned timestamps, sorted by
+	// timestamp, with one entry per second. Used to read the expired values consistently at a
+	// given timestamp.
+	clock []tsTime
+}
+
+// tsTime holds the last timestamp assigned by Zero within the second of the given Unix time.
+type tsTime struct {
+	ts   uint64
+	unix int64
 }
 
+// maxClockEntries bounds the number of entries of the clock to a day worth of seconds.
+const maxClockEntries = 24 * 3600
+
 func (o *oracle) init() {
 	o.waiters = make(map[uint64][]chan struct{})
 	o.pendingTxns = make(map[uint64]*Txn)
@@ -240,6 +255,38 @@ func (o *oracle) ProcessDelta(delta *pb.OracleDelta) {
 	x.AssertTrue(atomic.CompareAndSwapUint64(&o.maxAssigned, curMax, delta.MaxAssigned))
 	ostats.Record(context.Background(),
 		x.MaxAssignedTs.M(int64(delta.MaxAssigned))) // Can't access o.MaxAssigned without atomics.
+	o.updateClock(delta.MaxAssigned, delta.UnixTime)
+}
+
+func (o *oracle) updateClock(ts uint64, unix int64) {
+	o.AssertLock()
+	if unix == 0 {
+		return
+	}
+	if n := len(o.clock); n > 0 && o.clock[n-1].unix >= unix {
+		// Zero's clock went backwards or didn't move, so the timestamp belongs to the last second.
+		o.clock[n-1].ts = ts
+		return
+	}
+	o.clock = append(o.clock, tsTime{ts: ts, unix: unix})
+	if len(o.clock) > maxClockEntries {
+		o.clock = o.clock[len(o.clock)-maxClockEntries:]
+	}
+}
+
+// TimeAt returns the Unix time, in seconds, at which Zero sent the first max assigned timestamp
+// at or after ts. Reads at ts wait for such a timestamp, so values are expired at the same time
+// on every read at ts, and on every replica. If no such timestamp is known yet, the current time is returned.
+func (o *oracle) TimeAt(ts uint64) int64 {
+	o.RLock()
+	defer o.RUnlock()
+	idx := sort.Search(len(o.clock), func(i int) bool {
+		return o.clock[i].ts >= ts
+	})
+	if idx == len(o.clock) {
+		return time.Now().Unix()
+	}
+	return o.clock[idx].unix
 }
 
 func (o *oracle) ResetTxns() {
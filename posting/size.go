@@ -127,6 +127,7 @@ func calculatePostingSize(posting *pb.Posting) uint64 {
 		1*8 + // Op consists of 1 word.
 		1*8 + // StartTs consists of 1 word.
 		1*8 + // CommitTs consists of 1 word.
+		1*8 + // ExpireAt consists of 1 word.
 		0*8 + // XXX_NoUnkeyedLiteral consists of 0 word. Because, it is empty struct.
 		3*8 + // XXX_unrecognized array consists of 3 words.
 		1*8 // XXX_sizecache consists of 1 word.
@@ -103,8 +103,8 @@ func TestUidBlockCalculation(t *testing.T) {
 
 func TestPostingCalculation(t *testing.T) {
 	posting = &pb.Posting{}
-	// 160 is obtained from BenchmarkPosting
-	require.Equal(t, uint64(160), calculatePostingSize(posting))
+	// 168 is obtained from BenchmarkPosting
+	require.Equal(t, uint64(168), calculatePostingSize(posting))
 }
 
 func TestFacetCalculation(t *testing.T) {
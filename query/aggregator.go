@@ -19,6 +19,9 @@ package query
 import (
 	"bytes"
 	"math"
+	"math/big"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
@@ -31,6 +34,42 @@ type aggregator struct {
 	name   string
 	result types.Val
 	count  int // used when we need avergae.
+	// vals holds the values of the statistical aggregators, which need all of them at once.
+	vals []types.Val
+	// fraction is the percentile computed by median and percentile.
+	fraction float64
+}
+
+// newAggregator returns the aggregator of the function, reading the fraction of percentile
+// from its arguments.
+func newAggregator(fn *Function) (aggregator, error) {
+	ag := aggregator{name: fn.Name}
+	switch fn.Name {
+	case "median":
+		ag.fraction = 0.5
+	case "percentile":
+		if len(fn.Args) != 1 {
+			return ag, errors.Errorf("Function percentile expects a fraction. Got: %d arguments",
+				len(fn.Args))
+		}
+		fraction, err := strconv.ParseFloat(fn.Args[0].Value, 64)
+		if err != nil || fraction < 0 || fraction > 1 {
+			return ag, errors.Errorf("The fraction of percentile should be between 0 and 1. "+
+				"Got: %v", fn.Args[0].Value)
+		}
+		ag.fraction = fraction
+	}
+	return ag, nil
+}
+
+// isStatAggregator returns true if the aggregator needs all the values to compute its result,
+// instead of combining them one at a time.
+func isStatAggregator(name string) bool {
+	switch name {
+	case "median", "percentile", "stddev", "variance", "countdistinct":
+		return true
+	}
+	return false
 }
 
 func isUnary(f string) bool {
@@ -69,6 +108,8 @@ func compareValues(ag string, va, vb types.Val) (bool, error) {
 		x.Fatalf("Function %v is not binary boolean", ag)
 	}
 
+	castTemporal(&va, vb)
+	castTemporal(&vb, va)
 	_, err := types.Less(va, vb)
 	if err != nil {
 		//Try to convert values.
@@ -289,6 +330,10 @@ func applyExp(a, res *types.Val) error {
 }
 
 func applyNeg(a, res *types.Val) error {
+	if a.Tid == types.DurationID {
+		res.Value = -a.Value.(time.Duration)
+		return nil
+	}
 	vBase := getValType(a)
 	switch vBase {
 	case INT:
@@ -454,6 +499,13 @@ func (ag *aggregator) ApplyVal(v types.Val) error {
 	}
 
 	va := ag.result
+	if res, ok, err := applyTemporal(ag.name, va, v); ok {
+		if err != nil {
+			return err
+		}
+		ag.result = res
+		return nil
+	}
 	if err := ag.matchType(&v, &va); err != nil {
 		return err
 	}
@@ -473,6 +525,12 @@ func (ag *aggregator) ApplyVal(v types.Val) error {
 }
 
 func (ag *aggregator) Apply(val types.Val) {
+	if isStatAggregator(ag.name) {
+		if val.Value != nil {
+			ag.vals = append(ag.vals, val)
+		}
+		return
+	}
 	if ag.result.Value == nil {
 		ag.result = val
 		ag.count++
@@ -502,6 +560,12 @@ func (ag *aggregator) Apply(val types.Val) {
 			va.Value = va.Value.(int64) + vb.Value.(int64)
 		} else if va.Tid == types.FloatID && vb.Tid == types.FloatID {
 			va.Value = va.Value.(float64) + vb.Value.(float64)
+		} else if va.Tid == types.BigIntID && vb.Tid == types.BigIntID {
+			va.Value = new(big.Int).Add(va.Value.(*big.Int), vb.Value.(*big.Int))
+		} else if va.Tid == types.DecimalID && vb.Tid == types.DecimalID {
+			va.Value = new(big.Rat).Add(va.Value.(*big.Rat), vb.Value.(*big.Rat))
+		} else if va.Tid == types.DurationID && vb.Tid == types.DurationID {
+			va.Value = va.Value.(time.Duration) + vb.Value.(time.Duration)
 		}
 		// Skipping the else case since that means the pair cannot be summed.
 		res = va
@@ -514,6 +578,7 @@ func (ag *aggregator) Apply(val types.Val) {
 
 func (ag *aggregator) ValueMarshalled() (*pb.TaskValue, error) {
 	data := types.ValueForType(types.BinaryID)
+	ag.computeStats()
 	ag.divideByCount()
 	res := &pb.TaskValue{ValType: ag.result.Tid.Enum(), Val: x.Nilbyte}
 	if ag.result.Value == nil {
@@ -532,6 +597,22 @@ func (ag *aggregator) divideByCount() {
 	if ag.name != "avg" || ag.count == 0 || ag.result.Value == nil {
 		return
 	}
+	// The average of bigints and decimals is an exact decimal.
+	switch ag.result.Tid {
+	case types.BigIntID, types.DecimalID:
+		avg := new(big.Rat)
+		if ag.result.Tid == types.BigIntID {
+			avg.SetInt(ag.result.Value.(*big.Int))
+		} else {
+			avg.Set(ag.result.Value.(*big.Rat))
+		}
+		avg.Quo(avg, new(big.Rat).SetInt64(int64(ag.count)))
+		ag.result = types.Val{Tid: types.DecimalID, Value: avg}
+		return
+	case types.DurationID:
+		ag.result.Value = ag.result.Value.(time.Duration) / time.Duration(ag.count)
+		return
+	}
 	var v float64
 	if ag.result.Tid == types.IntID {
 		v = float64(ag.result.Value.(int64))
@@ -543,7 +624,159 @@ func (ag *aggregator) divideByCount() {
 	ag.result.Value = v / float64(ag.count)
 }
 
+// computeStats sets the result of the statistical aggregators from the collected values.
+// median and percentile interpolate between the two closest values, so they return a float for
+// int and float values, and a datetime for datetime values. stddev and variance return a float,
+// and don't apply on datetime values. For bigint and decimal values, they all return an exact
+// decimal. countdistinct returns an int for values of any type.
+// The values which don't have the type of the first one are skipped.
+func (ag *aggregator) computeStats() {
+	if len(ag.vals) == 0 {
+		// There are no distinct values to count, the other aggregators don't have a result.
+		if ag.name == "countdistinct" && ag.result.Value == nil {
+			ag.result = types.Val{Tid: types.IntID, Value: int64(0)}
+		}
+		return
+	}
+	vals := ag.vals
+	ag.vals = nil
+
+	if ag.name == "countdistinct" {
+		distinct := make(map[string]struct{})
+		for _, v := range vals {
+			str := types.ValueForType(types.StringID)
+			if err := types.Marshal(v, &str); err != nil {
+				continue
+			}
+			// The type is part of the key, so 1 and "1" are different values.
+			distinct[v.Tid.Name()+":"+str.Value.(string)] = struct{}{}
+		}
+		ag.result = types.Val{Tid: types.IntID, Value: int64(len(distinct))}
+		return
+	}
+
+	if vals[0].Tid == types.DateTimeID {
+		if ag.name != "median" && ag.name != "percentile" {
+			return
+		}
+		var times []time.Time
+		for _, v := range vals {
+			if v.Tid == types.DateTimeID {
+				times = append(times, v.Value.(time.Time))
+			}
+		}
+		sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+		lo, hi, weight := percentileRanks(len(times), ag.fraction)
+		ag.result = types.Val{
+			Tid:   types.DateTimeID,
+			Value: times[lo].Add(time.Duration(weight * float64(times[hi].Sub(times[lo])))),
+		}
+		return
+	}
+
+	if vals[0].Tid == types.BigIntID || vals[0].Tid == types.DecimalID {
+		ag.result = types.Val{Tid: types.DecimalID, Value: ag.exactStats(vals)}
+		return
+	}
+
+	var nums []float64
+	for _, v := range vals {
+		switch v.Tid {
+		case types.IntID:
+			nums = append(nums, float64(v.Value.(int64)))
+		case types.FloatID:
+			nums = append(nums, v.Value.(float64))
+		}
+	}
+	if len(nums) == 0 {
+		return
+	}
+
+	var res float64
+	switch ag.name {
+	case "median", "percentile":
+		sort.Float64s(nums)
+		lo, hi, weight := percentileRanks(len(nums), ag.fraction)
+		res = nums[lo] + weight*(nums[hi]-nums[lo])
+	case "stddev", "variance":
+		// This is the population variance, i.e. the values are the whole population.
+		var mean float64
+		for _, n := range nums {
+			mean += n
+		}
+		mean /= float64(len(nums))
+		for _, n := range nums {
+			res += (n - mean) * (n - mean)
+		}
+		res /= float64(len(nums))
+		if ag.name == "stddev" {
+			res = math.Sqrt(res)
+		}
+	}
+	ag.result = types.Val{Tid: types.FloatID, Value: res}
+}
+
+// exactStats returns the result of the statistical aggregator computed exactly from the bigint
+// and decimal values, of which there is at least one. Only the square root of stddev is rounded.
+func (ag *aggregator) exactStats(vals []types.Val) *big.Rat {
+	var nums []*big.Rat
+	for _, v := range vals {
+		switch v.Tid {
+		case types.BigIntID:
+			nums = append(nums, new(big.Rat).SetInt(v.Value.(*big.Int)))
+		case types.DecimalID:
+			nums = append(nums, v.Value.(*big.Rat))
+		}
+	}
+	count := new(big.Rat).SetInt64(int64(len(nums)))
+
+	switch ag.name {
+	case "median", "percentile":
+		sort.Slice(nums, func(i, j int) bool { return nums[i].Cmp(nums[j]) < 0 })
+		// The fraction is read back from its shortest form, so that 0.9 is exactly 9/10.
+		pos, _ := new(big.Rat).SetString(strconv.FormatFloat(ag.fraction, 'g', -1, 64))
+		pos.Mul(pos, new(big.Rat).SetInt64(int64(len(nums)-1)))
+		lo := new(big.Int).Quo(pos.Num(), pos.Denom())
+		weight := pos.Sub(pos, new(big.Rat).SetInt(lo))
+		res := new(big.Rat).Set(nums[lo.Int64()])
+		if weight.Sign() > 0 {
+			diff := new(big.Rat).Sub(nums[lo.Int64()+1], res)
+			res.Add(res, diff.Mul(diff, weight))
+		}
+		return res
+	default:
+		// stddev and variance, of the whole population like for the other values.
+		mean := new(big.Rat)
+		for _, n := range nums {
+			mean.Add(mean, n)
+		}
+		mean.Quo(mean, count)
+		res := new(big.Rat)
+		var diff big.Rat
+		for _, n := range nums {
+			diff.Sub(n, mean)
+			res.Add(res, diff.Mul(&diff, &diff))
+		}
+		res.Quo(res, count)
+		if ag.name == "stddev" {
+			f := new(big.Float).SetPrec(256).SetRat(res)
+			res.SetString(f.Sqrt(f).Text('f', types.MaxDecimalDigits))
+		}
+		return res
+	}
+}
+
+// percentileRanks returns the ranks of the two sorted values closest to the percentile, and the
+// weight of the second one when interpolating between them.
+func percentileRanks(n int, fraction float64) (int, int, float64) {
+	pos := fraction * float64(n-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	return lo, hi, pos - float64(lo)
+}
+
 func (ag *aggregator) Value() (types.Val, error) {
+	ag.computeStats()
 	if ag.result.Value == nil {
 		return ag.result, ErrEmptyVal
 	}
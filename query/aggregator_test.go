@@ -0,0 +1,154 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/types"
+)
+
+func applyAll(t *testing.T, fn *Function, vals ...types.Val) types.Val {
+	ag, err := newAggregator(fn)
+	require.NoError(t, err)
+	for _, v := range vals {
+		ag.Apply(v)
+	}
+	res, err := ag.Value()
+	require.NoError(t, err)
+	return res
+}
+
+func TestStatAggregators(t *testing.T) {
+	ints := []types.Val{
+		{Tid: types.IntID, Value: int64(4)},
+		{Tid: types.IntID, Value: int64(1)},
+		{Tid: types.FloatID, Value: 3.0},
+		{Tid: types.IntID, Value: int64(4)},
+	}
+	float := func(v float64) types.Val { return types.Val{Tid: types.FloatID, Value: v} }
+
+	require.Equal(t, float(3.5), applyAll(t, &Function{Name: "median"}, ints...))
+	require.InDelta(t, 2.2, applyAll(t, &Function{Name: "percentile",
+		Args: []gql.Arg{{Value: "0.2"}}}, ints...).Value, 1e-9)
+	require.Equal(t, float(4), applyAll(t, &Function{Name: "percentile",
+		Args: []gql.Arg{{Value: "1"}}}, ints...))
+	require.Equal(t, float(1.5), applyAll(t, &Function{Name: "variance"}, ints...))
+	require.InDelta(t, 1.2247, applyAll(t, &Function{Name: "stddev"}, ints...).Value, 1e-4)
+	require.Equal(t, types.Val{Tid: types.IntID, Value: int64(3)},
+		applyAll(t, &Function{Name: "countdistinct"}, ints...))
+
+	day := func(d int) types.Val {
+		return types.Val{Tid: types.DateTimeID, Value: time.Date(2019, 1, d, 0, 0, 0, 0, time.UTC)}
+	}
+	require.Equal(t, types.Val{Tid: types.DateTimeID,
+		Value: time.Date(2019, 1, 2, 12, 0, 0, 0, time.UTC)},
+		applyAll(t, &Function{Name: "median"}, day(1), day(4), day(2), day(3)))
+
+	// stddev doesn't apply on datetime values.
+	ag, err := newAggregator(&Function{Name: "stddev"})
+	require.NoError(t, err)
+	ag.Apply(day(1))
+	_, err = ag.Value()
+	require.Equal(t, ErrEmptyVal, err)
+
+	_, err = newAggregator(&Function{Name: "percentile", Args: []gql.Arg{{Value: "2"}}})
+	require.Error(t, err)
+}
+
+func TestBigNumberAggregators(t *testing.T) {
+	bigint := func(s string) types.Val {
+		i, ok := new(big.Int).SetString(s, 10)
+		require.True(t, ok)
+		return types.Val{Tid: types.BigIntID, Value: i}
+	}
+	ints := []types.Val{bigint("9223372036854775807"), bigint("9223372036854775807"),
+		bigint("1")}
+
+	res := applyAll(t, &Function{Name: "sum"}, ints...)
+	require.Equal(t, "18446744073709551615", res.Value.(*big.Int).String())
+	res = applyAll(t, &Function{Name: "avg"}, ints...)
+	require.Equal(t, types.DecimalID, res.Tid)
+	require.Equal(t, "6148914691236517205", types.DecimalString(res.Value.(*big.Rat)))
+	res = applyAll(t, &Function{Name: "min"}, ints...)
+	require.Equal(t, "1", res.Value.(*big.Int).String())
+
+	decimals := []types.Val{
+		{Tid: types.DecimalID, Value: big.NewRat(1, 10)},
+		{Tid: types.DecimalID, Value: big.NewRat(2, 10)},
+	}
+	res = applyAll(t, &Function{Name: "sum"}, decimals...)
+	require.Equal(t, "0.3", types.DecimalString(res.Value.(*big.Rat)))
+	res = applyAll(t, &Function{Name: "avg"}, decimals...)
+	require.Equal(t, "0.15", types.DecimalString(res.Value.(*big.Rat)))
+}
+
+func TestBigNumberStatAggregators(t *testing.T) {
+	decimal := func(s string) types.Val {
+		r, ok := new(big.Rat).SetString(s)
+		require.True(t, ok)
+		return types.Val{Tid: types.DecimalID, Value: r}
+	}
+	decimals := []types.Val{decimal("0.1"), decimal("0.4"), decimal("0.2"), decimal("0.3")}
+	stat := func(fn *Function, vals ...types.Val) string {
+		res := applyAll(t, fn, vals...)
+		require.Equal(t, types.DecimalID, res.Tid)
+		return types.DecimalString(res.Value.(*big.Rat))
+	}
+
+	require.Equal(t, "0.25", stat(&Function{Name: "median"}, decimals...))
+	require.Equal(t, "0.37", stat(&Function{Name: "percentile",
+		Args: []gql.Arg{{Value: "0.9"}}}, decimals...))
+	require.Equal(t, "0.4", stat(&Function{Name: "percentile",
+		Args: []gql.Arg{{Value: "1"}}}, decimals...))
+	require.Equal(t, "0.0125", stat(&Function{Name: "variance"}, decimals...))
+	require.Equal(t, "0.1118033988749894848204586834365638",
+		stat(&Function{Name: "stddev"}, decimals...))
+	require.Equal(t, "0", stat(&Function{Name: "stddev"}, decimal("1.5")))
+	// The values themselves are left as they were.
+	require.Equal(t, "0.4", types.DecimalString(decimals[1].Value.(*big.Rat)))
+
+	// Bigints don't lose precision, unlike floats, and can be mixed with decimals.
+	bigint := func(s string) types.Val {
+		i, ok := new(big.Int).SetString(s, 10)
+		require.True(t, ok)
+		return types.Val{Tid: types.BigIntID, Value: i}
+	}
+	require.Equal(t, "9223372036854775808", stat(&Function{Name: "median"},
+		bigint("9223372036854775807"), bigint("9223372036854775809")))
+	require.Equal(t, "1", stat(&Function{Name: "variance"},
+		bigint("9223372036854775807"), bigint("9223372036854775809")))
+	require.Equal(t, "1.25", stat(&Function{Name: "median"}, bigint("1"), decimal("1.5")))
+}
+
+func TestDurationAggregators(t *testing.T) {
+	durations := []types.Val{
+		{Tid: types.DurationID, Value: time.Hour},
+		{Tid: types.DurationID, Value: 30 * time.Minute},
+	}
+	res := applyAll(t, &Function{Name: "sum"}, durations...)
+	require.Equal(t, types.Val{Tid: types.DurationID, Value: 90 * time.Minute}, res)
+	res = applyAll(t, &Function{Name: "avg"}, durations...)
+	require.Equal(t, types.Val{Tid: types.DurationID, Value: 45 * time.Minute}, res)
+	res = applyAll(t, &Function{Name: "min"}, durations...)
+	require.Equal(t, types.Val{Tid: types.DurationID, Value: 30 * time.Minute}, res)
+}
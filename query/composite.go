@@ -0,0 +1,235 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"sort"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/worker"
+)
+
+// indexLookup returns whether the predicates are indexed. It's worker.IndexedPredicates, except
+// in tests.
+type indexLookup func(preds []string) (map[string]bool, error)
+
+// builtCompositeIndexes returns the composite indexes of the namespace ns which can be used by
+// the queries, the ones with the most predicates first.
+func builtCompositeIndexes(ns uint64) []*pb.CompositeIndex {
+	var out []*pb.CompositeIndex
+	for _, index := range schema.State().CompositeIndexes(ns) {
+		if index.Built {
+			out = append(out, index)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return len(out[i].Predicates) > len(out[j].Predicates)
+	})
+	return out
+}
+
+// useCompositeIndexes replaces the AND-ed eq functions of the SubGraph tree covering all the
+// predicates of a composite index by a single eq on the hidden predicate of the index, so that
+// the posting lists of the predicates don't have to be intersected. At the root, the function
+// of the query block counts as AND-ed with its filter.
+func (sg *SubGraph) useCompositeIndexes(indexes []*pb.CompositeIndex, lookup indexLookup) {
+	if len(indexes) == 0 {
+		return
+	}
+	sg.useCompositeIndexesAtRoot(indexes, lookup)
+	sg.useCompositeIndexesInFilters(indexes, lookup)
+}
+
+func (sg *SubGraph) useCompositeIndexesAtRoot(indexes []*pb.CompositeIndex, lookup indexLookup) {
+	// The filters of recurse queries apply at every level, they can't be moved to the root.
+	if !isCompositeEq(sg) || len(sg.Filters) != 1 || sg.Params.Recurse {
+		return
+	}
+	root := sg.Filters[0]
+	var leaves []*SubGraph
+	switch {
+	case root.FilterOp == "" && isCompositeEq(root):
+		leaves = []*SubGraph{root}
+	case root.FilterOp == "and":
+		leaves = root.Filters
+	}
+
+	for _, index := range indexes {
+		all := append([]*SubGraph{sg}, leaves...)
+		covered := coveringLeaves(index, all)
+		if covered == nil || covered[0] != sg || !replaceable(covered, len(all), lookup) {
+			continue
+		}
+		fn, err := compositeEq(index, covered)
+		if err != nil {
+			continue
+		}
+		sg.Attr, sg.SrcFunc = fn.Attr, fn.SrcFunc
+		switch {
+		case root.FilterOp == "":
+			sg.Filters = nil
+		default:
+			root.Filters = removeLeaves(root.Filters, covered[1:])
+			if len(root.Filters) == 0 {
+				sg.Filters = nil
+			}
+		}
+		return
+	}
+}
+
+func (sg *SubGraph) useCompositeIndexesInFilters(indexes []*pb.CompositeIndex,
+	lookup indexLookup) {
+	for _, filter := range sg.Filters {
+		filter.useCompositeIndexesInFilters(indexes, lookup)
+	}
+	for _, child := range sg.Children {
+		child.useCompositeIndexesInFilters(indexes, lookup)
+	}
+	if sg.FilterOp != "and" {
+		return
+	}
+
+	for _, index := range indexes {
+		covered := coveringLeaves(index, sg.Filters)
+		if covered == nil || !replaceable(covered, len(sg.Filters), lookup) {
+			continue
+		}
+		fn, err := compositeEq(index, covered)
+		if err != nil {
+			continue
+		}
+		// The eq on the index takes the place of the first function it replaces.
+		for i, filter := range sg.Filters {
+			if filter == covered[0] {
+				sg.Filters[i] = fn
+			}
+		}
+		sg.Filters = removeLeaves(sg.Filters, covered[1:])
+	}
+}
+
+// isCompositeEq returns true if the SubGraph is an eq function comparing an untagged value of a
+// predicate with a single constant, which a composite index can answer.
+func isCompositeEq(sg *SubGraph) bool {
+	fn := sg.SrcFunc
+	if sg.FilterOp != "" || fn == nil || fn.Name != "eq" || fn.IsCount || fn.IsValueVar ||
+		fn.IsLenVar || len(fn.Args) != 1 || fn.Args[0].IsValueVar ||
+		len(sg.Params.Langs) > 0 || len(sg.Attr) == 0 {
+		return false
+	}
+	return !schema.IsCompositeIndexPredicate(sg.Attr)
+}
+
+// coveringLeaves returns the eq functions among the leaves on the predicates of the index, in
+// the order of the predicates, or nil if some predicate isn't compared by any of them.
+func coveringLeaves(index *pb.CompositeIndex, leaves []*SubGraph) []*SubGraph {
+	out := make([]*SubGraph, 0, len(index.Predicates))
+	for _, pred := range index.Predicates {
+		var found *SubGraph
+		for _, leaf := range leaves {
+			if leaf.Attr == pred && isCompositeEq(leaf) {
+				found = leaf
+				break
+			}
+		}
+		if found == nil {
+			return nil
+		}
+		out = append(out, found)
+	}
+	// The order of the predicates is that of the index, the first covered leaf is the one
+	// appearing first.
+	sort.SliceStable(out, func(i, j int) bool {
+		return indexOf(leaves, out[i]) < indexOf(leaves, out[j])
+	})
+	return out
+}
+
+// replaceable returns true if the eq functions covered by a composite index, out of numLeaves
+// AND-ed functions, can be replaced by an eq on the index. An eq on a predicate without index
+// fails, so the composite index only takes its place if it answers all the functions by itself.
+func replaceable(covered []*SubGraph, numLeaves int, lookup indexLookup) bool {
+	if len(covered) == numLeaves {
+		return true
+	}
+	preds := make([]string, 0, len(covered))
+	for _, leaf := range covered {
+		preds = append(preds, leaf.Attr)
+	}
+	indexed, err := lookup(preds)
+	if err != nil {
+		return false
+	}
+	for _, pred := range preds {
+		if !indexed[pred] {
+			return false
+		}
+	}
+	return true
+}
+
+// compositeEq returns the eq function on the hidden predicate of the index matching the nodes
+// matched by all the eq functions on the predicates of the index.
+func compositeEq(index *pb.CompositeIndex, covered []*SubGraph) (*SubGraph, error) {
+	typs, err := worker.CompositeIndexTypes(index)
+	if err != nil {
+		return nil, err
+	}
+	vals := make([]types.Val, len(index.Predicates))
+	for i, pred := range index.Predicates {
+		for _, leaf := range covered {
+			if leaf.Attr != pred {
+				continue
+			}
+			src := types.Val{Tid: types.StringID, Value: []byte(leaf.SrcFunc.Args[0].Value)}
+			if vals[i], err = types.Convert(src, typs[pred]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	value, err := schema.CompositeIndexValue(vals)
+	if err != nil {
+		return nil, err
+	}
+	return &SubGraph{
+		Attr:    schema.CompositeIndexPredicate(index),
+		SrcFunc: &Function{Name: "eq", Args: []gql.Arg{{Value: value}}},
+	}, nil
+}
+
+func removeLeaves(filters, leaves []*SubGraph) []*SubGraph {
+	out := filters[:0]
+	for _, filter := range filters {
+		if indexOf(leaves, filter) < 0 {
+			out = append(out, filter)
+		}
+	}
+	return out
+}
+
+func indexOf(sgs []*SubGraph, sg *SubGraph) int {
+	for i, s := range sgs {
+		if s == sg {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+var testIndexes = []*pb.CompositeIndex{
+	{
+		Name:       "country_age",
+		TypeName:   "Person",
+		Predicates: []string{"country", "age"},
+		ValueTypes: []string{"string", "int"},
+	},
+	{
+		Name:       "country_status",
+		TypeName:   "Person",
+		Predicates: []string{"country", "status"},
+		ValueTypes: []string{"string", "string"},
+	},
+}
+
+// testIndexed says status isn't indexed.
+func testIndexed(preds []string) (map[string]bool, error) {
+	return map[string]bool{"country": true, "age": true}, nil
+}
+
+func TestUseCompositeIndexesAtRoot(t *testing.T) {
+	and := &SubGraph{FilterOp: "and", Filters: []*SubGraph{
+		fnSubGraph("has", "name"),
+		fnSubGraph("eq", "age", "30"),
+	}}
+	root := fnSubGraph("eq", "country", "DE")
+	root.Filters = []*SubGraph{and}
+
+	root.useCompositeIndexes(testIndexes, testIndexed)
+	require.Equal(t, "dgraph.index.country_age", root.Attr)
+	require.Equal(t, "2:DE2:30", root.SrcFunc.Args[0].Value)
+	require.Equal(t, []string{"name"}, filterAttrs(and))
+
+	// Once the filter has no function left, it's removed.
+	root = fnSubGraph("eq", "country", "DE")
+	root.Filters = []*SubGraph{fnSubGraph("eq", "status", "active")}
+	root.useCompositeIndexes(testIndexes, testIndexed)
+	require.Equal(t, "dgraph.index.country_status", root.Attr)
+	require.Equal(t, "2:DE6:active", root.SrcFunc.Args[0].Value)
+	require.Empty(t, root.Filters)
+
+	// The eq on status, which isn't indexed, is only replaced when the index answers the whole
+	// filter. Otherwise it fails as usual.
+	and = &SubGraph{FilterOp: "and", Filters: []*SubGraph{
+		fnSubGraph("has", "name"),
+		fnSubGraph("eq", "status", "active"),
+	}}
+	root = fnSubGraph("eq", "country", "DE")
+	root.Filters = []*SubGraph{and}
+	root.useCompositeIndexes(testIndexes, testIndexed)
+	require.Equal(t, "country", root.Attr)
+	require.Equal(t, []string{"name", "status"}, filterAttrs(and))
+}
+
+func TestUseCompositeIndexesInFilters(t *testing.T) {
+	and := &SubGraph{FilterOp: "and", Filters: []*SubGraph{
+		fnSubGraph("regexp", "bio", "/x/"),
+		fnSubGraph("eq", "age", "030"),
+		fnSubGraph("eq", "country", "DE"),
+	}}
+	child := &SubGraph{Attr: "friend", Filters: []*SubGraph{and}}
+	root := fnSubGraph("has", "name")
+	root.Children = []*SubGraph{child}
+
+	root.useCompositeIndexes(testIndexes, testIndexed)
+	require.Equal(t, "name", root.Attr)
+	require.Equal(t, []string{"bio", "dgraph.index.country_age"}, filterAttrs(and))
+	// The values are converted to the types of the predicates.
+	require.Equal(t, "2:DE2:30", and.Filters[1].SrcFunc.Args[0].Value)
+}
+
+func TestUseCompositeIndexesSkipped(t *testing.T) {
+	or := &SubGraph{FilterOp: "or", Filters: []*SubGraph{
+		fnSubGraph("eq", "country", "DE"),
+		fnSubGraph("eq", "status", "active"),
+	}}
+	lang := &SubGraph{FilterOp: "and", Filters: []*SubGraph{
+		fnSubGraph("eq", "country", "DE"),
+		fnSubGraph("eq", "status", "active"),
+	}}
+	lang.Filters[1].Params.Langs = []string{"en"}
+	invalid := &SubGraph{FilterOp: "and", Filters: []*SubGraph{
+		fnSubGraph("eq", "country", "DE"),
+		fnSubGraph("eq", "age", "thirty"),
+	}}
+	multiple := &SubGraph{FilterOp: "and", Filters: []*SubGraph{
+		fnSubGraph("eq", "country", "DE", "FR"),
+		fnSubGraph("eq", "status", "active"),
+	}}
+
+	root := fnSubGraph("has", "name")
+	root.Filters = []*SubGraph{
+		{FilterOp: "and", Filters: []*SubGraph{or, lang, invalid, multiple}},
+	}
+	root.useCompositeIndexes(testIndexes, testIndexed)
+	for _, filter := range []*SubGraph{or, lang, invalid, multiple} {
+		require.Equal(t, "country", filter.Filters[0].Attr)
+		require.Len(t, filter.Filters, 2)
+	}
+}
@@ -0,0 +1,270 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// fieldCheck is a field of a type to check on a node of the type.
+type fieldCheck struct {
+	uid      uint64
+	typeName string
+	field    *pb.SchemaUpdate
+}
+
+// ValidateTypeConstraints checks that the nodes, as seen by the transaction started at startTs,
+// satisfy the fields declared by their types: the required fields must be present, fields which
+// aren't lists must have at most one value, and the values must be of the declared type. Fields
+// declared without a type aren't checked. The error lists all the violations.
+func ValidateTypeConstraints(ctx context.Context, startTs uint64, uids []uint64) error {
+	if len(uids) == 0 {
+		return nil
+	}
+	uids = append([]uint64(nil), uids...)
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	uids = uniqueUids(uids)
+
+	nodeTypes, err := fetchNodeTypes(ctx, startTs, uids)
+	if err != nil {
+		return err
+	}
+	ns := x.ExtractNamespace(ctx)
+	checks := make(map[string][]fieldCheck)
+	for i, uid := range uids {
+		for _, typeName := range nodeTypes[i] {
+			typ, ok := schema.State().GetType(x.NamespaceAttr(ns, typeName))
+			if !ok {
+				continue
+			}
+			for _, field := range typ.Fields {
+				if !hasDeclaredType(field) {
+					continue
+				}
+				checks[field.Predicate] = append(checks[field.Predicate],
+					fieldCheck{uid: uid, typeName: typeName, field: field})
+			}
+		}
+	}
+	preds := make([]string, 0, len(checks))
+	for pred := range checks {
+		preds = append(preds, pred)
+	}
+	sort.Strings(preds)
+
+	var violations []string
+	for _, pred := range preds {
+		v, err := checkFields(ctx, startTs, pred, checks[pred])
+		if err != nil {
+			return err
+		}
+		violations = append(violations, v...)
+	}
+	if len(violations) > 0 {
+		return errors.Errorf("Transaction violates type constraints: %s",
+			strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+// HasTypeConstraints returns whether some type of the namespace ns declares the type of a field,
+// in which case the transactions must be validated before they commit.
+func HasTypeConstraints(ns uint64) bool {
+	for _, name := range schema.State().Types() {
+		if x.ParseNamespace(name) != ns {
+			continue
+		}
+		typ, ok := schema.State().GetType(name)
+		if !ok {
+			continue
+		}
+		for _, field := range typ.Fields {
+			if hasDeclaredType(field) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasDeclaredType(field *pb.SchemaUpdate) bool {
+	return field.ValueType != pb.Posting_DEFAULT || field.ObjectTypeName != ""
+}
+
+func uniqueUids(uids []uint64) []uint64 {
+	out := uids[:0]
+	for i, uid := range uids {
+		if i == 0 || uid != uids[i-1] {
+			out = append(out, uid)
+		}
+	}
+	return out
+}
+
+// fetchNodeTypes returns the types of every one of the sorted uids.
+func fetchNodeTypes(ctx context.Context, startTs uint64, uids []uint64) ([][]string, error) {
+	result, err := worker.ProcessTaskOverNetwork(ctx, &pb.Query{
+		Attr:    x.NamespaceAttr(x.ExtractNamespace(ctx), "dgraph.type"),
+		UidList: &pb.List{Uids: uids},
+		ReadTs:  startTs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodeTypes := make([][]string, len(uids))
+	for i := range uids {
+		if i >= len(result.ValueMatrix) {
+			break
+		}
+		for _, tv := range result.ValueMatrix[i].Values {
+			nodeTypes[i] = append(nodeTypes[i], string(tv.Val))
+		}
+	}
+	return nodeTypes, nil
+}
+
+// declaredTypeName returns the type of the field as written in the type definition.
+func declaredTypeName(field *pb.SchemaUpdate) string {
+	if field.ObjectTypeName != "" {
+		return field.ObjectTypeName
+	}
+	return types.TypeID(field.ValueType).Name()
+}
+
+// checkFields returns the violations of the checks of the fields of the predicate.
+func checkFields(ctx context.Context, startTs uint64, pred string,
+	checks []fieldCheck) ([]string, error) {
+
+	uids := make([]uint64, 0, len(checks))
+	for _, c := range checks {
+		uids = append(uids, c.uid)
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	uids = uniqueUids(uids)
+	pos := make(map[uint64]int, len(uids))
+	for i, uid := range uids {
+		pos[uid] = i
+	}
+
+	q := &pb.Query{
+		Attr:    pred,
+		UidList: &pb.List{Uids: uids},
+		ReadTs:  startTs,
+	}
+	if strings.HasPrefix(pred, "~") {
+		q.Attr, q.Reverse = pred[1:], true
+	}
+	result, err := worker.ProcessTaskOverNetwork(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []string
+	for _, c := range checks {
+		var edges []uint64
+		var vals []*pb.TaskValue
+		if i := pos[c.uid]; i < len(result.UidMatrix) {
+			edges = result.UidMatrix[i].Uids
+		}
+		if i := pos[c.uid]; i < len(result.ValueMatrix) {
+			vals = result.ValueMatrix[i].Values
+		}
+		v, err := checkField(ctx, startTs, c, edges, vals)
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, v...)
+	}
+	return violations, nil
+}
+
+// checkField returns the violations of the field of a node, given the uids and the values the
+// node has for the field.
+func checkField(ctx context.Context, startTs uint64, c fieldCheck, edges []uint64,
+	vals []*pb.TaskValue) ([]string, error) {
+
+	field := c.field
+	// The field as it's named within the namespace of the type.
+	fieldName := x.ParseAttr(field.Predicate)
+	node := fmt.Sprintf("node %#x of type %s", c.uid, c.typeName)
+	declared := declaredTypeName(field)
+	n := len(edges) + len(vals)
+	required := (!field.List && field.NonNullable) || (field.List && field.NonNullableList)
+
+	var violations []string
+	switch {
+	case n == 0 && required:
+		violations = append(violations,
+			fmt.Sprintf("%s is missing required field %s", node, fieldName))
+	case n > 1 && !field.List:
+		violations = append(violations, fmt.Sprintf("%s has %d values for field %s, "+
+			"declared as a single %s", node, n, fieldName, declared))
+	}
+
+	isUid := field.ValueType == pb.Posting_UID || field.ValueType == pb.Posting_OBJECT
+	switch {
+	case isUid && len(vals) > 0:
+		violations = append(violations, fmt.Sprintf("%s has values of type %s for field %s, "+
+			"declared as %s", node, types.TypeID(vals[0].ValType).Name(), fieldName,
+			declared))
+	case !isUid && len(edges) > 0:
+		violations = append(violations, fmt.Sprintf("%s has uid edges for field %s, "+
+			"declared as %s", node, fieldName, declared))
+	case !isUid:
+		for _, tv := range vals {
+			if tv.ValType != field.ValueType {
+				violations = append(violations, fmt.Sprintf("%s has values of type %s for "+
+					"field %s, declared as %s", node, types.TypeID(tv.ValType).Name(),
+					fieldName, declared))
+				break
+			}
+		}
+	case field.ObjectTypeName != "" && len(edges) > 0:
+		targetTypes, err := fetchNodeTypes(ctx, startTs, edges)
+		if err != nil {
+			return nil, err
+		}
+		for i, target := range edges {
+			if !hasType(targetTypes[i], field.ObjectTypeName) {
+				violations = append(violations, fmt.Sprintf("%s links field %s to node %#x, "+
+					"which isn't of type %s", node, fieldName, target,
+					field.ObjectTypeName))
+			}
+		}
+	}
+	return violations, nil
+}
+
+func hasType(typeNames []string, name string) bool {
+	for _, t := range typeNames {
+		if t == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+func TestCheckField(t *testing.T) {
+	name := &pb.SchemaUpdate{Predicate: "name", ValueType: pb.Posting_STRING, NonNullable: true}
+	friends := &pb.SchemaUpdate{Predicate: "friend", ValueType: pb.Posting_UID, List: true,
+		NonNullableList: true}
+	str := &pb.TaskValue{ValType: pb.Posting_STRING, Val: []byte("a")}
+	num := &pb.TaskValue{ValType: pb.Posting_INT, Val: []byte{1, 0, 0, 0, 0, 0, 0, 0}}
+
+	tests := []struct {
+		field    *pb.SchemaUpdate
+		edges    []uint64
+		vals     []*pb.TaskValue
+		expected []string
+	}{
+		{field: name, vals: []*pb.TaskValue{str}},
+		{field: name, expected: []string{"node 0x1 of type Person is missing required field name"}},
+		{field: name, vals: []*pb.TaskValue{str, str}, expected: []string{
+			"node 0x1 of type Person has 2 values for field name, declared as a single string"}},
+		{field: name, vals: []*pb.TaskValue{num}, expected: []string{
+			"node 0x1 of type Person has values of type int for field name, declared as string"}},
+		{field: name, edges: []uint64{2}, expected: []string{
+			"node 0x1 of type Person has uid edges for field name, declared as string"}},
+		{field: friends, edges: []uint64{2, 3}},
+		{field: friends, expected: []string{
+			"node 0x1 of type Person is missing required field friend"}},
+		{field: friends, vals: []*pb.TaskValue{str}, expected: []string{
+			"node 0x1 of type Person has values of type string for field friend, declared as uid"}},
+	}
+	for _, tc := range tests {
+		c := fieldCheck{uid: 1, typeName: "Person", field: tc.field}
+		violations, err := checkField(context.Background(), 1, c, tc.edges, tc.vals)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, violations)
+	}
+}
@@ -0,0 +1,174 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/worker"
+)
+
+// estimator returns the estimated number of uids matched by a function on a predicate. It's
+// worker.EstimateUids, except in tests.
+type estimator func(attr, fnName string, args []string) (uint64, bool)
+
+// optimize uses the statistics of the predicates to decide the order in which the filters of
+// the SubGraph tree are evaluated. AND-ed filters are sorted so the most selective one runs
+// first and the others only check the uids it matched. At the root, the function is swapped
+// with an AND-ed filter which is expected to match fewer uids.
+func (sg *SubGraph) optimize(estimate estimator) {
+	sg.estimate(estimate)
+	sg.swapRootFunc()
+	sg.orderFilters()
+}
+
+// estimate fills the estimated number of uids matched by the SubGraph, its filters and its
+// children, when the statistics allow it.
+func (sg *SubGraph) estimate(estimate estimator) {
+	for _, filter := range sg.Filters {
+		filter.estimate(estimate)
+	}
+	for _, child := range sg.Children {
+		child.estimate(estimate)
+	}
+
+	sg.estimatedUids = nil
+	switch {
+	case sg.FilterOp == "and":
+		// The branches all have to match, so the result is at most that of the smallest one.
+		for _, filter := range sg.Filters {
+			if filter.estimatedUids != nil &&
+				(sg.estimatedUids == nil || *filter.estimatedUids < *sg.estimatedUids) {
+				sg.estimatedUids = filter.estimatedUids
+			}
+		}
+	case sg.FilterOp == "or":
+		var n uint64
+		for _, filter := range sg.Filters {
+			if filter.estimatedUids == nil {
+				return
+			}
+			n += *filter.estimatedUids
+		}
+		sg.estimatedUids = &n
+	case sg.FilterOp == "" && canEstimate(sg):
+		fn := sg.SrcFunc
+		args := make([]string, 0, len(fn.Args))
+		for _, arg := range fn.Args {
+			args = append(args, arg.Value)
+		}
+		if n, ok := estimate(sg.Attr, fn.Name, args); ok {
+			sg.estimatedUids = &n
+		}
+	}
+}
+
+// canEstimate returns true if the number of uids matched by the function of the SubGraph can
+// be estimated from the statistics of its predicate.
+func canEstimate(sg *SubGraph) bool {
+	fn := sg.SrcFunc
+	if fn == nil || fn.IsCount || fn.IsValueVar || fn.IsLenVar || sg.Attr == "" ||
+		strings.HasPrefix(sg.Attr, "~") {
+		return false
+	}
+	for _, arg := range fn.Args {
+		if arg.IsValueVar {
+			return false
+		}
+	}
+	return true
+}
+
+// swapRootFunc makes the most selective AND-ed filter of the root the function of the query
+// block, and the function of the query block a filter, if the filter is expected to match fewer
+// uids. Both give the same results, but the filters only check the uids found by the function.
+func (sg *SubGraph) swapRootFunc() {
+	if sg.estimatedUids == nil || len(sg.Filters) != 1 || !canSwap(sg) ||
+		sg.Params.Recurse || sg.Params.Alias == "shortest" || sg.facetsFilter != nil {
+		return
+	}
+
+	// The filter is either a single function, or AND-ed functions.
+	var branch **SubGraph
+	root := sg.Filters[0]
+	switch root.FilterOp {
+	case "":
+		branch = &sg.Filters[0]
+	case "and":
+		for i, filter := range root.Filters {
+			if filter.FilterOp == "" && filter.estimatedUids != nil &&
+				(branch == nil || *filter.estimatedUids < *(*branch).estimatedUids) {
+				branch = &root.Filters[i]
+			}
+		}
+	}
+	if branch == nil || (*branch).estimatedUids == nil || !canSwap(*branch) ||
+		*(*branch).estimatedUids >= *sg.estimatedUids {
+		return
+	}
+
+	// The language of a function is kept in the params of its SubGraph. The root doesn't have
+	// other languages, as they're only given to the predicates of a query block.
+	filter := *branch
+	*branch = &SubGraph{
+		Attr:          sg.Attr,
+		SrcFunc:       sg.SrcFunc,
+		Params:        params{Langs: sg.Params.Langs},
+		estimatedUids: sg.estimatedUids,
+	}
+	sg.Attr, sg.SrcFunc, sg.estimatedUids = filter.Attr, filter.SrcFunc, filter.estimatedUids
+	sg.Params.Langs = filter.Params.Langs
+}
+
+// canSwap returns true if the function of the SubGraph can be moved between the root and the
+// filters.
+func canSwap(sg *SubGraph) bool {
+	if !canEstimate(sg) {
+		return false
+	}
+	usesIndex, _ := worker.FuncUsesIndex(sg.SrcFunc.Name, false)
+	return usesIndex || sg.SrcFunc.Name == "has"
+}
+
+// orderFilters sorts the branches of the AND-ed filters of the SubGraph tree by their
+// estimated number of uids. Branches without an estimate keep their order, after the others.
+func (sg *SubGraph) orderFilters() {
+	for _, filter := range sg.Filters {
+		filter.orderFilters()
+	}
+	for _, child := range sg.Children {
+		child.orderFilters()
+	}
+	if sg.FilterOp != "and" {
+		return
+	}
+	sort.SliceStable(sg.Filters, func(i, j int) bool {
+		ei, ej := sg.Filters[i].estimatedUids, sg.Filters[j].estimatedUids
+		return ei != nil && (ej == nil || *ei < *ej)
+	})
+}
+
+// runsFirstFilter returns true if the first AND-ed filter of the SubGraph is expected to be more
+// selective than the others, so it's worth running it before them.
+func (sg *SubGraph) runsFirstFilter() bool {
+	if sg.FilterOp != "and" || len(sg.Filters) < 2 {
+		return false
+	}
+	first, second := sg.Filters[0].estimatedUids, sg.Filters[1].estimatedUids
+	return first != nil && (second == nil || *first < *second)
+}
@@ -0,0 +1,153 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/gql"
+)
+
+// testEstimates are the estimated number of uids matched by any function on a predicate.
+var testEstimates = map[string]uint64{
+	"name":  1000,
+	"email": 1,
+	"city":  50,
+	"age":   300,
+}
+
+func testEstimator(attr, fnName string, args []string) (uint64, bool) {
+	n, ok := testEstimates[attr]
+	return n, ok
+}
+
+func fnSubGraph(fnName, attr string, args ...string) *SubGraph {
+	sg := &SubGraph{Attr: attr, SrcFunc: &Function{Name: fnName}}
+	for _, arg := range args {
+		sg.SrcFunc.Args = append(sg.SrcFunc.Args, gql.Arg{Value: arg})
+	}
+	return sg
+}
+
+func filterAttrs(sg *SubGraph) []string {
+	var attrs []string
+	for _, filter := range sg.Filters {
+		attrs = append(attrs, filter.Attr)
+	}
+	return attrs
+}
+
+func TestOptimizeOrdersFilters(t *testing.T) {
+	and := &SubGraph{FilterOp: "and", Filters: []*SubGraph{
+		fnSubGraph("regexp", "bio", "/x/"),
+		fnSubGraph("ge", "age", "20"),
+		fnSubGraph("eq", "city", "paris"),
+	}}
+	child := &SubGraph{Attr: "friend", Filters: []*SubGraph{and}}
+	root := &SubGraph{
+		Attr:     "name",
+		Params:   params{Alias: "me", Langs: []string{"en"}},
+		SrcFunc:  &Function{Name: "has"},
+		Children: []*SubGraph{child},
+	}
+
+	root.optimize(testEstimator)
+	require.Equal(t, []string{"city", "age", "bio"}, filterAttrs(and))
+	require.Equal(t, uint64(50), *and.estimatedUids)
+	require.True(t, and.runsFirstFilter())
+	// The filters of edges are never swapped.
+	require.Equal(t, "friend", child.Attr)
+}
+
+func TestOptimizeSwapsRootFunc(t *testing.T) {
+	root := &SubGraph{
+		Attr:    "name",
+		Params:  params{Alias: "me"},
+		SrcFunc: &Function{Name: "has"},
+		Filters: []*SubGraph{{FilterOp: "and", Filters: []*SubGraph{
+			fnSubGraph("eq", "city", "paris"),
+			fnSubGraph("eq", "email", "alice@example.com"),
+		}}},
+	}
+	root.optimize(testEstimator)
+	require.Equal(t, "email", root.Attr)
+	require.Equal(t, "eq", root.SrcFunc.Name)
+	require.Equal(t, uint64(1), *root.estimatedUids)
+	require.Equal(t, []string{"city", "name"}, filterAttrs(root.Filters[0]))
+	require.Equal(t, "has", root.Filters[0].Filters[1].SrcFunc.Name)
+
+	// A single filter is swapped too.
+	root = &SubGraph{
+		Attr:    "age",
+		Params:  params{Alias: "me"},
+		SrcFunc: &Function{Name: "ge", Args: []gql.Arg{{Value: "20"}}},
+		Filters: []*SubGraph{fnSubGraph("anyofterms", "city", "paris")},
+	}
+	root.optimize(testEstimator)
+	require.Equal(t, "city", root.Attr)
+	require.Equal(t, []string{"age"}, filterAttrs(root))
+
+	// The languages of the functions are swapped along with them.
+	filter := fnSubGraph("eq", "email", "alice@example.com")
+	filter.Params.Langs = []string{"fr"}
+	root = &SubGraph{
+		Attr:    "name",
+		Params:  params{Alias: "me", Langs: []string{"en"}},
+		SrcFunc: &Function{Name: "anyofterms", Args: []gql.Arg{{Value: "alice"}}},
+		Filters: []*SubGraph{filter},
+	}
+	root.optimize(testEstimator)
+	require.Equal(t, "email", root.Attr)
+	require.Equal(t, []string{"fr"}, root.Params.Langs)
+	require.Equal(t, "me", root.Params.Alias)
+	require.Equal(t, "name", root.Filters[0].Attr)
+	require.Equal(t, []string{"en"}, root.Filters[0].Params.Langs)
+}
+
+func TestOptimizeKeepsRootFunc(t *testing.T) {
+	tests := []*SubGraph{
+		// The root function is already the most selective.
+		{
+			Attr:    "email",
+			SrcFunc: &Function{Name: "eq", Args: []gql.Arg{{Value: "alice@example.com"}}},
+			Filters: []*SubGraph{fnSubGraph("eq", "city", "paris")},
+		},
+		// OR-ed filters can't replace the root function.
+		{
+			Attr:    "name",
+			SrcFunc: &Function{Name: "has"},
+			Filters: []*SubGraph{{FilterOp: "or", Filters: []*SubGraph{
+				fnSubGraph("eq", "city", "paris"),
+				fnSubGraph("eq", "email", "alice@example.com"),
+			}}},
+		},
+		// Functions using value variables can't be estimated.
+		{
+			Attr:    "name",
+			SrcFunc: &Function{Name: "has"},
+			Filters: []*SubGraph{{Attr: "city", SrcFunc: &Function{Name: "eq",
+				Args: []gql.Arg{{Value: "c", IsValueVar: true}}}}},
+		},
+	}
+	for _, root := range tests {
+		attr := root.Attr
+		root.optimize(testEstimator)
+		require.Equal(t, attr, root.Attr)
+	}
+}
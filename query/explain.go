@@ -0,0 +1,240 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const (
+	// ExplainPlan returns the plan of the query without executing it.
+	ExplainPlan = "plan"
+	// ExplainAnalyze executes the query and returns its plan along with the number of uids
+	// and the worker latency of every step.
+	ExplainAnalyze = "analyze"
+
+	// explainKey is the key holding the plan in the JSON response.
+	explainKey = "_explain_"
+)
+
+// How a SubGraph finds its uids or values.
+const (
+	// strategyIndex looks up the index of the predicate for the tokens of the function.
+	strategyIndex = "index"
+	// strategyCountIndex looks up the count index of the predicate.
+	strategyCountIndex = "count_index"
+	// strategyScan iterates over all the keys of the predicate.
+	strategyScan = "scan"
+	// strategyLookup reads the posting list of the predicate for every source uid.
+	strategyLookup = "lookup"
+	// strategyUids uses the uids given in the query or held by a variable.
+	strategyUids = "uids"
+	// strategyVar evaluates the function using the values held by a variable.
+	strategyVar = "var"
+	// strategyRecurse and strategyShortest run the recurse and shortest path algorithms.
+	strategyRecurse  = "recurse"
+	strategyShortest = "shortest"
+	// strategyPropertyPath follows the edges matching a property path from the source uid.
+	strategyPropertyPath = "property_path"
+	// strategyGraphAlgo expands the predicate from the source uids level by level, and runs a
+	// graph algorithm over the nodes reached.
+	strategyGraphAlgo = "graph_algo"
+	// strategyNone doesn't run anything on the workers.
+	strategyNone = "none"
+)
+
+// PlanNode describes how a query block, an edge or a filter is executed.
+type PlanNode struct {
+	Name     string `json:"name,omitempty"`
+	Attr     string `json:"attr,omitempty"`
+	Func     string `json:"func,omitempty"`
+	FilterOp string `json:"filter_op,omitempty"`
+	Strategy string `json:"strategy"`
+	// Intersect is set when the uids found for the tokens of an index lookup must all match.
+	Intersect bool `json:"intersect,omitempty"`
+	// EstimatedUids is the number of uids the step is expected to return, when known before
+	// running the query.
+	EstimatedUids *uint64 `json:"estimated_uids,omitempty"`
+
+	// The following are only set once the query has been executed.
+	SrcUids       *uint64 `json:"src_uids,omitempty"`
+	DestUids      *uint64 `json:"dest_uids,omitempty"`
+	WorkerLatency string  `json:"worker_latency,omitempty"`
+
+	Filters  []*PlanNode `json:"filters,omitempty"`
+	Children []*PlanNode `json:"children,omitempty"`
+}
+
+// ExplainMode returns the explain mode requested for the query, if any. gRPC clients pass it
+// as the explain metadata and HTTP clients as a query parameter attached to the context.
+func ExplainMode(ctx context.Context) (string, error) {
+	mode, _ := ctx.Value(ExplainKey).(string)
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md["explain"]) > 0 {
+		mode = md["explain"][0]
+	}
+	switch mode {
+	case "", ExplainPlan, ExplainAnalyze:
+		return mode, nil
+	}
+	return "", errors.Errorf("Invalid explain mode %q. Valid modes are %q and %q",
+		mode, ExplainPlan, ExplainAnalyze)
+}
+
+func explain(sgs []*SubGraph, executed bool) []*PlanNode {
+	plan := make([]*PlanNode, 0, len(sgs))
+	for _, sg := range sgs {
+		plan = append(plan, explainSubGraph(sg, nil, executed))
+	}
+	return plan
+}
+
+func explainSubGraph(sg, parent *SubGraph, executed bool) *PlanNode {
+	node := &PlanNode{
+		Name:     sg.Params.Alias,
+		Attr:     x.ParseAttr(sg.Attr),
+		Func:     funcString(sg),
+		FilterOp: sg.FilterOp,
+	}
+	node.Strategy, node.Intersect = strategy(sg, parent)
+	switch {
+	case sg.estimatedUids != nil:
+		node.EstimatedUids = sg.estimatedUids
+	case parent == nil && sg.SrcFunc != nil && sg.SrcFunc.Name == "uid" && sg.SrcUIDs != nil:
+		n := uint64(len(sg.SrcUIDs.Uids))
+		node.EstimatedUids = &n
+	}
+
+	if executed && node.Strategy != strategyNone {
+		if sg.SrcUIDs != nil {
+			n := uint64(len(sg.SrcUIDs.Uids))
+			node.SrcUids = &n
+		}
+		if sg.DestUIDs != nil {
+			n := uint64(len(sg.DestUIDs.Uids))
+			node.DestUids = &n
+		}
+		if sg.taskLatency > 0 {
+			node.WorkerLatency = sg.taskLatency.String()
+		}
+	}
+
+	for _, filter := range sg.Filters {
+		node.Filters = append(node.Filters, explainSubGraph(filter, sg, executed))
+	}
+	for _, child := range sg.Children {
+		if child.IsInternal() && child.Attr == "expand" {
+			continue
+		}
+		node.Children = append(node.Children, explainSubGraph(child, sg, executed))
+	}
+	return node
+}
+
+// strategy returns how the SubGraph is processed, mirroring the choices made by ProcessGraph
+// and by the workers.
+func strategy(sg, parent *SubGraph) (string, bool) {
+	fn := sg.SrcFunc
+	switch {
+	case parent == nil && sg.Params.Alias == "shortest":
+		return strategyShortest, false
+	case parent == nil && sg.Params.Recurse:
+		return strategyRecurse, false
+	case sg.isGraphAlgo():
+		return strategyGraphAlgo, false
+	case sg.Attr == "uid" || sg.IsInternal() || sg.Params.IsEmpty:
+		return strategyNone, false
+	case fn != nil && fn.Name == "uid":
+		return strategyUids, false
+	case fn != nil && fn.Name == pathFn:
+		return strategyPropertyPath, false
+	case len(sg.Attr) == 0:
+		// Filters combining other filters.
+		return strategyNone, false
+	case fn != nil && (fn.IsValueVar || fn.IsLenVar):
+		return strategyVar, false
+	case fn != nil && fn.IsCount && parent == nil:
+		return strategyCountIndex, false
+	case fn != nil && fn.Name == "has":
+		return strategyScan, false
+	case fn != nil:
+		if index, intersect := worker.FuncUsesIndex(fn.Name, fn.IsCount); index {
+			return strategyIndex, intersect
+		}
+	}
+	return strategyLookup, false
+}
+
+func funcString(sg *SubGraph) string {
+	fn := sg.SrcFunc
+	if fn == nil {
+		return ""
+	}
+	var args []string
+	switch {
+	case fn.Name == "uid":
+		for _, v := range sg.Params.NeedsVar {
+			args = append(args, v.Name)
+		}
+		if sg.SrcUIDs != nil && len(sg.Params.NeedsVar) == 0 {
+			for _, uid := range sg.SrcUIDs.Uids {
+				args = append(args, "0x"+strconv.FormatUint(uid, 16))
+			}
+		}
+	case sg.Attr != "" && fn.IsCount:
+		args = append(args, "count("+x.ParseAttr(sg.Attr)+")")
+	case sg.Attr != "":
+		args = append(args, x.ParseAttr(sg.Attr))
+	}
+	for _, arg := range fn.Args {
+		if arg.IsValueVar {
+			args = append(args, "val("+arg.Value+")")
+			continue
+		}
+		args = append(args, strconv.Quote(arg.Value))
+	}
+	return fn.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+// WithPlan adds the plan to the JSON response under the _explain_ key.
+func WithPlan(js []byte, plan []*PlanNode) ([]byte, error) {
+	planJs, err := json.Marshal(plan)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while encoding query plan")
+	}
+	var buf bytes.Buffer
+	js = bytes.TrimSpace(js)
+	if len(js) < 2 || bytes.Equal(js, []byte("{}")) {
+		buf.WriteString("{")
+	} else {
+		buf.Write(js[:len(js)-1])
+		buf.WriteString(",")
+	}
+	buf.WriteString(strconv.Quote(explainKey) + ":")
+	buf.Write(planJs)
+	buf.WriteString("}")
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+func TestExplainMode(t *testing.T) {
+	mode, err := ExplainMode(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "", mode)
+
+	mode, err = ExplainMode(context.WithValue(context.Background(), ExplainKey, ExplainPlan))
+	require.NoError(t, err)
+	require.Equal(t, ExplainPlan, mode)
+
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("explain", ExplainAnalyze))
+	mode, err = ExplainMode(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ExplainAnalyze, mode)
+
+	_, err = ExplainMode(context.WithValue(context.Background(), ExplainKey, "everything"))
+	require.Error(t, err)
+}
+
+func TestExplainSubGraph(t *testing.T) {
+	name := &SubGraph{Attr: "name"}
+	friend := &SubGraph{
+		Attr:     "friend",
+		Children: []*SubGraph{{Attr: "uid"}},
+		Filters: []*SubGraph{{
+			Attr:    "name",
+			SrcFunc: &Function{Name: "allofterms", Args: []gql.Arg{{Value: "alice bob"}}},
+		}},
+		DestUIDs:    &pb.List{Uids: []uint64{2, 3}},
+		taskLatency: time.Millisecond,
+	}
+	root := &SubGraph{
+		Attr:     "name",
+		Params:   params{Alias: "me"},
+		SrcFunc:  &Function{Name: "eq", Args: []gql.Arg{{Value: "alice"}}},
+		Children: []*SubGraph{name, friend},
+	}
+
+	plan := explain([]*SubGraph{root}, false)
+	require.Len(t, plan, 1)
+	require.Equal(t, "me", plan[0].Name)
+	require.Equal(t, `eq(name, "alice")`, plan[0].Func)
+	require.Equal(t, strategyIndex, plan[0].Strategy)
+	require.Len(t, plan[0].Children, 2)
+	require.Equal(t, strategyLookup, plan[0].Children[0].Strategy)
+
+	friendPlan := plan[0].Children[1]
+	require.Nil(t, friendPlan.DestUids)
+	require.Len(t, friendPlan.Filters, 1)
+	require.Equal(t, strategyIndex, friendPlan.Filters[0].Strategy)
+	require.True(t, friendPlan.Filters[0].Intersect)
+	require.Equal(t, strategyNone, friendPlan.Children[0].Strategy)
+
+	plan = explain([]*SubGraph{root}, true)
+	friendPlan = plan[0].Children[1]
+	require.Equal(t, uint64(2), *friendPlan.DestUids)
+	require.Equal(t, "1ms", friendPlan.WorkerLatency)
+}
+
+func TestWithPlan(t *testing.T) {
+	plan := []*PlanNode{{Name: "me", Strategy: strategyScan}}
+
+	js, err := WithPlan([]byte(`{"me":[]}`), plan)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"me":[],"_explain_":[{"name":"me","strategy":"scan"}]}`, string(js))
+
+	js, err = WithPlan(nil, plan)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"_explain_":[{"name":"me","strategy":"scan"}]}`, string(js))
+}
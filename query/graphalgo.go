@@ -0,0 +1,293 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const (
+	pagerankFn   = "pagerank"
+	componentsFn = "components"
+	distanceFn   = "distance"
+
+	defaultPagerankIterations = 20
+	defaultPagerankDamping    = 0.85
+)
+
+// isGraphAlgo returns true if the SubGraph computes a graph algorithm, e.g.
+// pr as pagerank(follows, 20, 0.85), or the distance of the vectors of its source uids to a
+// vector, e.g. d as distance(embedding, $vec).
+func (sg *SubGraph) isGraphAlgo() bool {
+	return sg.SrcFunc != nil && (sg.SrcFunc.Name == pagerankFn ||
+		sg.SrcFunc.Name == componentsFn || sg.SrcFunc.Name == distanceFn)
+}
+
+// runGraphAlgo expands the graph formed by the predicate of the SubGraph from its source uids,
+// and computes the value of the algorithm for every node of the graph. The values are stored in
+// UidToVal, so they can be used through the variable of the SubGraph.
+func (sg *SubGraph) runGraphAlgo(ctx context.Context) error {
+	if sg.SrcFunc.Name == distanceFn {
+		return sg.runDistance(ctx)
+	}
+	adjacency, err := sg.expandGraph(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch sg.SrcFunc.Name {
+	case pagerankFn:
+		iterations, damping := defaultPagerankIterations, defaultPagerankDamping
+		args := sg.SrcFunc.Args
+		if len(args) > 0 {
+			if iterations, err = strconv.Atoi(args[0].Value); err != nil {
+				return errors.Wrapf(err, "while parsing the number of iterations of pagerank")
+			}
+		}
+		if len(args) > 1 {
+			if damping, err = strconv.ParseFloat(args[1].Value, 64); err != nil {
+				return errors.Wrapf(err, "while parsing the damping factor of pagerank")
+			}
+		}
+		sg.Params.UidToVal = pagerank(adjacency, iterations, damping)
+	case componentsFn:
+		sg.Params.UidToVal = components(adjacency)
+	}
+	return nil
+}
+
+// expandGraph returns the adjacency lists of the nodes reachable from the source uids of the
+// SubGraph through its predicate. Every reachable node has an entry, even without out edges.
+// The predicate is expanded one level at a time, on the groups serving it.
+func (sg *SubGraph) expandGraph(ctx context.Context) (map[uint64][]uint64, error) {
+	adjacency := make(map[uint64][]uint64)
+	if sg.SrcUIDs == nil {
+		return adjacency, nil
+	}
+
+	var numEdges uint64
+	frontier := make([]uint64, 0, len(sg.SrcUIDs.Uids))
+	for _, uid := range sg.SrcUIDs.Uids {
+		if _, ok := adjacency[uid]; !ok {
+			adjacency[uid] = nil
+			frontier = append(frontier, uid)
+		}
+	}
+	dummy := &SubGraph{}
+	for len(frontier) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		sort.Slice(frontier, func(i, j int) bool { return frontier[i] < frontier[j] })
+		edge := &SubGraph{
+			Attr:    sg.Attr,
+			ReadTs:  sg.ReadTs,
+			Cache:   sg.Cache,
+			SrcUIDs: &pb.List{Uids: frontier},
+		}
+		rch := make(chan error, 1)
+		ProcessGraph(ctx, edge, dummy, rch)
+		if err := <-rch; err != nil {
+			return nil, err
+		}
+		if edge.UnknownAttr {
+			return adjacency, nil
+		}
+
+		var next []uint64
+		for i, from := range edge.SrcUIDs.Uids {
+			if i >= len(edge.uidMatrix) {
+				break
+			}
+			for _, to := range edge.uidMatrix[i].Uids {
+				adjacency[from] = append(adjacency[from], to)
+				numEdges++
+				if _, ok := adjacency[to]; !ok {
+					adjacency[to] = nil
+					next = append(next, to)
+				}
+			}
+		}
+		if numEdges > x.Config.QueryEdgeLimit {
+			// If we've seen too many edges, stop the query.
+			return nil, errors.Errorf("Exceeded query edge limit = %v. Found %v edges.",
+				x.Config.QueryEdgeLimit, numEdges)
+		}
+		frontier = next
+	}
+	return adjacency, nil
+}
+
+// sortedNodes returns the nodes of the graph in ascending order, so the algorithms always
+// process them in the same order.
+func sortedNodes(adjacency map[uint64][]uint64) []uint64 {
+	nodes := make([]uint64, 0, len(adjacency))
+	for uid := range adjacency {
+		nodes = append(nodes, uid)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+	return nodes
+}
+
+// pagerank returns the PageRank of every node of the graph after the given number of iterations.
+// The rank of the nodes without out edges is spread evenly over the whole graph, so the ranks
+// always add up to 1.
+func pagerank(adjacency map[uint64][]uint64, iterations int, damping float64) map[uint64]types.Val {
+	nodes := sortedNodes(adjacency)
+	n := float64(len(nodes))
+	rank := make(map[uint64]float64, len(nodes))
+	for _, uid := range nodes {
+		rank[uid] = 1 / n
+	}
+
+	for i := 0; i < iterations; i++ {
+		var dangling float64
+		for _, uid := range nodes {
+			if len(adjacency[uid]) == 0 {
+				dangling += rank[uid]
+			}
+		}
+		next := make(map[uint64]float64, len(nodes))
+		for _, uid := range nodes {
+			next[uid] = (1-damping)/n + damping*dangling/n
+		}
+		for _, uid := range nodes {
+			out := adjacency[uid]
+			for _, to := range out {
+				next[to] += damping * rank[uid] / float64(len(out))
+			}
+		}
+		rank = next
+	}
+
+	vals := make(map[uint64]types.Val, len(rank))
+	for uid, r := range rank {
+		vals[uid] = types.Val{Tid: types.FloatID, Value: r}
+	}
+	return vals
+}
+
+// components returns the weakly connected component of every node of the graph, i.e. the
+// direction of the edges is ignored. A component is identified by its smallest uid.
+func components(adjacency map[uint64][]uint64) map[uint64]types.Val {
+	parent := make(map[uint64]uint64, len(adjacency))
+	var find func(uid uint64) uint64
+	find = func(uid uint64) uint64 {
+		p, ok := parent[uid]
+		if !ok || p == uid {
+			return uid
+		}
+		root := find(p)
+		parent[uid] = root
+		return root
+	}
+
+	nodes := sortedNodes(adjacency)
+	for _, uid := range nodes {
+		for _, to := range adjacency[uid] {
+			a, b := find(uid), find(to)
+			// Keep the smallest uid as the root, so it identifies the component.
+			switch {
+			case a < b:
+				parent[b] = a
+			case b < a:
+				parent[a] = b
+			}
+		}
+	}
+
+	vals := make(map[uint64]types.Val, len(nodes))
+	for _, uid := range nodes {
+		vals[uid] = types.Val{Tid: types.IntID, Value: int64(find(uid))}
+	}
+	return vals
+}
+
+// runDistance computes the euclidean distance between the vector of the predicate of every source
+// uid and the vector given to the function. The uids without a vector have no value.
+func (sg *SubGraph) runDistance(ctx context.Context) error {
+	if len(sg.SrcFunc.Args) != 1 {
+		return errors.Errorf("Function distance expects a predicate and a vector")
+	}
+	vec, err := types.ParseVector(sg.SrcFunc.Args[0].Value)
+	if err != nil {
+		return errors.Wrapf(err, "while parsing the vector of distance")
+	}
+	sg.Params.UidToVal = make(map[uint64]types.Val)
+	if sg.SrcUIDs == nil || len(sg.SrcUIDs.Uids) == 0 {
+		return nil
+	}
+
+	values := &SubGraph{
+		Attr:    sg.Attr,
+		ReadTs:  sg.ReadTs,
+		Cache:   sg.Cache,
+		SrcUIDs: sg.SrcUIDs,
+	}
+	rch := make(chan error, 1)
+	ProcessGraph(ctx, values, &SubGraph{}, rch)
+	if err := <-rch; err != nil {
+		return err
+	}
+	if values.UnknownAttr {
+		return nil
+	}
+
+	vectors := make(map[uint64][]float32, len(values.SrcUIDs.Uids))
+	for i, uid := range values.SrcUIDs.Uids {
+		if i >= len(values.valueMatrix) || len(values.valueMatrix[i].Values) == 0 {
+			continue
+		}
+		val, err := convertTo(values.valueMatrix[i].Values[0])
+		if err == ErrEmptyVal {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		v, ok := val.Value.([]float32)
+		if !ok {
+			return errors.Errorf("Function distance expects a predicate of type %s. Got: %s",
+				types.VFloatID.Name(), val.Tid.Name())
+		}
+		vectors[uid] = v
+	}
+	sg.Params.UidToVal, err = distances(vectors, vec)
+	return err
+}
+
+// distances returns the euclidean distance between every vector and the given one.
+func distances(vectors map[uint64][]float32, vec []float32) (map[uint64]types.Val, error) {
+	vals := make(map[uint64]types.Val, len(vectors))
+	for uid, v := range vectors {
+		d, err := posting.VectorDistance(v, vec)
+		if err != nil {
+			return nil, err
+		}
+		vals[uid] = types.Val{Tid: types.FloatID, Value: d}
+	}
+	return vals, nil
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/types"
+)
+
+func TestPagerank(t *testing.T) {
+	// 1 and 2 both point to 3, which points back to 1. 4 has no out edges.
+	adjacency := map[uint64][]uint64{
+		1: {3},
+		2: {3, 4},
+		3: {1},
+		4: nil,
+	}
+	vals := pagerank(adjacency, 50, 0.85)
+	require.Len(t, vals, 4)
+
+	var sum float64
+	for _, v := range vals {
+		require.Equal(t, types.FloatID, v.Tid)
+		sum += v.Value.(float64)
+	}
+	require.InDelta(t, 1.0, sum, 1e-9)
+
+	rank := func(uid uint64) float64 { return vals[uid].Value.(float64) }
+	require.True(t, rank(3) > rank(1))
+	require.True(t, rank(1) > rank(4))
+	require.True(t, rank(4) > rank(2))
+
+	// Without any iteration every node has the same rank.
+	vals = pagerank(adjacency, 0, 0.85)
+	require.Equal(t, 0.25, vals[2].Value.(float64))
+}
+
+func TestComponents(t *testing.T) {
+	adjacency := map[uint64][]uint64{
+		5: {2},
+		2: nil,
+		7: {5},
+		3: {9},
+		9: nil,
+		8: nil,
+	}
+	vals := components(adjacency)
+	expected := map[uint64]int64{5: 2, 2: 2, 7: 2, 3: 3, 9: 3, 8: 8}
+	require.Len(t, vals, len(expected))
+	for uid, label := range expected {
+		require.Equal(t, types.Val{Tid: types.IntID, Value: label}, vals[uid], "uid %d", uid)
+	}
+}
+
+func TestDistances(t *testing.T) {
+	vectors := map[uint64][]float32{
+		1: {0, 0},
+		2: {3, 4},
+	}
+	vals, err := distances(vectors, []float32{3, 0})
+	require.NoError(t, err)
+	require.Equal(t, map[uint64]types.Val{
+		1: {Tid: types.FloatID, Value: 3.0},
+		2: {Tid: types.FloatID, Value: 4.0},
+	}, vals)
+
+	_, err = distances(vectors, []float32{1, 2, 3})
+	require.Error(t, err)
+}
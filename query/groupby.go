@@ -24,6 +24,7 @@ import (
 	"github.com/dgraph-io/dgraph/algo"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
 	"github.com/pkg/errors"
 )
 
@@ -58,7 +59,7 @@ func (grp *groupResult) aggregateChild(child *SubGraph) error {
 	}
 	if child.SrcFunc != nil && isAggregatorFn(child.SrcFunc.Name) {
 		if fieldName == "" {
-			fieldName = fmt.Sprintf("%s(%s)", child.SrcFunc.Name, child.Attr)
+			fieldName = fmt.Sprintf("%s(%s)", child.SrcFunc.Name, x.ParseAttr(child.Attr))
 		}
 		finalVal, err := aggregateGroup(grp, child)
 		if err != nil {
@@ -138,8 +139,9 @@ func (d *dedup) addValue(attr string, value types.Val, uid uint64) {
 }
 
 func aggregateGroup(grp *groupResult, child *SubGraph) (types.Val, error) {
-	ag := aggregator{
-		name: child.SrcFunc.Name,
+	ag, err := newAggregator(child.SrcFunc)
+	if err != nil {
+		return types.Val{}, err
 	}
 	for _, uid := range grp.uids {
 		idx := sort.Search(len(child.SrcUIDs.Uids), func(i int) bool {
@@ -211,7 +213,7 @@ func (sg *SubGraph) formResult(ul *pb.List) (*groupResults, error) {
 
 		attr := child.Params.Alias
 		if attr == "" {
-			attr = child.Attr
+			attr = x.ParseAttr(child.Attr)
 		}
 		if len(child.DestUIDs.GetUids()) > 0 {
 			// It's a UID node.
@@ -293,7 +295,7 @@ func (sg *SubGraph) fillGroupedVars(doneVars map[string]varValue, path []*SubGra
 
 		attr := child.Params.Alias
 		if attr == "" {
-			attr = child.Attr
+			attr = x.ParseAttr(child.Attr)
 		}
 		if len(child.DestUIDs.GetUids()) > 0 {
 			// It's a UID node.
@@ -231,6 +231,14 @@ func evalMathTree(mNode *mathTree) error {
 	}
 
 	aggName := mNode.Fn
+	if fn, ok := valFunctions[aggName]; ok {
+		if len(mNode.Child) != fn.nargs {
+			return errors.Errorf("Function %v expects %v argument. But got: %v", aggName,
+				fn.nargs, len(mNode.Child))
+		}
+		return processValFunc(mNode)
+	}
+
 	if isUnary(aggName) {
 		if len(mNode.Child) != 1 {
 			return errors.Errorf("Function %v expects 1 argument. But got: %v", aggName,
@@ -18,6 +18,7 @@ package query
 
 import (
 	"testing"
+	"time"
 
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/stretchr/testify/require"
@@ -386,3 +387,138 @@ func TestProcessTernary(t *testing.T) {
 }
 
 func TestEvalMathTree(t *testing.T) {}
+
+func TestProcessValFunctions(t *testing.T) {
+	str := func(s string) *mathTree {
+		return &mathTree{Const: types.Val{Tid: types.StringID, Value: s}}
+	}
+	num := func(n int64) *mathTree {
+		return &mathTree{Const: types.Val{Tid: types.IntID, Value: n}}
+	}
+	date := func(s string) types.Val {
+		d, err := time.Parse(time.RFC3339, s)
+		require.NoError(t, err)
+		return types.Val{Tid: types.DateTimeID, Value: d}
+	}
+	dates := &mathTree{Var: "d", Val: map[uint64]types.Val{
+		1: date("2019-01-31T10:20:30Z"),
+		2: date("2020-02-29T23:00:00Z"),
+	}}
+
+	tests := []struct {
+		in  *mathTree
+		out types.Val
+	}{
+		{in: &mathTree{Fn: "concat", Child: []*mathTree{str("ab"), num(12)}},
+			out: types.Val{Tid: types.StringID, Value: "ab12"}},
+		{in: &mathTree{Fn: "upper", Child: []*mathTree{str("héllo")}},
+			out: types.Val{Tid: types.StringID, Value: "HÉLLO"}},
+		{in: &mathTree{Fn: "substr", Child: []*mathTree{str("héllo"), num(1), num(10)}},
+			out: types.Val{Tid: types.StringID, Value: "éllo"}},
+		{in: &mathTree{Fn: "len", Child: []*mathTree{str("héllo")}},
+			out: types.Val{Tid: types.IntID, Value: int64(5)}},
+		{in: &mathTree{Fn: "year", Child: []*mathTree{str("2019-03-04")}},
+			out: types.Val{Tid: types.IntID, Value: int64(2019)}},
+	}
+	for _, tc := range tests {
+		require.NoError(t, evalMathTree(tc.in))
+		require.Equal(t, tc.out, tc.in.Const, tc.in.Fn)
+	}
+
+	varTests := []struct {
+		in  *mathTree
+		out map[uint64]types.Val
+	}{
+		{in: &mathTree{Fn: "dateadd", Child: []*mathTree{dates, num(1), str("month")}},
+			out: map[uint64]types.Val{
+				1: date("2019-03-03T10:20:30Z"),
+				2: date("2020-03-29T23:00:00Z"),
+			}},
+		{in: &mathTree{Fn: "truncate", Child: []*mathTree{dates, str("day")}},
+			out: map[uint64]types.Val{
+				1: date("2019-01-31T00:00:00Z"),
+				2: date("2020-02-29T00:00:00Z"),
+			}},
+		{in: &mathTree{Fn: "datediff", Child: []*mathTree{dates, str("2019-01-01"),
+			str("month")}},
+			out: map[uint64]types.Val{
+				1: {Tid: types.IntID, Value: int64(0)},
+				2: {Tid: types.IntID, Value: int64(13)},
+			}},
+		{in: &mathTree{Fn: "datediff", Child: []*mathTree{str("2019-01-01"), dates,
+			str("hour")}},
+			out: map[uint64]types.Val{
+				1: {Tid: types.IntID, Value: int64(-730)},
+				2: {Tid: types.IntID, Value: int64(-10199)},
+			}},
+	}
+	for _, tc := range varTests {
+		require.NoError(t, evalMathTree(tc.in))
+		require.Equal(t, tc.out, tc.in.Val, tc.in.Fn)
+	}
+
+	err := evalMathTree(&mathTree{Fn: "truncate", Child: []*mathTree{dates, str("week")}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `Invalid unit "week"`)
+}
+
+func TestTemporalArithmetic(t *testing.T) {
+	date := func(s string) types.Val {
+		d, err := time.Parse(time.RFC3339, s)
+		require.NoError(t, err)
+		return types.Val{Tid: types.DateTimeID, Value: d}
+	}
+	dur := func(d time.Duration) types.Val {
+		return types.Val{Tid: types.DurationID, Value: d}
+	}
+	leaf := func(v types.Val) *mathTree {
+		return &mathTree{Var: "v", Val: map[uint64]types.Val{1: v}}
+	}
+	constant := func(v types.Val) *mathTree {
+		return &mathTree{Const: v}
+	}
+
+	tests := []struct {
+		fn   string
+		l, r *mathTree
+		out  types.Val
+	}{
+		{fn: "+", l: leaf(date("2019-01-31T10:00:00Z")), r: constant(dur(36 * time.Hour)),
+			out: date("2019-02-01T22:00:00Z")},
+		{fn: "-", l: leaf(date("2019-01-31T10:00:00Z")),
+			r:   constant(types.Val{Tid: types.StringID, Value: "PT30M"}),
+			out: date("2019-01-31T09:30:00Z")},
+		{fn: "-", l: leaf(date("2019-01-31T10:00:00Z")),
+			r:   constant(types.Val{Tid: types.StringID, Value: "2019-01-30"}),
+			out: dur(34 * time.Hour)},
+		{fn: "+", l: leaf(dur(time.Hour)), r: leaf(dur(time.Minute)),
+			out: dur(61 * time.Minute)},
+		{fn: "*", l: leaf(dur(time.Hour)), r: constant(types.Val{Tid: types.FloatID, Value: 1.5}),
+			out: dur(90 * time.Minute)},
+		{fn: "/", l: leaf(dur(time.Hour)), r: constant(dur(20 * time.Minute)),
+			out: types.Val{Tid: types.FloatID, Value: 3.0}},
+		{fn: "max", l: leaf(dur(time.Hour)), r: constant(dur(time.Minute)),
+			out: dur(time.Hour)},
+	}
+	for _, tc := range tests {
+		tree := &mathTree{Fn: tc.fn, Child: []*mathTree{tc.l, tc.r}}
+		require.NoError(t, evalMathTree(tree))
+		require.Equal(t, tc.out, tree.Val[1], tc.fn)
+	}
+
+	tree := &mathTree{Fn: ">", Child: []*mathTree{leaf(dur(time.Hour)),
+		constant(types.Val{Tid: types.StringID, Value: "45m"})}}
+	require.NoError(t, evalMathTree(tree))
+	require.Equal(t, true, tree.Val[1].Value)
+
+	tree = &mathTree{Fn: "u-", Child: []*mathTree{leaf(dur(time.Hour))}}
+	require.NoError(t, evalMathTree(tree))
+	require.Equal(t, dur(-time.Hour), tree.Val[1])
+
+	tree = &mathTree{Fn: "/", Child: []*mathTree{leaf(dur(time.Hour)),
+		constant(types.Val{Tid: types.IntID, Value: int64(0)})}}
+	require.Error(t, evalMathTree(tree))
+	tree = &mathTree{Fn: "+", Child: []*mathTree{leaf(date("2019-01-31T10:00:00Z")),
+		leaf(date("2019-01-31T10:00:00Z"))}}
+	require.Error(t, evalMathTree(tree))
+}
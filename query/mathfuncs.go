@@ -0,0 +1,389 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/types"
+)
+
+// valFunc is a string or datetime function of math(). Unlike the numeric functions, each one
+// checks and converts the types of its own arguments.
+type valFunc struct {
+	nargs int
+	apply func(args []types.Val) (types.Val, error)
+}
+
+var valFunctions = map[string]valFunc{
+	"concat":   {nargs: 2, apply: applyConcat},
+	"lower":    {nargs: 1, apply: applyLower},
+	"upper":    {nargs: 1, apply: applyUpper},
+	"substr":   {nargs: 3, apply: applySubstr},
+	"len":      {nargs: 1, apply: applyLen},
+	"dateadd":  {nargs: 3, apply: applyDateAdd},
+	"datediff": {nargs: 3, apply: applyDateDiff},
+	"truncate": {nargs: 2, apply: applyTruncate},
+	"year":     {nargs: 1, apply: applyYear},
+}
+
+func toString(fn string, v types.Val) (string, error) {
+	str := types.ValueForType(types.StringID)
+	if err := types.Marshal(v, &str); err != nil {
+		return "", errors.Wrapf(err, "Wrong type %v encountered for func %s", v.Tid, fn)
+	}
+	return str.Value.(string), nil
+}
+
+func toInt(fn string, v types.Val) (int64, error) {
+	if v.Tid != types.IntID {
+		return 0, errors.Errorf("Wrong type %v encountered for func %s. Expected an int",
+			v.Tid, fn)
+	}
+	return v.Value.(int64), nil
+}
+
+func toTime(fn string, v types.Val) (time.Time, error) {
+	if v.Tid == types.DateTimeID {
+		return v.Value.(time.Time), nil
+	}
+	// Values of other types, like strings, are converted from their binary form.
+	data := types.ValueForType(types.BinaryID)
+	err := types.Marshal(v, &data)
+	if err == nil {
+		v, err = types.Convert(types.Val{Tid: v.Tid, Value: data.Value}, types.DateTimeID)
+	}
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "Wrong type %v encountered for func %s", v.Tid, fn)
+	}
+	return v.Value.(time.Time), nil
+}
+
+func applyConcat(args []types.Val) (types.Val, error) {
+	a, err := toString("concat", args[0])
+	if err != nil {
+		return types.Val{}, err
+	}
+	b, err := toString("concat", args[1])
+	if err != nil {
+		return types.Val{}, err
+	}
+	return types.Val{Tid: types.StringID, Value: a + b}, nil
+}
+
+func applyLower(args []types.Val) (types.Val, error) {
+	str, err := toString("lower", args[0])
+	return types.Val{Tid: types.StringID, Value: strings.ToLower(str)}, err
+}
+
+func applyUpper(args []types.Val) (types.Val, error) {
+	str, err := toString("upper", args[0])
+	return types.Val{Tid: types.StringID, Value: strings.ToUpper(str)}, err
+}
+
+// applySubstr returns the length characters of the string starting at the start character,
+// e.g. substr(name, 0, 3). The substring stops at the end of the string.
+func applySubstr(args []types.Val) (types.Val, error) {
+	str, err := toString("substr", args[0])
+	if err != nil {
+		return types.Val{}, err
+	}
+	start, err := toInt("substr", args[1])
+	if err != nil {
+		return types.Val{}, err
+	}
+	length, err := toInt("substr", args[2])
+	if err != nil {
+		return types.Val{}, err
+	}
+	if start < 0 || length < 0 {
+		return types.Val{}, errors.Errorf("Function substr expects a non-negative start and "+
+			"length. Got: %d, %d", start, length)
+	}
+	runes := []rune(str)
+	if start > int64(len(runes)) {
+		start = int64(len(runes))
+	}
+	end := start + length
+	if end > int64(len(runes)) {
+		end = int64(len(runes))
+	}
+	return types.Val{Tid: types.StringID, Value: string(runes[start:end])}, nil
+}
+
+func applyLen(args []types.Val) (types.Val, error) {
+	str, err := toString("len", args[0])
+	return types.Val{Tid: types.IntID, Value: int64(utf8.RuneCountInString(str))}, err
+}
+
+func applyYear(args []types.Val) (types.Val, error) {
+	t, err := toTime("year", args[0])
+	return types.Val{Tid: types.IntID, Value: int64(t.Year())}, err
+}
+
+// dateUnits are the units of dateadd, datediff and truncate shorter than a month.
+var dateUnits = map[string]time.Duration{
+	"second": time.Second,
+	"minute": time.Minute,
+	"hour":   time.Hour,
+	"day":    24 * time.Hour,
+}
+
+func parseDateUnit(fn string, v types.Val) (string, error) {
+	if v.Tid != types.StringID {
+		return "", errors.Errorf("Function %s expects a unit as a string. Got: %v", fn, v.Tid)
+	}
+	unit := strings.ToLower(v.Value.(string))
+	if _, ok := dateUnits[unit]; ok || unit == "month" || unit == "year" {
+		return unit, nil
+	}
+	return "", errors.Errorf("Invalid unit %q for func %s. Expected second, minute, hour, day, "+
+		"month or year", unit, fn)
+}
+
+// applyDateAdd adds a number of units to the datetime, e.g. dateadd(dt, 3, "day").
+func applyDateAdd(args []types.Val) (types.Val, error) {
+	t, err := toTime("dateadd", args[0])
+	if err != nil {
+		return types.Val{}, err
+	}
+	n, err := toInt("dateadd", args[1])
+	if err != nil {
+		return types.Val{}, err
+	}
+	unit, err := parseDateUnit("dateadd", args[2])
+	if err != nil {
+		return types.Val{}, err
+	}
+	switch unit {
+	case "month":
+		t = t.AddDate(0, int(n), 0)
+	case "year":
+		t = t.AddDate(int(n), 0, 0)
+	default:
+		t = t.Add(time.Duration(n) * dateUnits[unit])
+	}
+	return types.Val{Tid: types.DateTimeID, Value: t}, nil
+}
+
+// applyDateDiff returns the number of whole units from the second datetime to the first one,
+// e.g. datediff(end, start, "day"). It's negative if the first datetime is the earliest.
+func applyDateDiff(args []types.Val) (types.Val, error) {
+	a, err := toTime("datediff", args[0])
+	if err != nil {
+		return types.Val{}, err
+	}
+	b, err := toTime("datediff", args[1])
+	if err != nil {
+		return types.Val{}, err
+	}
+	unit, err := parseDateUnit("datediff", args[2])
+	if err != nil {
+		return types.Val{}, err
+	}
+
+	var diff int64
+	switch unit {
+	case "month", "year":
+		b = b.In(a.Location())
+		months := int64(a.Year()-b.Year())*12 + int64(a.Month()-b.Month())
+		// Don't count the last month if it isn't complete.
+		switch {
+		case months > 0 && a.Before(b.AddDate(0, int(months), 0)):
+			months--
+		case months < 0 && a.After(b.AddDate(0, int(months), 0)):
+			months++
+		}
+		diff = months
+		if unit == "year" {
+			diff = months / 12
+		}
+	default:
+		diff = int64(a.Sub(b) / dateUnits[unit])
+	}
+	return types.Val{Tid: types.IntID, Value: diff}, nil
+}
+
+// applyTruncate rounds the datetime down to the start of its unit, e.g. truncate(dt, "day").
+func applyTruncate(args []types.Val) (types.Val, error) {
+	t, err := toTime("truncate", args[0])
+	if err != nil {
+		return types.Val{}, err
+	}
+	unit, err := parseDateUnit("truncate", args[1])
+	if err != nil {
+		return types.Val{}, err
+	}
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	switch unit {
+	case "year":
+		t = time.Date(year, time.January, 1, 0, 0, 0, 0, t.Location())
+	case "month":
+		t = time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	case "day":
+		t = time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	case "hour":
+		t = time.Date(year, month, day, hour, 0, 0, 0, t.Location())
+	case "minute":
+		t = time.Date(year, month, day, hour, min, 0, 0, t.Location())
+	case "second":
+		t = time.Date(year, month, day, hour, min, sec, 0, t.Location())
+	}
+	return types.Val{Tid: types.DateTimeID, Value: t}, nil
+}
+
+// processValFunc handles the string and datetime functions, like concat, substr or dateadd.
+// A uid only gets a value if all the variables used as arguments have one for it.
+func processValFunc(mNode *mathTree) error {
+	fn := valFunctions[mNode.Fn]
+	args := make([]types.Val, len(mNode.Child))
+
+	if allConsts(mNode.Child) {
+		for i, ch := range mNode.Child {
+			args[i] = ch.Const
+		}
+		var err error
+		mNode.Const, err = fn.apply(args)
+		return err
+	}
+
+	// The uids are the ones of the first variable argument.
+	var keys map[uint64]types.Val
+	for _, ch := range mNode.Child {
+		if ch.Const.Value == nil {
+			keys = ch.Val
+			break
+		}
+	}
+	destMap := make(map[uint64]types.Val)
+outer:
+	for k := range keys {
+		for i, ch := range mNode.Child {
+			args[i] = ch.Const
+			if ch.Const.Value == nil {
+				v, ok := ch.Val[k]
+				if !ok || v.Value == nil {
+					continue outer
+				}
+				args[i] = v
+			}
+		}
+		res, err := fn.apply(args)
+		if err != nil {
+			return err
+		}
+		destMap[k] = res
+	}
+	mNode.Val = destMap
+	return nil
+}
+
+func allConsts(children []*mathTree) bool {
+	for _, ch := range children {
+		if ch.Const.Value == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func isTemporal(tid types.TypeID) bool {
+	return tid == types.DateTimeID || tid == types.DurationID
+}
+
+// castTemporal converts a string constant used along with a datetime or a duration, e.g. the
+// "PT1H" of math(start + "PT1H"), to a duration, or else to a datetime.
+func castTemporal(v *types.Val, other types.Val) {
+	if v.Tid != types.StringID || !isTemporal(other.Tid) {
+		return
+	}
+	str, ok := v.Value.(string)
+	if !ok {
+		return
+	}
+	if d, err := types.ParseDuration(str); err == nil {
+		*v = types.Val{Tid: types.DurationID, Value: d}
+	} else if t, err := types.ParseTime(str); err == nil {
+		*v = types.Val{Tid: types.DateTimeID, Value: t}
+	}
+}
+
+func toSeconds(v types.Val) (float64, bool) {
+	switch v.Tid {
+	case types.IntID:
+		return float64(v.Value.(int64)), true
+	case types.FloatID:
+		return v.Value.(float64), true
+	}
+	return 0, false
+}
+
+// applyTemporal applies the arithmetic operators on datetimes and durations. A duration can be
+// added to or subtracted from a datetime, and the difference of two datetimes is a duration.
+// Durations can be added together, multiplied or divided by numbers, and divided by each other.
+// It returns false if the operator doesn't apply on datetimes or durations.
+func applyTemporal(fn string, a, b types.Val) (types.Val, bool, error) {
+	castTemporal(&a, b)
+	castTemporal(&b, a)
+	if !isTemporal(a.Tid) && !isTemporal(b.Tid) {
+		return types.Val{}, false, nil
+	}
+
+	duration := func(d time.Duration) (types.Val, bool, error) {
+		return types.Val{Tid: types.DurationID, Value: d}, true, nil
+	}
+	datetime := func(t time.Time) (types.Val, bool, error) {
+		return types.Val{Tid: types.DateTimeID, Value: t}, true, nil
+	}
+	an, aNum := toSeconds(a)
+	bn, bNum := toSeconds(b)
+	switch {
+	case fn == "+" && a.Tid == types.DateTimeID && b.Tid == types.DurationID:
+		return datetime(a.Value.(time.Time).Add(b.Value.(time.Duration)))
+	case fn == "+" && a.Tid == types.DurationID && b.Tid == types.DateTimeID:
+		return datetime(b.Value.(time.Time).Add(a.Value.(time.Duration)))
+	case fn == "-" && a.Tid == types.DateTimeID && b.Tid == types.DurationID:
+		return datetime(a.Value.(time.Time).Add(-b.Value.(time.Duration)))
+	case fn == "-" && a.Tid == types.DateTimeID && b.Tid == types.DateTimeID:
+		return duration(a.Value.(time.Time).Sub(b.Value.(time.Time)))
+	case fn == "+" && a.Tid == types.DurationID && b.Tid == types.DurationID:
+		return duration(a.Value.(time.Duration) + b.Value.(time.Duration))
+	case fn == "-" && a.Tid == types.DurationID && b.Tid == types.DurationID:
+		return duration(a.Value.(time.Duration) - b.Value.(time.Duration))
+	case fn == "*" && a.Tid == types.DurationID && bNum:
+		return duration(time.Duration(float64(a.Value.(time.Duration)) * bn))
+	case fn == "*" && aNum && b.Tid == types.DurationID:
+		return duration(time.Duration(an * float64(b.Value.(time.Duration))))
+	case fn == "/" && a.Tid == types.DurationID && bNum:
+		if bn == 0 {
+			return types.Val{}, true, errors.Errorf("Division by zero")
+		}
+		return duration(time.Duration(float64(a.Value.(time.Duration)) / bn))
+	case fn == "/" && a.Tid == types.DurationID && b.Tid == types.DurationID:
+		if b.Value.(time.Duration) == 0 {
+			return types.Val{}, true, errors.Errorf("Division by zero")
+		}
+		ratio := float64(a.Value.(time.Duration)) / float64(b.Value.(time.Duration))
+		return types.Val{Tid: types.FloatID, Value: ratio}, true, nil
+	}
+	return types.Val{}, false, nil
+}
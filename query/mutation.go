@@ -68,8 +68,9 @@ func expandEdges(ctx context.Context, m *pb.Mutations) ([]*pb.DirectedEdge, erro
 			if err != nil {
 				return nil, err
 			}
-			preds = append(preds, getPredicatesFromTypes(types)...)
-			preds = append(preds, x.ReservedPredicates()...)
+			ns := x.ExtractNamespace(ctx)
+			preds = append(preds, getPredicatesFromTypes(ns, types)...)
+			preds = append(preds, x.NamespaceReservedPredicates(ns)...)
 		}
 
 		for _, pred := range preds {
@@ -113,7 +114,7 @@ func verifyUid(ctx context.Context, uid uint64) error {
 // it shows up in the subjects or objects
 func AssignUids(ctx context.Context, nquads []*api.NQuad) (map[string]uint64, error) {
 	newUids := make(map[string]uint64)
-	num := &pb.Num{}
+	num := &pb.Num{Namespace: x.ExtractNamespace(ctx)}
 	var err error
 	for _, nq := range nquads {
 		// We dont want to assign uids to these.
@@ -0,0 +1,178 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// namespaceQuery rewrites the predicates used by the query block, and by the blocks within it,
+// to the attributes storing them in the namespace ns. The names used in the response are
+// stripped of the namespace again (see SubGraph.fieldName).
+func namespaceQuery(ns uint64, gq *gql.GraphQuery) {
+	if ns == x.GalaxyNamespace || gq == nil {
+		return
+	}
+	if isPredicateBlock(gq) {
+		gq.Attr = x.NamespaceAttr(ns, gq.Attr)
+	}
+	namespaceFunction(ns, gq.Func)
+	namespaceFilter(ns, gq.Filter)
+	for _, order := range gq.Order {
+		// Ordering by a value variable uses the name of the variable.
+		if !needsVar(gq, order.Attr) {
+			order.Attr = x.NamespaceAttr(ns, order.Attr)
+		}
+	}
+	for i := range gq.GroupbyAttrs {
+		gq.GroupbyAttrs[i].Attr = x.NamespaceAttr(ns, gq.GroupbyAttrs[i].Attr)
+	}
+	// The locations used by A* and the weights of the nodes can be read from predicates by
+	// shortest path queries.
+	for _, arg := range []string{"heuristic", "weight"} {
+		if pred := gq.Args[arg]; len(pred) > 0 && !needsVar(gq, pred) {
+			gq.Args[arg] = x.NamespaceAttr(ns, pred)
+		}
+	}
+	for _, child := range gq.Children {
+		namespaceQuery(ns, child)
+	}
+}
+
+// isPredicateBlock returns true if the block reads a predicate, as opposed to the blocks for
+// uid, val(), math(), expand() and the aggregations of variables.
+func isPredicateBlock(gq *gql.GraphQuery) bool {
+	switch {
+	case gq.Attr == "" || gq.Attr == "uid":
+		return false
+	case gq.IsInternal:
+		// Only the graph algorithms read the predicate given to their function.
+		return gq.Func != nil && gq.Func.Attr == gq.Attr
+	}
+	return true
+}
+
+func namespaceFunction(ns uint64, f *gql.Function) {
+	switch {
+	case f == nil:
+	case f.Name == "type":
+		// type(T) is eq(dgraph.type, T) with the dgraph.type predicate of the namespace.
+		f.Attr = x.NamespaceAttr(ns, "dgraph.type")
+	case f.Attr != "" && f.Attr != "uid" && !f.IsValueVar && !f.IsLenVar:
+		f.Attr = x.NamespaceAttr(ns, f.Attr)
+	}
+}
+
+func namespaceFilter(ns uint64, ft *gql.FilterTree) {
+	if ft == nil {
+		return
+	}
+	namespaceFunction(ns, ft.Func)
+	for _, child := range ft.Child {
+		namespaceFilter(ns, child)
+	}
+}
+
+func needsVar(gq *gql.GraphQuery, name string) bool {
+	for _, v := range gq.NeedsVar {
+		if v.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// GetNamespaceSchema returns the predicates and the types requested by req within the namespace
+// attached to the context, named the way they are within the namespace.
+func GetNamespaceSchema(ctx context.Context, req *pb.SchemaRequest) (
+	[]*pb.SchemaNode, []*pb.TypeUpdate, error) {
+	ns := x.ExtractNamespace(ctx)
+	schemaReq := namespaceSchemaRequest(ns, req)
+	preds, err := worker.GetSchemaOverNetwork(ctx, schemaReq)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "while fetching schema")
+	}
+	types, err := worker.GetTypes(ctx, schemaReq)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "while fetching types")
+	}
+	return stripSchemaNamespace(ns, preds), stripTypesNamespace(ns, types), nil
+}
+
+// namespaceSchemaRequest returns the schema request for the predicates and types of the
+// namespace ns.
+func namespaceSchemaRequest(ns uint64, req *pb.SchemaRequest) *pb.SchemaRequest {
+	out := *req
+	out.Predicates = make([]string, 0, len(req.Predicates))
+	for _, pred := range req.Predicates {
+		out.Predicates = append(out.Predicates, x.NamespaceAttr(ns, pred))
+	}
+	out.Types = make([]string, 0, len(req.Types))
+	for _, typ := range req.Types {
+		out.Types = append(out.Types, x.NamespaceAttr(ns, typ))
+	}
+	return &out
+}
+
+// stripSchemaNamespace returns the schema of the predicates of the namespace ns, named the way
+// they are within the namespace.
+func stripSchemaNamespace(ns uint64, nodes []*pb.SchemaNode) []*pb.SchemaNode {
+	out := nodes[:0]
+	for _, node := range nodes {
+		if x.ParseNamespace(node.Predicate) != ns {
+			continue
+		}
+		node.Predicate = x.ParseAttr(node.Predicate)
+		out = append(out, node)
+	}
+	return out
+}
+
+// stripTypesNamespace returns the types of the namespace ns, named the way they are within the
+// namespace. The types are copied, since their fields and indexes are shared with the schema state.
+func stripTypesNamespace(ns uint64, types []*pb.TypeUpdate) []*pb.TypeUpdate {
+	var out []*pb.TypeUpdate
+	for _, typ := range types {
+		if x.ParseNamespace(typ.TypeName) != ns {
+			continue
+		}
+		stripped := &pb.TypeUpdate{TypeName: x.ParseAttr(typ.TypeName)}
+		for _, field := range typ.Fields {
+			f := *field
+			f.Predicate = x.ParseAttr(f.Predicate)
+			stripped.Fields = append(stripped.Fields, &f)
+		}
+		for _, index := range typ.Indexes {
+			i := *index
+			i.TypeName = stripped.TypeName
+			i.Predicates = make([]string, 0, len(index.Predicates))
+			for _, pred := range index.Predicates {
+				i.Predicates = append(i.Predicates, x.ParseAttr(pred))
+			}
+			stripped.Indexes = append(stripped.Indexes, &i)
+		}
+		out = append(out, stripped)
+	}
+	return out
+}
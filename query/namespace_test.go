@@ -0,0 +1,73 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestNamespaceQuery(t *testing.T) {
+	res, err := gql.Parse(gql.Request{Str: `{
+		q(func: eq(name, "alice"), orderasc: age) @filter(has(friend) OR type(Person)) {
+			uid
+			name
+			~friend {
+				count(uid)
+			}
+		}
+	}`})
+	require.NoError(t, err)
+	gq := res.Query[0]
+	namespaceQuery(7, gq)
+
+	require.Equal(t, x.NamespaceAttr(7, "name"), gq.Func.Attr)
+	require.Equal(t, x.NamespaceAttr(7, "age"), gq.Order[0].Attr)
+	require.Equal(t, x.NamespaceAttr(7, "friend"), gq.Filter.Child[0].Func.Attr)
+	require.Equal(t, x.NamespaceAttr(7, "dgraph.type"), gq.Filter.Child[1].Func.Attr)
+	require.Equal(t, "uid", gq.Children[0].Attr)
+	require.Equal(t, x.NamespaceAttr(7, "name"), gq.Children[1].Attr)
+	require.Equal(t, "~"+x.NamespaceAttr(7, "friend"), gq.Children[2].Attr)
+	require.Equal(t, "uid", gq.Children[2].Children[0].Attr)
+}
+
+func TestStripNamespace(t *testing.T) {
+	nodes := []*pb.SchemaNode{
+		{Predicate: x.NamespaceAttr(7, "name")},
+		{Predicate: "name"},
+		{Predicate: x.NamespaceAttr(8, "age")},
+	}
+	nodes = stripSchemaNamespace(7, nodes)
+	require.Len(t, nodes, 1)
+	require.Equal(t, "name", nodes[0].Predicate)
+
+	field := &pb.SchemaUpdate{Predicate: x.NamespaceAttr(7, "name")}
+	types := stripTypesNamespace(7, []*pb.TypeUpdate{
+		{TypeName: x.NamespaceAttr(7, "Person"), Fields: []*pb.SchemaUpdate{field}},
+		{TypeName: "Person"},
+	})
+	require.Len(t, types, 1)
+	require.Equal(t, "Person", types[0].TypeName)
+	require.Equal(t, "name", types[0].Fields[0].Predicate)
+	// the fields of the schema state are left as is
+	require.Equal(t, x.NamespaceAttr(7, "name"), field.Predicate)
+}
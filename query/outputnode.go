@@ -31,6 +31,7 @@ import (
 
 	"github.com/dgraph-io/dgo/v2/protos/api"
 	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/task"
 	"github.com/dgraph-io/dgraph/types"
@@ -170,6 +171,8 @@ func valToBytes(v types.Val) ([]byte, error) {
 		return []byte(fmt.Sprintf("\"%#x\"", v.Value)), nil
 	case types.PasswordID:
 		return []byte(fmt.Sprintf("%q", v.Value.(string))), nil
+	case types.BigIntID, types.DecimalID, types.DurationID, types.UUIDID, types.VFloatID:
+		return v.MarshalJSON()
 	default:
 		return nil, errors.New("Unsupported types.Val.Tid")
 	}
@@ -484,6 +487,66 @@ func handleCountUIDNodes(sg *SubGraph, n outputNode, count int) bool {
 	return addedNewChild
 }
 
+// isBlockAggregate returns whether the SubGraph aggregates the values of its predicate over all
+// the nodes of its block, like countdistinct(name) outside of @groupby.
+func (sg *SubGraph) isBlockAggregate() bool {
+	return sg.SrcFunc != nil && isAggregatorFn(sg.SrcFunc.Name) && !sg.IsInternal() &&
+		len(sg.Params.NeedsVar) == 0
+}
+
+// handleBlockAggregates adds the aggregates of the children of sg over the values of the given
+// uids, each one in its own object like count(uid).
+func handleBlockAggregates(sg *SubGraph, n outputNode, uids []uint64) (bool, error) {
+	if sg.IsGroupBy() {
+		return false, nil
+	}
+	addedNewChild := false
+	fieldName := sg.fieldName()
+	for _, child := range sg.Children {
+		if !child.isBlockAggregate() || (child.Params.Alias == "" && child.Params.Normalize) {
+			continue
+		}
+		ag, err := newAggregator(child.SrcFunc)
+		if err != nil {
+			return false, err
+		}
+		for _, uid := range uids {
+			idx := algo.IndexOf(child.SrcUIDs, uid)
+			if idx < 0 || idx >= len(child.valueMatrix) {
+				continue
+			}
+			for _, tv := range child.valueMatrix[idx].Values {
+				sv, err := convertWithBestEffort(tv, child.Attr)
+				if err != nil {
+					return false, err
+				}
+				ag.Apply(sv)
+			}
+		}
+		val, err := ag.Value()
+		if err == ErrEmptyVal {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		addedNewChild = true
+
+		field := child.Params.Alias
+		if field == "" {
+			field = fmt.Sprintf("%s(%s)", child.SrcFunc.Name, x.ParseAttr(child.Attr))
+			if len(child.Params.Langs) > 0 {
+				field = fmt.Sprintf("%s(%s@%s)", child.SrcFunc.Name, x.ParseAttr(child.Attr),
+					strings.Join(child.Params.Langs, ":"))
+			}
+		}
+		fjChild := n.New(fieldName)
+		fjChild.AddValue(field, val)
+		n.AddListChild(fieldName, fjChild)
+	}
+	return addedNewChild, nil
+}
+
 func processNodeUids(fj *fastJsonNode, sg *SubGraph) error {
 	var seedNode *fastJsonNode
 	if sg.Params.IsEmpty {
@@ -503,6 +566,11 @@ func processNodeUids(fj *fastJsonNode, sg *SubGraph) error {
 		fj.addGroupby(sg, sg.GroupbyRes[0], sg.Params.Alias)
 		return nil
 	}
+	added, err := handleBlockAggregates(sg, fj, sg.DestUIDs.Uids)
+	if err != nil {
+		return err
+	}
+	hasChild = hasChild || added
 
 	lenList := len(sg.uidMatrix[0].Uids)
 	for i := 0; i < lenList; i++ {
@@ -552,6 +620,7 @@ type Extensions struct {
 	Latency *api.Latency    `json:"server_latency,omitempty"`
 	Txn     *api.TxnContext `json:"txn,omitempty"`
 	Metrics *api.Metrics    `json:"metrics,omitempty"`
+	Profile []*ProfileNode  `json:"profile,omitempty"`
 }
 
 func (sg *SubGraph) toFastJSON(l *Latency) ([]byte, error) {
@@ -588,7 +657,7 @@ func (sg *SubGraph) toFastJSON(l *Latency) ([]byte, error) {
 }
 
 func (sg *SubGraph) fieldName() string {
-	fieldName := sg.Attr
+	fieldName := x.ParseAttr(sg.Attr)
 	if sg.Params.Alias != "" {
 		fieldName = sg.Params.Alias
 	}
@@ -603,7 +672,7 @@ func addCount(pc *SubGraph, count uint64, dst outputNode) {
 	c.Value = int64(count)
 	fieldName := pc.Params.Alias
 	if fieldName == "" {
-		fieldName = fmt.Sprintf("count(%s)", pc.Attr)
+		fieldName = fmt.Sprintf("count(%s)", x.ParseAttr(pc.Attr))
 	}
 	dst.AddValue(fieldName, c)
 }
@@ -612,6 +681,9 @@ func aggWithVarFieldName(pc *SubGraph) string {
 	if pc.Params.Alias != "" {
 		return pc.Params.Alias
 	}
+	if pc.isGraphAlgo() {
+		return fmt.Sprintf("%s(%s)", pc.SrcFunc.Name, x.ParseAttr(pc.Attr))
+	}
 	fieldName := fmt.Sprintf("val(%v)", pc.Params.Var)
 	if len(pc.Params.NeedsVar) > 0 {
 		fieldName = fmt.Sprintf("val(%v)", pc.Params.NeedsVar[0].Name)
@@ -642,11 +714,43 @@ func addCheckPwd(pc *SubGraph, vals []*pb.TaskValue, dst outputNode) {
 
 	fieldName := pc.Params.Alias
 	if fieldName == "" {
-		fieldName = fmt.Sprintf("checkpwd(%s)", pc.Attr)
+		fieldName = fmt.Sprintf("checkpwd(%s)", x.ParseAttr(pc.Attr))
 	}
 	dst.AddValue(fieldName, c)
 }
 
+// addHistory adds the changes of the predicate asked by the history function, as a list of
+// objects with the value (or the uid) set or deleted, the operation and the commit timestamp.
+func addHistory(pc *SubGraph, entries []*pb.HistoryEntry, dst outputNode) {
+	fieldName := pc.Params.Alias
+	if fieldName == "" {
+		fieldName = fmt.Sprintf("history(%s)", x.ParseAttr(pc.Attr))
+	}
+	for _, e := range entries {
+		uc := dst.New(fieldName)
+		switch {
+		case e.Uid != 0:
+			uc.SetUID(e.Uid, "uid")
+		case e.Value != nil:
+			sv, err := convertWithBestEffort(&pb.TaskValue{Val: e.Value, ValType: e.ValType},
+				pc.Attr)
+			if err == nil {
+				uc.AddValue("value", sv)
+			}
+		}
+		if len(e.LangTag) > 0 {
+			uc.AddValue("lang", types.Val{Tid: types.StringID, Value: string(e.LangTag)})
+		}
+		op := "set"
+		if e.Op == posting.Del {
+			op = "del"
+		}
+		uc.AddValue("op", types.Val{Tid: types.StringID, Value: op})
+		uc.AddValue("commit_ts", types.Val{Tid: types.IntID, Value: int64(e.CommitTs)})
+		dst.AddListChild(fieldName, uc)
+	}
+}
+
 func alreadySeen(parentIds []uint64, uid uint64) bool {
 	for _, id := range parentIds {
 		if id == uid {
@@ -680,6 +784,10 @@ func (sg *SubGraph) preTraverse(uid uint64, dst outputNode) error {
 		if pc.Params.IgnoreResult {
 			continue
 		}
+		if pc.isBlockAggregate() {
+			// Added once for all the nodes of the block by handleBlockAggregates.
+			continue
+		}
 		if pc.IsInternal() {
 			if pc.Params.Expand != "" {
 				continue
@@ -722,6 +830,11 @@ func (sg *SubGraph) preTraverse(uid uint64, dst outputNode) error {
 		} else if pc.SrcFunc != nil && pc.SrcFunc.Name == "checkpwd" {
 			addCheckPwd(pc, pc.valueMatrix[idx].Values, dst)
 
+		} else if pc.SrcFunc != nil && pc.SrcFunc.Name == "history" {
+			if idx < len(pc.historyMatrix) {
+				addHistory(pc, pc.historyMatrix[idx].Entries, dst)
+			}
+
 		} else if idx < len(pc.uidMatrix) && len(pc.uidMatrix[idx].Uids) > 0 {
 			var fcsList []*pb.Facets
 			if pc.Params.Facet != nil {
@@ -822,6 +935,9 @@ func (sg *SubGraph) preTraverse(uid uint64, dst outputNode) error {
 
 			// add value for count(uid) nodes if any.
 			_ = handleCountUIDNodes(pc, dst, len(ul.Uids))
+			if _, err := handleBlockAggregates(pc, dst, ul.Uids); err != nil {
+				return err
+			}
 		} else {
 			if pc.Params.Alias == "" && len(pc.Params.Langs) > 0 {
 				fieldName += "@"
@@ -0,0 +1,136 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// checkPartialIndexes returns an error if a function of the SubGraph tree looks up a partial
+// index while the query block doesn't match its condition. The nodes not matching the condition
+// aren't indexed, so the lookup would silently miss them. The condition is matched if it's the
+// function of the query block, or a filter AND-ed with the others.
+func (sg *SubGraph) checkPartialIndexes() error {
+	var matched []*SubGraph
+	if sg.SrcFunc != nil && sg.Attr != "" {
+		matched = append(matched, sg)
+	}
+	if len(sg.Filters) > 0 {
+		matched = andedFunctions(sg.Filters[0], matched)
+	}
+
+	var check func(fn *SubGraph) error
+	check = func(fn *SubGraph) error {
+		for _, filter := range fn.Filters {
+			if err := check(filter); err != nil {
+				return err
+			}
+		}
+		if fn.SrcFunc == nil || !usesValueIndex(fn.SrcFunc) {
+			return nil
+		}
+		where := schema.State().IndexCondition(fn.Attr)
+		if where == nil {
+			return nil
+		}
+		for _, m := range matched {
+			if matchesIndexCondition(m, where) {
+				return nil
+			}
+		}
+		return errors.Errorf("Predicate %s has a partial index @where(%s). Add the same "+
+			"condition as a filter to use %s on it", x.ParseAttr(fn.Attr),
+			schema.IndexConditionString(where), fn.SrcFunc.Name)
+	}
+	if err := check(sg); err != nil {
+		return err
+	}
+
+	for _, child := range sg.Children {
+		if err := child.checkPartialIndexes(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// andedFunctions appends the functions of the filter tree which all the results must match.
+func andedFunctions(filter *SubGraph, out []*SubGraph) []*SubGraph {
+	switch filter.FilterOp {
+	case "":
+		if filter.SrcFunc != nil {
+			out = append(out, filter)
+		}
+	case "and":
+		for _, f := range filter.Filters {
+			out = andedFunctions(f, out)
+		}
+	}
+	return out
+}
+
+// usesValueIndex returns whether the function looks up the index of the values of its predicate.
+func usesValueIndex(fn *Function) bool {
+	if fn.IsCount || strings.HasPrefix(fn.Name, "facet_") {
+		return false
+	}
+	switch fn.Name {
+	case "regexp", "anyof", "allof":
+		return true
+	}
+	usesIndex, _ := worker.FuncUsesIndex(fn.Name, false)
+	return usesIndex
+}
+
+// matchesIndexCondition returns whether the function of the SubGraph is the condition of a
+// partial index.
+func matchesIndexCondition(sg *SubGraph, where *pb.IndexCondition) bool {
+	fn := sg.SrcFunc
+	if sg.Attr != where.Predicate || fn.Name != where.FuncName || fn.IsCount ||
+		fn.IsValueVar || fn.IsLenVar || len(sg.Params.Langs) > 0 {
+		return false
+	}
+	if where.FuncName != "eq" {
+		return true
+	}
+	if len(fn.Args) != 1 || fn.Args[0].IsValueVar {
+		return false
+	}
+	if fn.Args[0].Value == where.Value {
+		return true
+	}
+	// The values can be written differently, like 1 and 1.0 for a float.
+	typ, err := schema.State().TypeOf(where.Predicate)
+	if err != nil {
+		return false
+	}
+	want, err := types.Convert(types.Val{Tid: types.StringID, Value: []byte(where.Value)}, typ)
+	if err != nil {
+		return false
+	}
+	got, err := types.Convert(types.Val{Tid: types.StringID, Value: []byte(fn.Args[0].Value)},
+		typ)
+	return err == nil && types.CompareVals("eq", got, want)
+}
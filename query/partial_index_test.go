@@ -0,0 +1,107 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/schema"
+)
+
+func TestCheckPartialIndexes(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		email: string @index(exact) @where(eq(active, true)) .
+		phone: string @index(hash) @where(has(active)) .
+		active: bool @index(bool) .
+		name: string @index(exact) .
+	`), 1))
+
+	for _, test := range []struct {
+		name string
+		sg   *SubGraph
+		ok   bool
+	}{
+		{"no partial index", fnSubGraph("eq", "name", "a"), true},
+		{"no condition", fnSubGraph("eq", "email", "a@b.c"), false},
+		{"inequality without condition", fnSubGraph("ge", "email", "a"), false},
+		{"has doesn't use the index", fnSubGraph("has", "email"), true},
+		{
+			"condition as filter",
+			withFilter(fnSubGraph("eq", "email", "a@b.c"), fnSubGraph("eq", "active", "true")),
+			true,
+		},
+		{
+			"condition written differently",
+			withFilter(fnSubGraph("eq", "email", "a@b.c"), fnSubGraph("eq", "active", "1")),
+			true,
+		},
+		{
+			"other value",
+			withFilter(fnSubGraph("eq", "email", "a@b.c"), fnSubGraph("eq", "active", "false")),
+			false,
+		},
+		{
+			"condition AND-ed",
+			withFilter(fnSubGraph("anyofterms", "name", "a"), &SubGraph{FilterOp: "and",
+				Filters: []*SubGraph{
+					fnSubGraph("eq", "active", "true"),
+					fnSubGraph("eq", "email", "a@b.c"),
+				}}),
+			true,
+		},
+		{
+			"condition OR-ed",
+			withFilter(fnSubGraph("eq", "email", "a@b.c"), &SubGraph{FilterOp: "or",
+				Filters: []*SubGraph{
+					fnSubGraph("eq", "active", "true"),
+					fnSubGraph("eq", "name", "a"),
+				}}),
+			false,
+		},
+		{
+			"condition at root",
+			withFilter(fnSubGraph("eq", "active", "true"), fnSubGraph("eq", "email", "a@b.c")),
+			true,
+		},
+		{
+			"has condition",
+			withFilter(fnSubGraph("eq", "phone", "123"), fnSubGraph("has", "active")),
+			true,
+		},
+		{
+			"filter of a child",
+			&SubGraph{Attr: "name", SrcFunc: &Function{Name: "has"}, Children: []*SubGraph{
+				withFilter(&SubGraph{Attr: "friend"}, fnSubGraph("eq", "email", "a@b.c")),
+			}},
+			false,
+		},
+	} {
+		err := test.sg.checkPartialIndexes()
+		if test.ok {
+			require.NoError(t, err, test.name)
+		} else {
+			require.Error(t, err, test.name)
+		}
+	}
+}
+
+func withFilter(sg, filter *SubGraph) *SubGraph {
+	sg.Filters = append(sg.Filters, filter)
+	return sg
+}
@@ -0,0 +1,323 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const pathFn = "path"
+
+type pathEdge struct {
+	pred string
+	to   int
+}
+
+type pathState struct {
+	// eps are the states reached without following any edge.
+	eps   []int
+	edges []pathEdge
+}
+
+// pathAutomaton is the nondeterministic finite automaton matching the sequences of predicates
+// described by a property path.
+type pathAutomaton struct {
+	states   []pathState
+	start    int
+	accept   int
+	closures map[int][]int
+}
+
+// compilePath builds the automaton of the property path, using Thompson's construction.
+func compilePath(p *gql.PropertyPath) *pathAutomaton {
+	a := &pathAutomaton{closures: make(map[int][]int)}
+	a.start, a.accept = a.compile(p)
+	return a
+}
+
+func (a *pathAutomaton) newState() int {
+	a.states = append(a.states, pathState{})
+	return len(a.states) - 1
+}
+
+func (a *pathAutomaton) addEps(from, to int) {
+	a.states[from].eps = append(a.states[from].eps, to)
+}
+
+// compile adds the states matching the property path to the automaton, and returns the states
+// at which a match starts and ends.
+func (a *pathAutomaton) compile(p *gql.PropertyPath) (int, int) {
+	switch p.Op {
+	case gql.PathSeq:
+		start, end := a.compile(p.Children[0])
+		for _, child := range p.Children[1:] {
+			cstart, cend := a.compile(child)
+			a.addEps(end, cstart)
+			end = cend
+		}
+		return start, end
+	case gql.PathAlt:
+		start, end := a.newState(), a.newState()
+		for _, child := range p.Children {
+			cstart, cend := a.compile(child)
+			a.addEps(start, cstart)
+			a.addEps(cend, end)
+		}
+		return start, end
+	case gql.PathStar, gql.PathPlus, gql.PathOpt:
+		start, end := a.newState(), a.newState()
+		cstart, cend := a.compile(p.Children[0])
+		a.addEps(start, cstart)
+		a.addEps(cend, end)
+		if p.Op != gql.PathPlus {
+			// The child can be skipped.
+			a.addEps(start, end)
+		}
+		if p.Op != gql.PathOpt {
+			// The child can be repeated.
+			a.addEps(cend, cstart)
+		}
+		return start, end
+	default:
+		start, end := a.newState(), a.newState()
+		a.states[start].edges = append(a.states[start].edges, pathEdge{pred: p.Pred, to: end})
+		return start, end
+	}
+}
+
+// closure returns the states reached from the state without following any edge, including
+// the state itself.
+func (a *pathAutomaton) closure(state int) []int {
+	if c, ok := a.closures[state]; ok {
+		return c
+	}
+	seen := map[int]bool{state: true}
+	c := []int{state}
+	for i := 0; i < len(c); i++ {
+		for _, next := range a.states[c[i]].eps {
+			if !seen[next] {
+				seen[next] = true
+				c = append(c, next)
+			}
+		}
+	}
+	a.closures[state] = c
+	return c
+}
+
+// pathKey is a node of the graph reached in a state of the automaton.
+type pathKey struct {
+	uid   uint64
+	state int
+}
+
+// pathStep records how a node was reached in a state, to rebuild the matched paths.
+type pathStep struct {
+	prev  pathKey
+	attr  string
+	first bool
+}
+
+// edgeFetcher returns the lists of uids pointed to by the predicate from the given sorted uids.
+type edgeFetcher func(ctx context.Context, pred string, uids []uint64) ([]*pb.List, error)
+
+// run follows the edges from the source uid breadth-first, with one step of the automaton per
+// level, and returns how every node was reached in every state.
+func (a *pathAutomaton) run(ctx context.Context, from uint64,
+	fetch edgeFetcher) (map[pathKey]pathStep, error) {
+
+	steps := make(map[pathKey]pathStep)
+	var frontier []pathKey
+	for _, state := range a.closure(a.start) {
+		key := pathKey{uid: from, state: state}
+		steps[key] = pathStep{first: true}
+		frontier = append(frontier, key)
+	}
+
+	var numEdges uint64
+	for len(frontier) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// Group the nodes of the frontier by the predicates they have to follow.
+		byPred := make(map[string]map[uint64][]pathKey)
+		for _, key := range frontier {
+			for _, edge := range a.states[key.state].edges {
+				if byPred[edge.pred] == nil {
+					byPred[edge.pred] = make(map[uint64][]pathKey)
+				}
+				byPred[edge.pred][key.uid] = append(byPred[edge.pred][key.uid], key)
+			}
+		}
+		preds := make([]string, 0, len(byPred))
+		for pred := range byPred {
+			preds = append(preds, pred)
+		}
+		sort.Strings(preds)
+
+		var next []pathKey
+		for _, pred := range preds {
+			keys := byPred[pred]
+			uids := make([]uint64, 0, len(keys))
+			for uid := range keys {
+				uids = append(uids, uid)
+			}
+			sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+			lists, err := fetch(ctx, pred, uids)
+			if err != nil {
+				return nil, err
+			}
+
+			for i, uid := range uids {
+				if i >= len(lists) {
+					break
+				}
+				numEdges += uint64(len(lists[i].Uids))
+				for _, key := range keys[uid] {
+					for _, edge := range a.states[key.state].edges {
+						if edge.pred != pred {
+							continue
+						}
+						for _, to := range lists[i].Uids {
+							for _, state := range a.closure(edge.to) {
+								nextKey := pathKey{uid: to, state: state}
+								if _, ok := steps[nextKey]; ok {
+									continue
+								}
+								steps[nextKey] = pathStep{prev: key, attr: pred}
+								next = append(next, nextKey)
+							}
+						}
+					}
+				}
+			}
+		}
+		if numEdges > x.Config.QueryEdgeLimit {
+			// If we've seen too many edges, stop the query.
+			return nil, errors.Errorf("Exceeded query edge limit = %v. Found %v edges.",
+				x.Config.QueryEdgeLimit, numEdges)
+		}
+		frontier = next
+	}
+	return steps, nil
+}
+
+// matchedPath returns the path from the source node to the uid found by run, or nil if the uid
+// wasn't reached in the accepting state. It's one of the paths with the fewest edges.
+func (a *pathAutomaton) matchedPath(steps map[pathKey]pathStep, uid uint64) []pathInfo {
+	key := pathKey{uid: uid, state: a.accept}
+	step, ok := steps[key]
+	if !ok {
+		return nil
+	}
+	var path []pathInfo
+	for {
+		if step.first {
+			path = append(path, pathInfo{uid: key.uid})
+			break
+		}
+		path = append(path, pathInfo{uid: key.uid, attr: step.attr})
+		key = step.prev
+		step = steps[key]
+	}
+	// The path was built from the destination backwards.
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// evalPropertyPath runs the path function at the root of the query, e.g.
+// path(0x1, "follows+/worksAt?", 0x5). The results are the nodes reachable from the source
+// node through a sequence of edges matching the property path. If a destination is given, the
+// results only hold it when it's reachable, and the matched path is returned along with them.
+func (sg *SubGraph) evalPropertyPath(ctx context.Context) error {
+	args := sg.SrcFunc.Args
+	if len(args) != 2 && len(args) != 3 {
+		return errors.Errorf("Function path expects a source uid, a property path and "+
+			"optionally a destination uid. Got: %d arguments", len(args))
+	}
+	from, err := strconv.ParseUint(args[0].Value, 0, 64)
+	if err != nil {
+		return errors.Wrapf(err, "while parsing the source uid of path")
+	}
+	var to uint64
+	if len(args) == 3 {
+		if to, err = strconv.ParseUint(args[2].Value, 0, 64); err != nil {
+			return errors.Wrapf(err, "while parsing the destination uid of path")
+		}
+	}
+	expr, err := gql.ParsePropertyPath(args[1].Value)
+	if err != nil {
+		return err
+	}
+
+	a := compilePath(expr)
+	steps, err := a.run(ctx, from, sg.fetchEdges)
+	if err != nil {
+		return err
+	}
+
+	sg.DestUIDs = &pb.List{}
+	if to == 0 {
+		for key := range steps {
+			if key.state == a.accept {
+				sg.DestUIDs.Uids = append(sg.DestUIDs.Uids, key.uid)
+			}
+		}
+		sort.Slice(sg.DestUIDs.Uids, func(i, j int) bool {
+			return sg.DestUIDs.Uids[i] < sg.DestUIDs.Uids[j]
+		})
+	} else if path := a.matchedPath(steps, to); path != nil {
+		sg.DestUIDs.Uids = []uint64{to}
+		sg.matchedPaths = createkroutesubgraph(ctx, []route{{
+			route:       &path,
+			totalWeight: float64(len(path) - 1),
+		}})
+	}
+	sg.uidMatrix = []*pb.List{sg.DestUIDs}
+	return nil
+}
+
+// fetchEdges returns the lists of uids pointed to by the predicate from the given uids.
+func (sg *SubGraph) fetchEdges(ctx context.Context, pred string,
+	uids []uint64) ([]*pb.List, error) {
+
+	edge := &SubGraph{
+		Attr:    x.NamespaceAttr(x.ExtractNamespace(ctx), pred),
+		ReadTs:  sg.ReadTs,
+		Cache:   sg.Cache,
+		SrcUIDs: &pb.List{Uids: uids},
+	}
+	rch := make(chan error, 1)
+	ProcessGraph(ctx, edge, &SubGraph{}, rch)
+	if err := <-rch; err != nil {
+		return nil, err
+	}
+	if edge.UnknownAttr {
+		return nil, nil
+	}
+	return edge.uidMatrix, nil
+}
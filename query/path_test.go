@@ -0,0 +1,99 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// testGraph maps every predicate to the edges of its nodes.
+var testGraph = map[string]map[uint64][]uint64{
+	"follows":   {1: {2}, 2: {3}, 3: {1, 4}},
+	"worksAt":   {2: {10}, 4: {11}},
+	"locatedIn": {10: {20}, 20: {30}, 11: {21}},
+	"~worksAt":  {10: {2}, 11: {4}},
+}
+
+func fetchTestEdges(ctx context.Context, pred string, uids []uint64) ([]*pb.List, error) {
+	var lists []*pb.List
+	for _, uid := range uids {
+		lists = append(lists, &pb.List{Uids: testGraph[pred][uid]})
+	}
+	return lists, nil
+}
+
+func runTestPath(t *testing.T, expr string, from uint64) (*pathAutomaton, map[pathKey]pathStep) {
+	path, err := gql.ParsePropertyPath(expr)
+	require.NoError(t, err)
+	a := compilePath(path)
+	x.Config.QueryEdgeLimit = 1e6
+	steps, err := a.run(context.Background(), from, fetchTestEdges)
+	require.NoError(t, err)
+	return a, steps
+}
+
+func reachedUids(a *pathAutomaton, steps map[pathKey]pathStep) []uint64 {
+	var uids []uint64
+	for key := range steps {
+		if key.state == a.accept {
+			uids = append(uids, key.uid)
+		}
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	return uids
+}
+
+func TestPropertyPathReachable(t *testing.T) {
+	tests := []struct {
+		expr string
+		uids []uint64
+	}{
+		{expr: "follows", uids: []uint64{2}},
+		{expr: "follows/follows", uids: []uint64{3}},
+		{expr: "follows+", uids: []uint64{1, 2, 3, 4}},
+		{expr: "follows*/worksAt", uids: []uint64{10, 11}},
+		{expr: "follows?/worksAt", uids: []uint64{10}},
+		{expr: "follows+/worksAt?/(locatedIn)*", uids: []uint64{1, 2, 3, 4, 10, 11, 20, 21, 30}},
+		{expr: "worksAt|follows/follows", uids: []uint64{3}},
+		{expr: "follows/worksAt/^worksAt", uids: []uint64{2}},
+		{expr: "locatedIn", uids: nil},
+	}
+	for _, tc := range tests {
+		a, steps := runTestPath(t, tc.expr, 1)
+		require.Equal(t, tc.uids, reachedUids(a, steps), tc.expr)
+	}
+}
+
+func TestPropertyPathMatchedPath(t *testing.T) {
+	a, steps := runTestPath(t, "follows+/worksAt?/(locatedIn)*", 1)
+	require.Equal(t, []pathInfo{
+		{uid: 1},
+		{uid: 2, attr: "follows"},
+		{uid: 10, attr: "worksAt"},
+		{uid: 20, attr: "locatedIn"},
+		{uid: 30, attr: "locatedIn"},
+	}, a.matchedPath(steps, 30))
+	require.Nil(t, a.matchedPath(steps, 5))
+}
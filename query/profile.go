@@ -0,0 +1,87 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// ProfileNode holds the time spent executing a query block, an edge or a filter, and the group
+// which served it.
+type ProfileNode struct {
+	Name     string `json:"name,omitempty"`
+	Attr     string `json:"attr,omitempty"`
+	GroupId  uint32 `json:"group_id,omitempty"`
+	TaskNs   uint64 `json:"task_ns,omitempty"`
+	SortNs   uint64 `json:"sort_ns,omitempty"`
+	FilterNs uint64 `json:"filter_ns,omitempty"`
+	FacetsNs uint64 `json:"facets_ns,omitempty"`
+
+	Filters  []*ProfileNode `json:"filters,omitempty"`
+	Children []*ProfileNode `json:"children,omitempty"`
+}
+
+// Profile collects the execution profile of the queries processed with a context holding it
+// under ProfileKey.
+type Profile struct {
+	Nodes []*ProfileNode
+}
+
+// ProfileRequested returns true if a gRPC client asked for the execution profile of its query
+// through the profile metadata.
+func ProfileRequested(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md["profile"]) == 0 {
+		return false
+	}
+	// We ignore the error here, in which case the profile isn't collected.
+	profile, _ := strconv.ParseBool(md["profile"][0])
+	return profile
+}
+
+func (p *Profile) add(sgs []*SubGraph) {
+	for _, sg := range sgs {
+		p.Nodes = append(p.Nodes, profileSubGraph(sg))
+	}
+}
+
+func profileSubGraph(sg *SubGraph) *ProfileNode {
+	node := &ProfileNode{
+		Name:     sg.Params.Alias,
+		Attr:     x.ParseAttr(sg.Attr),
+		GroupId:  sg.groupId,
+		TaskNs:   uint64(sg.taskLatency.Nanoseconds()),
+		SortNs:   uint64(sg.sortLatency.Nanoseconds()),
+		FilterNs: uint64(sg.filterLatency.Nanoseconds()),
+		FacetsNs: uint64(sg.facetsLatency.Nanoseconds()),
+	}
+	for _, filter := range sg.Filters {
+		node.Filters = append(node.Filters, profileSubGraph(filter))
+	}
+	for _, child := range sg.Children {
+		if child.IsInternal() && child.Attr == "expand" {
+			continue
+		}
+		node.Children = append(node.Children, profileSubGraph(child))
+	}
+	return node
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestProfileRequested(t *testing.T) {
+	require.False(t, ProfileRequested(context.Background()))
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("profile", "true"))
+	require.True(t, ProfileRequested(ctx))
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("profile", "yes"))
+	require.False(t, ProfileRequested(ctx))
+}
+
+func TestProfileSubGraph(t *testing.T) {
+	root := &SubGraph{
+		Attr:          "name",
+		Params:        params{Alias: "me"},
+		groupId:       1,
+		taskLatency:   2 * time.Millisecond,
+		filterLatency: time.Millisecond,
+		Filters:       []*SubGraph{{Attr: "age", groupId: 2, taskLatency: time.Millisecond}},
+		Children: []*SubGraph{
+			{Attr: "friend", groupId: 2, sortLatency: time.Microsecond,
+				facetsLatency: time.Microsecond},
+			{Attr: "expand", Params: params{IsInternal: true}},
+		},
+	}
+
+	p := &Profile{}
+	p.add([]*SubGraph{root})
+	require.Equal(t, []*ProfileNode{{
+		Name:     "me",
+		Attr:     "name",
+		GroupId:  1,
+		TaskNs:   uint64(2 * time.Millisecond),
+		FilterNs: uint64(time.Millisecond),
+		Filters:  []*ProfileNode{{Attr: "age", GroupId: 2, TaskNs: uint64(time.Millisecond)}},
+		Children: []*ProfileNode{{Attr: "friend", GroupId: 2,
+			SortNs: uint64(time.Microsecond), FacetsNs: uint64(time.Microsecond)}},
+	}}, p.Nodes)
+}
@@ -176,6 +176,16 @@ type params struct {
 	MaxWeight float64
 	// MinWeight is the min weight allowed in a path returned by the shortest path algorithm.
 	MinWeight float64
+	// AllPaths is true if the shortest path query returns every path of minimal weight.
+	AllPaths bool
+	// Heuristic is the geo predicate holding the location of the nodes, used by the shortest
+	// path query to run A* towards the location of the destination node.
+	Heuristic string
+	// Weight is the numeric predicate or value variable holding the weight of the nodes, used by
+	// the shortest path query as the weight of the edges leading to them instead of facets.
+	Weight string
+	// WeightVar is true if Weight is a value variable.
+	WeightVar bool
 
 	// ExploreDepth is used by recurse and shortest path queries to specify the maximum graph
 	// depth to explore.
@@ -244,9 +254,12 @@ type SubGraph struct {
 	// facetsMatrix contains the facet values. There would a list corresponding to each uid in
 	// uidMatrix.
 	facetsMatrix []*pb.FacetsList
-	ExpandPreds  []*pb.ValueList
-	GroupbyRes   []*groupResults // one result for each uid list.
-	LangTags     []*pb.LangList
+	// historyMatrix contains the changes of the predicate asked by the history function. There
+	// would be a list corresponding to each uid in SrcUIDs.
+	historyMatrix []*pb.HistoryList
+	ExpandPreds   []*pb.ValueList
+	GroupbyRes    []*groupResults // one result for each uid list.
+	LangTags      []*pb.LangList
 
 	// SrcUIDs is a list of unique source UIDs. They are always copies of destUIDs
 	// of parent nodes in GraphQL structure.
@@ -265,6 +278,22 @@ type SubGraph struct {
 	DestUIDs *pb.List
 	List     bool // whether predicate is of list type
 
+	// taskLatency is the time taken by the workers to process the task of this SubGraph.
+	taskLatency time.Duration
+	// sortLatency, filterLatency and facetsLatency are the time spent sorting the results of
+	// this SubGraph, running its filters and processing its facets.
+	sortLatency   time.Duration
+	filterLatency time.Duration
+	facetsLatency time.Duration
+	// groupId is the group which served the task of this SubGraph.
+	groupId uint32
+	// estimatedUids is the number of uids the SubGraph is expected to match, estimated from the
+	// statistics of the predicates before running the query.
+	estimatedUids *uint64
+	// matchedPaths holds the path matched by the path function at the root, when it's given a
+	// destination.
+	matchedPaths []*SubGraph
+
 	pathMeta *pathMetadata
 }
 
@@ -304,6 +333,10 @@ func (sg *SubGraph) createSrcFunction(gf *gql.Function) {
 	// type function is just an alias for eq(type, "dgraph.type").
 	if gf.Name == "type" {
 		sg.Attr = "dgraph.type"
+		if gf.Attr != "" {
+			// The dgraph.type predicate of the namespace of the query.
+			sg.Attr = gf.Attr
+		}
 		sg.SrcFunc.Name = "eq"
 		sg.SrcFunc.IsCount = false
 		sg.SrcFunc.IsValueVar = false
@@ -424,6 +457,9 @@ func filterCopy(sg *SubGraph, ft *gql.FilterTree) error {
 		if !isValidFuncName(ft.Func.Name) {
 			return errors.Errorf("Invalid function name: %s", ft.Func.Name)
 		}
+		if ft.Func.Name == pathFn {
+			return errors.Errorf("Function path is only allowed at root")
+		}
 
 		if isUidFnWithoutVar(ft.Func) {
 			sg.SrcFunc = &Function{Name: ft.Func.Name}
@@ -562,7 +598,8 @@ func treeCopy(gq *gql.GraphQuery, sg *SubGraph) error {
 		}
 
 		if gchild.Func != nil &&
-			(gchild.Func.IsAggregator() || gchild.Func.IsPasswordVerifier()) {
+			(gchild.Func.IsAggregator() || gchild.Func.IsPasswordVerifier() ||
+				gchild.Func.IsGraphAlgorithm() || gchild.Func.IsHistory()) {
 			if len(gchild.Children) != 0 {
 				return errors.Errorf("Node with %q cant have child attr", gchild.Func.Name)
 			}
@@ -658,6 +695,25 @@ func (args *params) fill(gq *gql.GraphQuery) error {
 			args.MinWeight = -math.MaxFloat64
 		}
 
+		if v, ok := gq.Args["allpaths"]; ok {
+			allPaths, err := strconv.ParseBool(v)
+			if err != nil {
+				return err
+			}
+			args.AllPaths = allPaths
+		}
+
+		args.Heuristic = gq.Args["heuristic"]
+
+		if v, ok := gq.Args["weight"]; ok {
+			args.Weight = v
+			for _, nv := range gq.NeedsVar {
+				if nv.Name == v && nv.Typ == gql.ValueVar {
+					args.WeightVar = true
+				}
+			}
+		}
+
 		if gq.ShortestPathArgs.From == nil || gq.ShortestPathArgs.To == nil {
 			return errors.Errorf("from/to can't be nil for shortest path")
 		}
@@ -698,6 +754,11 @@ type ContextKey int
 const (
 	// DebugKey is the key used to toggle debug mode.
 	DebugKey ContextKey = iota
+	// ExplainKey is the key used to ask for the plan of the query.
+	ExplainKey
+	// ProfileKey is the key holding the *Profile which collects the execution profile of
+	// the query.
+	ProfileKey
 )
 
 func isDebug(ctx context.Context) bool {
@@ -950,8 +1011,9 @@ func evalLevelAgg(
 			return mp, nil
 		}
 
-		ag := aggregator{
-			name: sg.SrcFunc.Name,
+		ag, err := newAggregator(sg.SrcFunc)
+		if err != nil {
+			return nil, err
 		}
 		for _, val := range vals {
 			ag.Apply(val)
@@ -992,8 +1054,9 @@ func evalLevelAgg(
 	mp = make(map[uint64]types.Val)
 	// Go over the sibling node and aggregate.
 	for i, list := range relSG.uidMatrix {
-		ag := aggregator{
-			name: sg.SrcFunc.Name,
+		ag, err := newAggregator(sg.SrcFunc)
+		if err != nil {
+			return nil, err
 		}
 		for _, uid := range list.Uids {
 			if val, ok := vals[uid]; ok {
@@ -1128,6 +1191,14 @@ func (sg *SubGraph) valueVarAggregation(doneVars map[string]varValue, path []*Su
 			doneVars[sg.Params.Var] = it
 		}
 		sg.Params.UidToVal = mp
+	case sg.isGraphAlgo():
+		// The values were computed while processing the graph.
+		if sg.Params.Var != "" {
+			it := doneVars[sg.Params.Var]
+			it.Vals = sg.Params.UidToVal
+			it.path = path
+			doneVars[sg.Params.Var] = it
+		}
 	case sg.MathExp != nil:
 		// Preprocess to bring all variables to the same level.
 		err := sg.transformVars(doneVars, path)
@@ -1227,6 +1298,10 @@ func (sg *SubGraph) updateFacetMatrix() {
 	if len(sg.facetsMatrix) != len(sg.uidMatrix) {
 		return
 	}
+	start := time.Now()
+	defer func() {
+		sg.facetsLatency += time.Since(start)
+	}()
 
 	for lidx, l := range sg.uidMatrix {
 		out := sg.facetsMatrix[lidx].FacetsList[:0]
@@ -1307,6 +1382,10 @@ func (sg *SubGraph) populateVarMap(doneVars map[string]varValue, sgPath []*SubGr
 			if child.Attr == "uid" {
 				continue
 			}
+			// The aggregates of the block don't belong to any of its nodes.
+			if child.isBlockAggregate() {
+				continue
+			}
 
 			// If the length of child UID list is zero and it has no valid value, then the
 			// current UID should be removed from this level.
@@ -1348,7 +1427,10 @@ func (sg *SubGraph) updateVars(doneVars map[string]varValue, sgPath []*SubGraph)
 	if err := sg.populateUidValVar(doneVars, sgPathCopy); err != nil {
 		return err
 	}
-	return sg.populateFacetVars(doneVars, sgPathCopy)
+	start := time.Now()
+	err := sg.populateFacetVars(doneVars, sgPathCopy)
+	sg.facetsLatency += time.Since(start)
+	return err
 }
 
 // populateUidValVar populates the value of the variable into doneVars.
@@ -1824,6 +1906,7 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 		if err != nil {
 			return out, err
 		}
+		ns := x.ExtractNamespace(ctx)
 
 		switch child.Params.Expand {
 		// It could be expand(_all_) or expand(val(x)).
@@ -1833,15 +1916,17 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 				break
 			}
 
-			preds = getPredicatesFromTypes(typeNames)
+			preds = getPredicatesFromTypes(ns, typeNames)
 		default:
 			if len(child.ExpandPreds) > 0 {
 				span.Annotate(nil, "expand default")
 				// We already have the predicates populated from the var.
-				preds = getPredsFromVals(child.ExpandPreds)
+				for _, pred := range getPredsFromVals(child.ExpandPreds) {
+					preds = append(preds, x.NamespaceAttr(ns, pred))
+				}
 			} else {
 				typeNames := strings.Split(child.Params.Expand, ",")
-				preds = getPredicatesFromTypes(typeNames)
+				preds = getPredicatesFromTypes(ns, typeNames)
 			}
 		}
 		preds = uniquePreds(preds)
@@ -1905,8 +1990,18 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		rch <- nil
 		return
 	}
+	if sg.isGraphAlgo() {
+		rch <- sg.runGraphAlgo(ctx)
+		return
+	}
+
 	var err error
 	switch {
+	case parent == nil && sg.SrcFunc != nil && sg.SrcFunc.Name == pathFn:
+		if err = sg.evalPropertyPath(ctx); err != nil {
+			rch <- err
+			return
+		}
 	case parent == nil && sg.SrcFunc != nil && sg.SrcFunc.Name == "uid":
 		// I'm root and I'm using some variable that has been populated.
 		// Retain the actual order in uidMatrix. But sort the destUids.
@@ -1982,7 +2077,9 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 				rch <- err
 				return
 			}
+			taskStart := time.Now()
 			result, err := worker.ProcessTaskOverNetwork(ctx, taskQuery)
+			sg.taskLatency = time.Since(taskStart)
 			if err != nil && strings.Contains(err.Error(), worker.ErrNonExistentTabletMessage) {
 				sg.UnknownAttr = true
 			} else if err != nil {
@@ -1993,9 +2090,11 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 			sg.uidMatrix = result.UidMatrix
 			sg.valueMatrix = result.ValueMatrix
 			sg.facetsMatrix = result.FacetMatrix
+			sg.historyMatrix = result.HistoryMatrix
 			sg.counts = result.Counts
 			sg.LangTags = result.LangMatrix
 			sg.List = result.List
+			sg.groupId = result.GroupId
 
 			if sg.Params.DoCount {
 				if len(sg.Filters) == 0 {
@@ -2021,36 +2120,19 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 
 	// Run filters if any.
 	if len(sg.Filters) > 0 {
-		// Run all filters in parallel.
-		filterChan := make(chan error, len(sg.Filters))
-		for _, filter := range sg.Filters {
-			isUidFuncWithoutVar := filter.SrcFunc != nil && filter.SrcFunc.Name == "uid" &&
-				len(filter.Params.NeedsVar) == 0
-			// For uid function filter, no need for processing. User already gave us the
-			// list. Lets just update DestUIDs.
-			if isUidFuncWithoutVar {
-				filter.DestUIDs = filter.SrcUIDs
-				filterChan <- nil
-				continue
-			}
-
-			filter.SrcUIDs = sg.DestUIDs
-			// Passing the pointer is okay since the filter only reads.
-			filter.Params.ParentVars = sg.Params.ParentVars // Pass to the child.
-			go ProcessGraph(ctx, filter, sg, filterChan)
-		}
-
-		var filterErr error
-		for range sg.Filters {
-			if err = <-filterChan; err != nil {
-				// Store error in a variable and wait for all filters to run
-				// before returning. Else tracing causes crashes.
-				filterErr = err
+		filterStart := time.Now()
+		filters, srcUIDs := sg.Filters, sg.DestUIDs
+		if sg.runsFirstFilter() {
+			// The first filter is expected to be the most selective one. Run it on its own,
+			// so the others only have to check the uids it matched.
+			if err = sg.runFilters(ctx, filters[:1], srcUIDs); err != nil {
+				rch <- err
+				return
 			}
+			filters, srcUIDs = filters[1:], filters[0].DestUIDs
 		}
-
-		if filterErr != nil {
-			rch <- filterErr
+		if err = sg.runFilters(ctx, filters, srcUIDs); err != nil {
+			rch <- err
 			return
 		}
 
@@ -2078,6 +2160,7 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 			lists = append(lists, sg.DestUIDs)
 			sg.DestUIDs = algo.IntersectSorted(lists)
 		}
+		sg.filterLatency = time.Since(filterStart)
 	}
 
 	if len(sg.Params.Order) == 0 && len(sg.Params.FacetOrder) == 0 {
@@ -2090,7 +2173,10 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		// If we are asked for count, we don't need to change the order of results.
 		if !sg.Params.DoCount {
 			// We need to sort first before pagination.
-			if err = sg.applyOrderAndPagination(ctx); err != nil {
+			sortStart := time.Now()
+			err = sg.applyOrderAndPagination(ctx)
+			sg.sortLatency = time.Since(sortStart)
+			if err != nil {
 				rch <- err
 				return
 			}
@@ -2156,7 +2242,7 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		}
 
 		child.SrcUIDs = sg.DestUIDs // Make the connection.
-		if child.IsInternal() {
+		if child.IsInternal() && !child.isGraphAlgo() {
 			// We dont have to execute these nodes.
 			continue
 		}
@@ -2166,7 +2252,7 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 	var childErr error
 	// Now get all the results back.
 	for _, child := range sg.Children {
-		if child.IsInternal() {
+		if child.IsInternal() && !child.isGraphAlgo() {
 			continue
 		}
 		if err = <-childChan; err != nil {
@@ -2194,6 +2280,37 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 	rch <- childErr
 }
 
+// runFilters runs the given filters of the SubGraph in parallel, on the given uids.
+func (sg *SubGraph) runFilters(ctx context.Context, filters []*SubGraph, srcUIDs *pb.List) error {
+	filterChan := make(chan error, len(filters))
+	for _, filter := range filters {
+		isUidFuncWithoutVar := filter.SrcFunc != nil && filter.SrcFunc.Name == "uid" &&
+			len(filter.Params.NeedsVar) == 0
+		// For uid function filter, no need for processing. User already gave us the
+		// list. Lets just update DestUIDs.
+		if isUidFuncWithoutVar {
+			filter.DestUIDs = filter.SrcUIDs
+			filterChan <- nil
+			continue
+		}
+
+		filter.SrcUIDs = srcUIDs
+		// Passing the pointer is okay since the filter only reads.
+		filter.Params.ParentVars = sg.Params.ParentVars // Pass to the child.
+		go ProcessGraph(ctx, filter, sg, filterChan)
+	}
+
+	var filterErr error
+	for range filters {
+		if err := <-filterChan; err != nil {
+			// Store error in a variable and wait for all filters to run
+			// before returning. Else tracing causes crashes.
+			filterErr = err
+		}
+	}
+	return filterErr
+}
+
 // applyPagination applies count and offset to lists inside uidMatrix.
 func (sg *SubGraph) applyPagination(ctx context.Context) error {
 	if sg.Params.Count == 0 && sg.Params.Offset == 0 { // No pagination.
@@ -2406,7 +2523,7 @@ func (sg *SubGraph) sortAndPaginateUsingVar(ctx context.Context) error {
 func isValidArg(a string) bool {
 	switch a {
 	case "numpaths", "from", "to", "orderasc", "orderdesc", "first", "offset", "after", "depth",
-		"minweight", "maxweight":
+		"minweight", "maxweight", "allpaths", "heuristic", "weight":
 		return true
 	}
 	return false
@@ -2416,7 +2533,8 @@ func isValidArg(a string) bool {
 func isValidFuncName(f string) bool {
 	switch f {
 	case "anyofterms", "allofterms", "val", "regexp", "anyoftext", "alloftext",
-		"has", "uid", "uid_in", "anyof", "allof", "type", "match":
+		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to",
+		"facet_eq", "facet_le", "facet_lt", "facet_ge", "facet_gt", pathFn:
 		return true
 	}
 	return isInequalityFn(f) || types.IsGeoFunc(f)
@@ -2435,7 +2553,7 @@ func isAggregatorFn(f string) bool {
 	case "min", "max", "sum", "avg":
 		return true
 	}
-	return false
+	return isStatAggregator(f)
 }
 
 func isUidFnWithoutVar(f *gql.Function) bool {
@@ -2444,7 +2562,7 @@ func isUidFnWithoutVar(f *gql.Function) bool {
 
 func getNodeTypes(ctx context.Context, sg *SubGraph) ([]string, error) {
 	temp := &SubGraph{
-		Attr:    "dgraph.type",
+		Attr:    x.NamespaceAttr(x.ExtractNamespace(ctx), "dgraph.type"),
 		SrcUIDs: sg.DestUIDs,
 		ReadTs:  sg.ReadTs,
 	}
@@ -2459,12 +2577,13 @@ func getNodeTypes(ctx context.Context, sg *SubGraph) ([]string, error) {
 	return getPredsFromVals(result.ValueMatrix), nil
 }
 
-// getPredicatesFromTypes returns the list of preds contained in the given types.
-func getPredicatesFromTypes(typeNames []string) []string {
+// getPredicatesFromTypes returns the list of preds contained in the given types of the
+// namespace ns.
+func getPredicatesFromTypes(ns uint64, typeNames []string) []string {
 	var preds []string
 
 	for _, typeName := range typeNames {
-		typeDef, ok := schema.State().GetType(typeName)
+		typeDef, ok := schema.State().GetType(x.NamespaceAttr(ns, typeName))
 		if !ok {
 			continue
 		}
@@ -2554,6 +2673,9 @@ type Request struct {
 	Subgraphs []*SubGraph
 
 	Vars map[string]varValue
+
+	// planOnly is set when the query should only be planned, not executed.
+	planOnly bool
 }
 
 // ProcessQuery processes query part of the request (without mutations).
@@ -2568,9 +2690,15 @@ func (req *Request) ProcessQuery(ctx context.Context) (err error) {
 	req.Vars = make(map[string]varValue)
 	loopStart := time.Now()
 	queries := req.GqlQuery.Query
+	ns := x.ExtractNamespace(ctx)
+	indexes := builtCompositeIndexes(ns)
+	indexedPredicates := func(preds []string) (map[string]bool, error) {
+		return worker.IndexedPredicates(ctx, preds)
+	}
 	// first loop converts queries to SubGraph representation and populates ReadTs And Cache.
 	for i := 0; i < len(queries); i++ {
 		gq := queries[i]
+		namespaceQuery(ns, gq)
 
 		if gq == nil || (len(gq.UID) == 0 && gq.Func == nil && len(gq.NeedsVar) == 0 &&
 			gq.Alias != "shortest" && !gq.IsEmpty) {
@@ -2581,6 +2709,11 @@ func (req *Request) ProcessQuery(ctx context.Context) (err error) {
 		if err != nil {
 			return errors.Wrapf(err, "while converting to subgraph")
 		}
+		sg.useCompositeIndexes(indexes, indexedPredicates)
+		sg.optimize(worker.EstimateUids)
+		if err := sg.checkPartialIndexes(); err != nil {
+			return err
+		}
 		sg.recurse(func(sg *SubGraph) {
 			sg.ReadTs = req.ReadTs
 			sg.Cache = req.Cache
@@ -2589,6 +2722,9 @@ func (req *Request) ProcessQuery(ctx context.Context) (err error) {
 		req.Subgraphs = append(req.Subgraphs, sg)
 	}
 	req.Latency.Parsing += time.Since(loopStart)
+	if req.planOnly {
+		return nil
+	}
 
 	execStart := time.Now()
 	hasExecuted := make([]bool, len(req.Subgraphs))
@@ -2703,6 +2839,10 @@ func (req *Request) ProcessQuery(ctx context.Context) (err error) {
 	if len(shortestSg) != 0 {
 		req.Subgraphs = append(req.Subgraphs, shortestSg...)
 	}
+	// Same for the paths matched by the path function.
+	for _, sg := range req.Subgraphs {
+		req.Subgraphs = append(req.Subgraphs, sg.matchedPaths...)
+	}
 	return nil
 }
 
@@ -2712,15 +2852,32 @@ type ExecutionResult struct {
 	SchemaNode []*pb.SchemaNode
 	Types      []*pb.TypeUpdate
 	Metrics    map[string]uint64
+	// Plan describes how the query was executed, when asked to explain it.
+	Plan []*PlanNode
+	// PlanOnly is set when the query was only planned, so the subgraphs hold no results.
+	PlanOnly bool
 }
 
 // Process handles a query request.
 func (req *Request) Process(ctx context.Context) (er ExecutionResult, err error) {
+	mode, err := ExplainMode(ctx)
+	if err != nil {
+		return er, err
+	}
+	req.planOnly = mode == ExplainPlan
+
 	err = req.ProcessQuery(ctx)
 	if err != nil {
 		return er, err
 	}
 	er.Subgraphs = req.Subgraphs
+	if mode != "" {
+		er.Plan = explain(req.Subgraphs, !req.planOnly)
+		er.PlanOnly = req.planOnly
+	}
+	if profile, ok := ctx.Value(ProfileKey).(*Profile); ok && profile != nil {
+		profile.add(req.Subgraphs)
+	}
 	// calculate metrics.
 	metrics := make(map[string]uint64)
 	for _, sg := range er.Subgraphs {
@@ -2730,11 +2887,8 @@ func (req *Request) Process(ctx context.Context) (er ExecutionResult, err error)
 
 	schemaProcessingStart := time.Now()
 	if req.GqlQuery.Schema != nil {
-		if er.SchemaNode, err = worker.GetSchemaOverNetwork(ctx, req.GqlQuery.Schema); err != nil {
-			return er, errors.Wrapf(err, "while fetching schema")
-		}
-		if er.Types, err = worker.GetTypes(ctx, req.GqlQuery.Schema); err != nil {
-			return er, errors.Wrapf(err, "while fetching types")
+		if er.SchemaNode, er.Types, err = GetNamespaceSchema(ctx, req.GqlQuery.Schema); err != nil {
+			return er, err
 		}
 	}
 	req.Latency.Processing += time.Since(schemaProcessingStart)
@@ -1296,6 +1296,24 @@ func TestGroupByAgg(t *testing.T) {
 		js)
 }
 
+func TestGroupByStats(t *testing.T) {
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @groupby(school) {
+					median(age)
+					p: percentile(age, 1)
+					countdistinct(age)
+				}
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"friend":[{"@groupby":[{"school":"0x1388","median(age)":16.000000,"p":17.000000,"countdistinct(age)":2},{"school":"0x1389","median(age)":17.000000,"p":19.000000,"countdistinct(age)":2}]}]}]}}`,
+		js)
+}
+
 func TestGroupByMulti(t *testing.T) {
 	query := `
 		{
@@ -1144,6 +1144,45 @@ func TestAggregateRoot2(t *testing.T) {
 	require.JSONEq(t, `{"data": {"me":[{"avg(val(a))":24.000000},{"min(val(a))":15},{"max(val(a))":38}]}}`, js)
 }
 
+func TestAggregateRootStats(t *testing.T) {
+	query := `
+		{
+			var(func: anyofterms(name, "Rick Michonne Andrea")) {
+				a as age
+			}
+
+			me() {
+				median(val(a))
+				percentile(val(a), 0.25)
+				variance(val(a))
+				stddev(val(a))
+				countdistinct(val(a))
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"median(val(a))":19.000000},{"percentile(val(a))":17.000000},{"variance(val(a))":100.666667},{"stddev(val(a))":10.033278},{"countdistinct(val(a))":3}]}}`, js)
+}
+
+func TestCountDistinctPredicate(t *testing.T) {
+	query := `
+		{
+			me(func: anyofterms(name, "Rick Michonne Andrea")) {
+				countdistinct(age)
+			}
+
+			friends(func: uid(1)) {
+				name
+				friend {
+					ages: countdistinct(age)
+				}
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"countdistinct(age)":3}],"friends":[{"name":"Michonne","friend":[{"ages":3}]}]}}`, js)
+}
+
 func TestAggregateRoot3(t *testing.T) {
 
 	query := `
@@ -1312,6 +1351,51 @@ func TestFilterLang(t *testing.T) {
 		`{"data": {"me":[{"name@en":"European badger"},{"name@en":"Honey badger"},{"name@en":"Honey bee"}]}}`, js)
 }
 
+func TestMathStringAndDateFunctions(t *testing.T) {
+	query := `
+	{
+		me(func: uid(23, 24, 25, 31)) {
+			n as name
+			d as dob
+			short: math(upper(substr(n, 0, 4)))
+			year: math(year(d))
+			days: math(datediff(d, "1909-01-01", "day"))
+		}
+	}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[
+		{"name":"Rick Grimes","dob":"1910-01-02T00:00:00Z","short":"RICK","year":1910,"days":366},
+		{"name":"Glenn Rhee","dob":"1909-05-05T00:00:00Z","short":"GLEN","year":1909,"days":124},
+		{"name":"Daryl Dixon","dob":"1909-01-10T00:00:00Z","short":"DARY","year":1909,"days":9},
+		{"name":"Andrea","dob":"1901-01-15T00:00:00Z","short":"ANDR","year":1901,"days":-2908}]}}`, js)
+}
+
+func TestMathStringVarSortAndFilter(t *testing.T) {
+	query := `
+	{
+		var(func: uid(23, 24, 25, 31)) {
+			n as name
+			d as dob
+			s as math(lower(n))
+			y as math(year(truncate(d, "year")))
+		}
+
+		sorted(func: uid(s), orderasc: val(s)) {
+			name
+		}
+
+		filtered(func: uid(s)) @filter(ge(val(y), 1909)) {
+			name
+		}
+	}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {
+		"sorted":[{"name":"Andrea"},{"name":"Daryl Dixon"},{"name":"Glenn Rhee"},{"name":"Rick Grimes"}],
+		"filtered":[{"name":"Rick Grimes"},{"name":"Glenn Rhee"},{"name":"Daryl Dixon"}]}}`, js)
+}
+
 func TestMathCeil1(t *testing.T) {
 
 	query := `
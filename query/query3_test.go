@@ -25,6 +25,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
+
+	"github.com/dgraph-io/dgraph/types"
 )
 
 func TestRecurseError(t *testing.T) {
@@ -449,6 +451,179 @@ func TestTwoShortestPath(t *testing.T) {
 		js)
 }
 
+func TestShortestPathAllPaths(t *testing.T) {
+	query := `
+		{
+			A as shortest(from: 1, to:1003, allpaths: true) {
+				path
+			}
+
+			me(func: uid( A)) {
+				name
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"_path_":[
+			{"uid":"0x1","_weight_":4,"path":{"uid":"0x1f","path":{"uid":"0x3e8","path":{"uid":"0x3e9","path":{"uid":"0x3eb"}}}}},
+			{"uid":"0x1","_weight_":4,"path":{"uid":"0x1f","path":{"uid":"0x3e8","path":{"uid":"0x3ea","path":{"uid":"0x3eb"}}}}}],
+		"me":[{"name":"Michonne"},{"name":"Andrea"},{"name":"Alice"},{"name":"Bob"},{"name":"John"}]}}`,
+		js)
+}
+
+func TestShortestPathAllPathsNumPaths(t *testing.T) {
+	query := `
+		{
+			shortest(from: 1, to:1003, allpaths: true, numpaths: 1) {
+				path
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"_path_":[
+			{"uid":"0x1","_weight_":4,"path":{"uid":"0x1f","path":{"uid":"0x3e8","path":{"uid":"0x3e9","path":{"uid":"0x3eb"}}}}}]}}`,
+		js)
+}
+
+func TestShortestPathHeuristic(t *testing.T) {
+	query := `
+		{
+			shortest(from: 1, to:1003, heuristic: loc) {
+				path @facets(weight)
+			}
+		}`
+	// The nodes without a location are estimated to be at no distance of the destination, so
+	// A* finds the same path as Dijkstra.
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data":{"_path_":[{"uid":"0x1","_weight_":1,"path":{"uid":"0x1f","path":{"uid":"0x3e8","path":{"uid":"0x3e9","path":{"uid":"0x3ea","path":{"uid":"0x3eb","path|weight":0.600000},"path|weight":0.100000},"path|weight":0.100000},"path|weight":0.100000},"path|weight":0.100000}}]}}`,
+		js)
+}
+
+func TestShortestPathWeightPredicate(t *testing.T) {
+	query := `
+		{
+			shortest(from: 1, to: 31, weight: age) {
+				path
+			}
+		}`
+	// The weight of the edge is the age of the node it leads to.
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"_path_":[{"uid":"0x1","_weight_":19,"path":{"uid":"0x1f"}}]}}`,
+		js)
+}
+
+func TestShortestPathWeightVar(t *testing.T) {
+	query := `
+		{
+			var(func: uid(31, 1000, 1001, 1002, 1003)) {
+				w as math(2)
+			}
+
+			shortest(from: 1, to:1002, weight: val(w)) {
+				path
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"_path_":[{"uid":"0x1","_weight_":6,"path":{"uid":"0x1f","path":{"uid":"0x3e8","path":{"uid":"0x3ea"}}}}]}}`,
+		js)
+}
+
+func TestShortestPathNegativeWeight(t *testing.T) {
+	query := `
+		{
+			var(func: uid(31, 1000)) {
+				w as math(-1)
+			}
+
+			shortest(from: 1, to:1000, weight: val(w)) {
+				path
+			}
+		}`
+	_, err := processQuery(context.Background(), t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "negative weights")
+}
+
+func TestEdgeWeights(t *testing.T) {
+	w := &edgeWeights{vals: map[uint64]types.Val{
+		1: {Tid: types.IntID, Value: int64(3)},
+		2: {Tid: types.FloatID, Value: 0.5},
+		3: {Tid: types.FloatID, Value: -0.5},
+		4: {Tid: types.StringID, Value: "3"},
+	}}
+	weight, err := w.weight(1)
+	require.NoError(t, err)
+	require.Equal(t, 3.0, weight)
+	weight, err = w.weight(2)
+	require.NoError(t, err)
+	require.Equal(t, 0.5, weight)
+	_, err = w.weight(3)
+	require.Contains(t, err.Error(), "negative weights")
+	_, err = w.weight(4)
+	require.Contains(t, err.Error(), "must be an int or a float")
+	// Nodes without a weight can't be reached.
+	_, err = w.weight(5)
+	require.Equal(t, errFacet, err)
+}
+
+func TestPropertyPath(t *testing.T) {
+	query := `
+		{
+			me(func: path(1, "follow/follow+")) {
+				name
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Alice"},{"name":"Bob"},{"name":"Matt"},{"name":"John"}]}}`,
+		js)
+}
+
+func TestPropertyPathWithDestination(t *testing.T) {
+	query := `
+		{
+			me(func: path(1, "follow+", 1002)) {
+				name
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Matt"}],
+		"_path_":[{"uid":"0x1","_weight_":4,"follow":{"uid":"0x1f","follow":{"uid":"0x3e9","follow":{"uid":"0x3eb","follow":{"uid":"0x3ea"}}}}}]}}`,
+		js)
+}
+
+func TestAllShortestPaths(t *testing.T) {
+	// 1 -> 2 -> 4 and 1 -> 3 -> 4 have the same weight, so 4 has two parents.
+	dist := map[uint64]nodeInfo{
+		1: {},
+		2: {parent: 1, mapItem: mapItem{cost: 1, attr: "road"}},
+		3: {parent: 1, mapItem: mapItem{cost: 1, attr: "rail"}},
+		4: {parent: 3, mapItem: mapItem{cost: 2, attr: "rail"},
+			others: []pathInfo{{uid: 2, attr: "road"}}},
+	}
+	uids := func(r route) []uint64 {
+		var res []uint64
+		for _, p := range *r.route {
+			res = append(res, p.uid)
+		}
+		return res
+	}
+
+	routes := allShortestPaths(dist, 1, 4, 2, 0)
+	require.Len(t, routes, 2)
+	require.Equal(t, []uint64{1, 2, 4}, uids(routes[0]))
+	require.Equal(t, "road", (*routes[0].route)[2].attr)
+	require.Equal(t, []uint64{1, 3, 4}, uids(routes[1]))
+	require.Equal(t, "rail", (*routes[1].route)[1].attr)
+	require.Equal(t, 2.0, routes[1].totalWeight)
+
+	require.Len(t, allShortestPaths(dist, 1, 4, 2, 1), 1)
+}
+
 func TestTwoShortestPathMaxWeight(t *testing.T) {
 
 	query := `
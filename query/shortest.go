@@ -20,8 +20,11 @@ import (
 	"container/heap"
 	"context"
 	"math"
+	"sort"
 	"sync"
 
+	geom "github.com/twpayne/go-geom"
+
 	"github.com/dgraph-io/dgraph/algo"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/types"
@@ -42,11 +45,12 @@ type route struct {
 }
 
 type queueItem struct {
-	uid   uint64  // uid of the node.
-	cost  float64 // cost of taking the path till this uid.
-	hop   int     // number of hops taken to reach this node.
-	index int
-	path  route // used in k shortest path.
+	uid      uint64  // uid of the node.
+	cost     float64 // cost of taking the path till this uid.
+	estimate float64 // cost plus the estimated cost from this uid to the destination.
+	hop      int     // number of hops taken to reach this node.
+	index    int
+	path     route // used in k shortest path.
 }
 
 var pathPool = sync.Pool{
@@ -61,7 +65,7 @@ var errFacet = errors.Errorf("Skip the edge")
 type priorityQueue []*queueItem
 
 func (h priorityQueue) Len() int           { return len(h) }
-func (h priorityQueue) Less(i, j int) bool { return h[i].cost < h[j].cost }
+func (h priorityQueue) Less(i, j int) bool { return h[i].estimate < h[j].estimate }
 func (h priorityQueue) Swap(i, j int) {
 	h[i], h[j] = h[j], h[i]
 	h[i].index = i
@@ -95,6 +99,185 @@ type nodeInfo struct {
 	parent uint64
 	// Pointer to the item in heap. Used to update priority
 	node *queueItem
+	// others holds the other parents reaching the node with the same cost, along with the attr
+	// and facet of their edge. They are only tracked when all the shortest paths are asked for.
+	others []pathInfo
+}
+
+// heuristic estimates the cost of the path from a node to the destination for A*, using the
+// great-circle distance in meters between their locations held by a geo predicate. The weights
+// of the edges should be distances in meters too, otherwise the estimate might be too large and
+// the path found might not be the shortest one.
+type heuristic struct {
+	attr   string
+	readTs uint64
+	cache  int
+	// target is the location of the destination.
+	target *geom.Point
+	// locations holds the locations fetched so far. It's nil for nodes without a location.
+	locations map[uint64]*geom.Point
+}
+
+// newHeuristic returns the heuristic of the shortest path query, or nil if it doesn't use A*.
+func newHeuristic(ctx context.Context, sg *SubGraph) (*heuristic, error) {
+	if sg.Params.Heuristic == "" {
+		return nil, nil
+	}
+	h := &heuristic{
+		attr:      sg.Params.Heuristic,
+		readTs:    sg.ReadTs,
+		cache:     sg.Cache,
+		locations: make(map[uint64]*geom.Point),
+	}
+	if err := h.locate(ctx, []uint64{sg.Params.From, sg.Params.To}); err != nil {
+		return nil, err
+	}
+	h.target = h.locations[sg.Params.To]
+	return h, nil
+}
+
+// locate fetches the locations of the uids which haven't been fetched yet.
+func (h *heuristic) locate(ctx context.Context, uids []uint64) error {
+	var missing []uint64
+	for _, uid := range uids {
+		if _, ok := h.locations[uid]; !ok {
+			h.locations[uid] = nil
+			missing = append(missing, uid)
+		}
+	}
+	vals, err := fetchValues(ctx, h.attr, h.readTs, h.cache, missing)
+	if err != nil {
+		return err
+	}
+	for uid, v := range vals {
+		// Only points have a location, other geometries are ignored.
+		if p, ok := v.Value.(*geom.Point); ok {
+			h.locations[uid] = p
+		}
+	}
+	return nil
+}
+
+// edgeWeights holds the weights of the nodes when they are read from a numeric predicate or a
+// value variable instead of facets. The weight of an edge is the weight of the node it leads to.
+type edgeWeights struct {
+	// attr is the predicate holding the weights. It's empty if they come from a variable.
+	attr   string
+	readTs uint64
+	cache  int
+	// vals holds the weights fetched so far. Nodes without a weight have a nil value.
+	vals map[uint64]types.Val
+}
+
+// newEdgeWeights returns the weights of the shortest path query, or nil if it uses facets.
+func newEdgeWeights(sg *SubGraph) *edgeWeights {
+	switch {
+	case sg.Params.Weight == "":
+		return nil
+	case sg.Params.WeightVar:
+		vals := sg.Params.UidToVal
+		if vals == nil {
+			vals = make(map[uint64]types.Val)
+		}
+		return &edgeWeights{vals: vals}
+	}
+	return &edgeWeights{
+		attr:   sg.Params.Weight,
+		readTs: sg.ReadTs,
+		cache:  sg.Cache,
+		vals:   make(map[uint64]types.Val),
+	}
+}
+
+// fetch reads the weights of the uids which haven't been fetched yet from the predicate.
+func (w *edgeWeights) fetch(ctx context.Context, uids []uint64) error {
+	if w.attr == "" {
+		return nil
+	}
+	var missing []uint64
+	for _, uid := range uids {
+		if _, ok := w.vals[uid]; !ok {
+			w.vals[uid] = types.Val{}
+			missing = append(missing, uid)
+		}
+	}
+	vals, err := fetchValues(ctx, w.attr, w.readTs, w.cache, missing)
+	if err != nil {
+		return err
+	}
+	for uid, v := range vals {
+		w.vals[uid] = v
+	}
+	return nil
+}
+
+// weight returns the weight of the edges leading to the uid. It returns errFacet if the node
+// has no weight, in which case the edge is ignored.
+func (w *edgeWeights) weight(uid uint64) (float64, error) {
+	v, ok := w.vals[uid]
+	if !ok || v.Value == nil {
+		return 0, errFacet
+	}
+	var weight float64
+	switch v.Tid {
+	case types.IntID:
+		weight = float64(v.Value.(int64))
+	case types.FloatID:
+		weight = v.Value.(float64)
+	default:
+		return 0, errors.Errorf("Weight of node %#x must be an int or a float. Got: %s",
+			uid, v.Tid.Name())
+	}
+	if weight < 0 {
+		return 0, errors.Errorf("Shortest path doesn't support negative weights. "+
+			"Got: %v for node %#x", weight, uid)
+	}
+	return weight, nil
+}
+
+// fetchValues returns the values of the predicate for the given uids. Uids without a value are
+// left out.
+func fetchValues(ctx context.Context, attr string, readTs uint64, cache int,
+	uids []uint64) (map[uint64]types.Val, error) {
+
+	vals := make(map[uint64]types.Val)
+	if len(uids) == 0 {
+		return vals, nil
+	}
+	uids = append(uids[:0:0], uids...)
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+
+	sg := &SubGraph{
+		Attr:    attr,
+		ReadTs:  readTs,
+		Cache:   cache,
+		SrcUIDs: &pb.List{Uids: uids},
+	}
+	rch := make(chan error, 1)
+	ProcessGraph(ctx, sg, &SubGraph{}, rch)
+	if err := <-rch; err != nil {
+		return nil, err
+	}
+	for i, uid := range sg.SrcUIDs.Uids {
+		if i >= len(sg.valueMatrix) || len(sg.valueMatrix[i].Values) == 0 {
+			continue
+		}
+		v, err := convertWithBestEffort(sg.valueMatrix[i].Values[0], attr)
+		if err != nil {
+			continue
+		}
+		vals[uid] = v
+	}
+	return vals, nil
+}
+
+// estimate returns the estimated cost of the path from the uid to the destination. It's zero
+// when the heuristic is nil or either location is unknown, so the estimate is never too large.
+func (h *heuristic) estimate(uid uint64) float64 {
+	if h == nil || h.target == nil || h.locations[uid] == nil {
+		return 0
+	}
+	return float64(types.PointDistance(h.locations[uid], h.target))
 }
 
 func (sg *SubGraph) getCost(matrix, list int) (cost float64,
@@ -132,9 +315,10 @@ func (sg *SubGraph) getCost(matrix, list int) (cost float64,
 	return cost, fcs, rerr
 }
 
-func (sg *SubGraph) expandOut(ctx context.Context,
-	adjacencyMap map[uint64]map[uint64]mapItem, next chan bool, rch chan error) {
+func (sg *SubGraph) expandOut(ctx context.Context, adjacencyMap map[uint64]map[uint64]mapItem,
+	h *heuristic, next chan bool, rch chan error) {
 
+	weights := newEdgeWeights(sg)
 	var numEdges uint64
 	var exec []*SubGraph
 	var err error
@@ -171,6 +355,20 @@ func (sg *SubGraph) expandOut(ctx context.Context,
 			}
 		}
 
+		if weights != nil {
+			// Fetch the weights of the nodes reached, before computing the cost of the edges.
+			var reached []uint64
+			for _, subgraph := range exec {
+				if !subgraph.UnknownAttr {
+					reached = append(reached, subgraph.DestUIDs.Uids...)
+				}
+			}
+			if err := weights.fetch(ctx, reached); err != nil {
+				rch <- err
+				return
+			}
+		}
+
 		for _, subgraph := range exec {
 			select {
 			case <-ctx.Done():
@@ -195,6 +393,10 @@ func (sg *SubGraph) expandOut(ctx context.Context,
 						}
 						// The default cost we'd use is 1.
 						cost, facet, err := subgraph.getCost(mIdx, lIdx)
+						if weights != nil && (err == nil || err == errFacet) {
+							// The weight of the node replaces the weight from the facets.
+							cost, err = weights.weight(toUID)
+						}
 						if err == errFacet {
 							// Ignore the edge and continue.
 							continue
@@ -220,6 +422,21 @@ func (sg *SubGraph) expandOut(ctx context.Context,
 			return
 		}
 
+		if h != nil {
+			// Fetch the locations of the nodes reached, to estimate their distance to the
+			// destination.
+			var reached []uint64
+			for _, subgraph := range exec {
+				if !subgraph.UnknownAttr {
+					reached = append(reached, subgraph.DestUIDs.Uids...)
+				}
+			}
+			if err := h.locate(ctx, reached); err != nil {
+				rch <- err
+				return
+			}
+		}
+
 		// modify the exec and attach child nodes.
 		var out []*SubGraph
 		for _, subgraph := range exec {
@@ -275,16 +492,21 @@ func runKShortestPaths(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	}
 
 	numPaths := sg.Params.NumPaths
+	h, err := newHeuristic(ctx, sg)
+	if err != nil {
+		return nil, err
+	}
 	var kroutes []route
 	pq := make(priorityQueue, 0)
 	heap.Init(&pq)
 
 	// Initialize and push the source node.
 	srcNode := &queueItem{
-		uid:  sg.Params.From,
-		cost: 0,
-		hop:  0,
-		path: route{route: &[]pathInfo{{uid: sg.Params.From}}},
+		uid:      sg.Params.From,
+		cost:     0,
+		estimate: h.estimate(sg.Params.From),
+		hop:      0,
+		path:     route{route: &[]pathInfo{{uid: sg.Params.From}}},
 	}
 	heap.Push(&pq, srcNode)
 
@@ -298,7 +520,7 @@ func runKShortestPaths(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	next := make(chan bool, 2)
 	expandErr := make(chan error, 2)
 	adjacencyMap := make(map[uint64]map[uint64]mapItem)
-	go sg.expandOut(ctx, adjacencyMap, next, expandErr)
+	go sg.expandOut(ctx, adjacencyMap, h, next, expandErr)
 
 	// In k shortest path we can't have this. We store the path till a node in every
 	// node.
@@ -377,10 +599,11 @@ func runKShortestPaths(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 				facet: info.facet,
 			}
 			node := &queueItem{
-				uid:  toUid,
-				cost: item.cost + cost,
-				hop:  item.hop + 1,
-				path: route{route: curPath},
+				uid:      toUid,
+				cost:     item.cost + cost,
+				estimate: item.cost + cost + h.estimate(toUid),
+				hop:      item.hop + 1,
+				path:     route{route: curPath},
 			}
 			heap.Push(&pq, node)
 		}
@@ -443,17 +666,22 @@ func shortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 		numPaths = 1
 	}
 
-	if numPaths > 1 {
+	if numPaths > 1 && !sg.Params.AllPaths {
 		return runKShortestPaths(ctx, sg)
 	}
+	h, err := newHeuristic(ctx, sg)
+	if err != nil {
+		return nil, err
+	}
 	pq := make(priorityQueue, 0)
 	heap.Init(&pq)
 
 	// Initialize and push the source node.
 	srcNode := &queueItem{
-		uid:  sg.Params.From,
-		cost: 0,
-		hop:  0,
+		uid:      sg.Params.From,
+		cost:     0,
+		estimate: h.estimate(sg.Params.From),
+		hop:      0,
 	}
 	heap.Push(&pq, srcNode)
 
@@ -465,7 +693,7 @@ func shortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	next := make(chan bool, 2)
 	expandErr := make(chan error, 2)
 	adjacencyMap := make(map[uint64]map[uint64]mapItem)
-	go sg.expandOut(ctx, adjacencyMap, next, expandErr)
+	go sg.expandOut(ctx, adjacencyMap, h, next, expandErr)
 
 	// map to store the min cost and parent of nodes.
 	dist := make(map[uint64]nodeInfo)
@@ -477,13 +705,22 @@ func shortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 		},
 	}
 
-	var stopExpansion bool
+	var stopExpansion, found bool
 	var totalWeight float64
 	for pq.Len() > 0 {
 		item := heap.Pop(&pq).(*queueItem)
+		if found && item.estimate > totalWeight {
+			// All the remaining paths are more expensive.
+			break
+		}
 		if item.uid == sg.Params.To {
 			totalWeight = item.cost
-			break
+			if !sg.Params.AllPaths {
+				break
+			}
+			// Keep going to find the other paths with the same weight.
+			found = true
+			continue
 		}
 		if item.hop > numHops && numHops < maxHops {
 			// Explore the next level by calling processGraph and add them
@@ -514,6 +751,17 @@ func shortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 				for toUid, info := range neighbours {
 					cost := info.cost
 					d, ok := dist[toUid]
+					if ok && d.cost == item.cost+cost && sg.Params.AllPaths &&
+						toUid != sg.Params.From {
+						// Another path with the same cost.
+						d.others = append(d.others, pathInfo{
+							uid:   item.uid,
+							attr:  info.attr,
+							facet: info.facet,
+						})
+						dist[toUid] = d
+						continue
+					}
 					if ok && d.cost <= item.cost+cost {
 						continue
 					}
@@ -521,9 +769,10 @@ func shortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 						// This is the first time we're seeing this node. So
 						// create a new node and add it to the heap and map.
 						node := &queueItem{
-							uid:  toUid,
-							cost: item.cost + cost,
-							hop:  item.hop + 1,
+							uid:      toUid,
+							cost:     item.cost + cost,
+							estimate: item.cost + cost + h.estimate(toUid),
+							hop:      item.hop + 1,
 						}
 						heap.Push(&pq, node)
 						dist[toUid] = nodeInfo{
@@ -540,8 +789,15 @@ func shortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 						// and fix the priority in the heap and map.
 						node := dist[toUid].node
 						node.cost = item.cost + cost
+						node.estimate = node.cost + h.estimate(toUid)
 						node.hop = item.hop + 1
-						heap.Fix(&pq, node.index)
+						if node.index < 0 {
+							// The node was already popped, which can only happen with A* if the
+							// heuristic overestimated its cost. Visit it again.
+							heap.Push(&pq, node)
+						} else {
+							heap.Fix(&pq, node.index)
+						}
 						// Update the map with new values.
 						dist[toUid] = nodeInfo{
 							parent: item.uid,
@@ -559,6 +815,21 @@ func shortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	}
 
 	next <- false
+	if sg.Params.AllPaths {
+		routes := allShortestPaths(dist, sg.Params.From, sg.Params.To, totalWeight,
+			sg.Params.NumPaths)
+		if !found || len(routes) == 0 {
+			sg.DestUIDs = &pb.List{}
+			return nil, nil
+		}
+		var res []uint64
+		for _, it := range *routes[0].route {
+			res = append(res, it.uid)
+		}
+		sg.DestUIDs.Uids = res
+		return createkroutesubgraph(ctx, routes), nil
+	}
+
 	// Go through the distance map to find the path.
 	var result []uint64
 	cur := sg.Params.To
@@ -584,6 +855,50 @@ func shortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	return []*SubGraph{shortestSg}, nil
 }
 
+// allShortestPaths follows the parents of the nodes from the destination back to the source to
+// find all the paths of minimal weight. At most limit paths are returned, unless it's zero.
+func allShortestPaths(dist map[uint64]nodeInfo, from, to uint64, totalWeight float64,
+	limit int) []route {
+
+	var routes []route
+	onPath := make(map[uint64]bool)
+	// suffix holds the nodes after uid in the path, from the destination backwards, along with
+	// the attr and facet of the edge leading to them.
+	var walk func(uid uint64, suffix []pathInfo)
+	walk = func(uid uint64, suffix []pathInfo) {
+		if limit > 0 && len(routes) >= limit {
+			return
+		}
+		if uid == from {
+			path := make([]pathInfo, 0, len(suffix)+1)
+			path = append(path, pathInfo{uid: from})
+			for i := len(suffix) - 1; i >= 0; i-- {
+				path = append(path, suffix[i])
+			}
+			routes = append(routes, route{route: &path, totalWeight: totalWeight})
+			return
+		}
+		info, ok := dist[uid]
+		// Edges with no weight could form a loop of parents.
+		if !ok || onPath[uid] {
+			return
+		}
+		onPath[uid] = true
+		parents := append([]pathInfo{{uid: info.parent, attr: info.attr, facet: info.facet}},
+			info.others...)
+		// Sort the parents so the paths are always returned in the same order.
+		sort.Slice(parents, func(i, j int) bool { return parents[i].uid < parents[j].uid })
+		for _, p := range parents {
+			next := append(suffix[:len(suffix):len(suffix)],
+				pathInfo{uid: uid, attr: p.attr, facet: p.facet})
+			walk(p.uid, next)
+		}
+		onPath[uid] = false
+	}
+	walk(to, nil)
+	return routes
+}
+
 func createPathSubgraph(ctx context.Context, dist map[uint64]nodeInfo, totalWeight float64,
 	result []uint64) *SubGraph {
 	shortestSg := new(SubGraph)
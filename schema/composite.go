@@ -0,0 +1,72 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// The hidden predicates of the composite indexes are named after the indexes, with this prefix.
+const compositeIndexPrefix = "dgraph.index."
+
+// CompositeIndexPredicate returns the hidden predicate storing the tuples of values indexed by
+// the composite index. It belongs to the namespace of the type of the index.
+func CompositeIndexPredicate(index *pb.CompositeIndex) string {
+	return x.NamespaceAttr(x.ParseNamespace(index.TypeName), compositeIndexPrefix+index.Name)
+}
+
+// IsCompositeIndexPredicate returns true if the predicate is the hidden predicate of a
+// composite index, which can't be mutated directly.
+func IsCompositeIndexPredicate(pred string) bool {
+	return strings.HasPrefix(x.ParseAttr(pred), compositeIndexPrefix)
+}
+
+// CompositeIndexSchema returns the schema of the hidden predicate of the composite index. Its
+// values are only ever compared for equality, so they're indexed with the hash tokenizer.
+func CompositeIndexSchema(index *pb.CompositeIndex) *pb.SchemaUpdate {
+	return &pb.SchemaUpdate{
+		Predicate: CompositeIndexPredicate(index),
+		ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX,
+		Tokenizer: []string{"hash"},
+	}
+}
+
+// CompositeIndexValue returns the value stored in the hidden predicate of a composite index for
+// a node with the given values of the predicates of the index. The values must have been
+// converted to the types of the predicates (see types.Convert), so that the same values always
+// give the same tuple.
+func CompositeIndexValue(vals []types.Val) (string, error) {
+	var b strings.Builder
+	for _, val := range vals {
+		str := types.ValueForType(types.StringID)
+		if err := types.Marshal(val, &str); err != nil {
+			return "", err
+		}
+		// Each value is prefixed by its length, so that no two tuples give the same value.
+		s := str.Value.(string)
+		b.WriteString(strconv.Itoa(len(s)))
+		b.WriteByte(':')
+		b.WriteString(s)
+	}
+	return b.String(), nil
+}
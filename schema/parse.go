@@ -18,6 +18,7 @@ package schema
 
 import (
 	"strings"
+	"time"
 
 	"github.com/dgraph-io/dgraph/lex"
 	"github.com/dgraph-io/dgraph/protos/pb"
@@ -47,7 +48,8 @@ func ParseBytes(s []byte, gid uint32) (rerr error) {
 	return nil
 }
 
-func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeID) error {
+func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeID,
+	migration *pb.Migration) error {
 	it.Next()
 	next := it.Item()
 	if next.Typ != itemText {
@@ -70,6 +72,33 @@ func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeI
 		schema.Count = true
 	case "upsert":
 		schema.Upsert = true
+	case "unique":
+		schema.Unique = true
+	case "ttl":
+		ttl, err := parseTTLDirective(it, schema.Predicate)
+		if err != nil {
+			return err
+		}
+		schema.Ttl = ttl
+	case "where":
+		where, err := parseWhereDirective(it, schema.Predicate)
+		if err != nil {
+			return err
+		}
+		schema.Where = where
+	case "facets":
+		keys, err := parseFacetsDirective(it, schema.Predicate)
+		if err != nil {
+			return err
+		}
+		schema.FacetIndex = keys
+	case "migrate":
+		from, err := parseMigrateDirective(it, schema.Predicate)
+		if err != nil {
+			return err
+		}
+		migration.From = from
+		migration.Schema = schema
 	case "lang":
 		if t != types.StringID || schema.List {
 			return next.Errorf("@lang directive can only be specified for string type."+
@@ -84,7 +113,8 @@ func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeI
 	return nil
 }
 
-func parseScalarPair(it *lex.ItemIterator, predicate string) (*pb.SchemaUpdate, error) {
+func parseScalarPair(it *lex.ItemIterator, predicate string) (*pb.SchemaUpdate, *pb.Migration,
+	error) {
 	it.Next()
 	next := it.Item()
 	switch {
@@ -97,11 +127,11 @@ func parseScalarPair(it *lex.ItemIterator, predicate string) (*pb.SchemaUpdate,
 	// '@' in predicate names, so both forms are disallowed. Handling them here avoids
 	// messing with the lexer and IRI values.
 	case next.Typ == itemAt || strings.Contains(predicate, "@"):
-		return nil, next.Errorf("Invalid '@' in name")
+		return nil, nil, next.Errorf("Invalid '@' in name")
 	case next.Typ != itemColon:
-		return nil, next.Errorf("Missing colon")
+		return nil, nil, next.Errorf("Missing colon")
 	case !it.Next():
-		return nil, next.Errorf("Invalid ending while trying to parse schema.")
+		return nil, nil, next.Errorf("Invalid ending while trying to parse schema.")
 	}
 	next = it.Item()
 	schema := &pb.SchemaUpdate{Predicate: predicate}
@@ -109,23 +139,23 @@ func parseScalarPair(it *lex.ItemIterator, predicate string) (*pb.SchemaUpdate,
 	if next.Typ == itemLeftSquare {
 		schema.List = true
 		if !it.Next() {
-			return nil, next.Errorf("Invalid ending while trying to parse schema.")
+			return nil, nil, next.Errorf("Invalid ending while trying to parse schema.")
 		}
 		next = it.Item()
 	}
 
 	if next.Typ != itemText {
-		return nil, next.Errorf("Missing Type")
+		return nil, nil, next.Errorf("Missing Type")
 	}
 	typ := strings.ToLower(next.Val)
 	// We ignore the case for types.
 	t, ok := types.TypeForName(typ)
 	if !ok {
-		return nil, next.Errorf("Undefined Type")
+		return nil, nil, next.Errorf("Undefined Type")
 	}
 	if schema.List {
 		if uint32(t) == uint32(types.PasswordID) || uint32(t) == uint32(types.BoolID) {
-			return nil, next.Errorf("Unsupported type for list: [%s].", types.TypeID(t).Name())
+			return nil, nil, next.Errorf("Unsupported type for list: [%s].", types.TypeID(t).Name())
 		}
 	}
 	schema.ValueType = t.Enum()
@@ -135,37 +165,171 @@ func parseScalarPair(it *lex.ItemIterator, predicate string) (*pb.SchemaUpdate,
 	next = it.Item()
 	if schema.List {
 		if next.Typ != itemRightSquare {
-			return nil, next.Errorf("Unclosed [ while parsing schema for: %s", predicate)
+			return nil, nil, next.Errorf("Unclosed [ while parsing schema for: %s", predicate)
 		}
 		if !it.Next() {
-			return nil, next.Errorf("Invalid ending")
+			return nil, nil, next.Errorf("Invalid ending")
 		}
 		next = it.Item()
 	}
 
+	migration := &pb.Migration{}
 	for {
 		if next.Typ != itemAt {
 			break
 		}
-		if err := parseDirective(it, schema, t); err != nil {
-			return nil, err
+		if err := parseDirective(it, schema, t, migration); err != nil {
+			return nil, nil, err
 		}
 		next = it.Item()
 	}
+	if migration.Schema == nil {
+		migration = nil
+	}
+	if schema.Where != nil && schema.Directive != pb.SchemaUpdate_INDEX {
+		return nil, nil, next.Errorf("@where requires an @index for pred: %s", predicate)
+	}
+	if schema.Where != nil && schema.Where.Predicate == predicate {
+		return nil, nil, next.Errorf("The @where condition of pred %s cannot be on itself",
+			predicate)
+	}
 
 	if next.Typ != itemDot {
-		return nil, next.Errorf("Invalid ending")
+		return nil, nil, next.Errorf("Invalid ending")
 	}
 	it.Next()
 	next = it.Item()
 	if next.Typ == lex.ItemEOF {
 		it.Prev()
-		return schema, nil
+		return schema, migration, nil
 	}
 	if next.Typ != itemNewLine {
-		return nil, next.Errorf("Invalid ending")
+		return nil, nil, next.Errorf("Invalid ending")
+	}
+	return schema, migration, nil
+}
+
+// parseMigrateDirective works on "@migrate" or "@migrate(oldname)" and returns the predicate
+// whose values are migrated.
+func parseMigrateDirective(it *lex.ItemIterator, predicate string) (string, error) {
+	if next, ok := it.PeekOne(); !ok || next.Typ != itemLeftRound {
+		return predicate, nil
+	}
+	it.Next()
+	if !it.Next() || it.Item().Typ != itemText {
+		return "", it.Item().Errorf("Require a predicate for @migrate of pred: %s", predicate)
+	}
+	from := it.Item().Val
+	if !it.Next() || it.Item().Typ != itemRightRound {
+		return "", it.Item().Errorf("Unclosed @migrate of pred: %s", predicate)
+	}
+	return from, nil
+}
+
+// parseWhereDirective works on "@where(eq(pred, value))" or "@where(has(pred))" and returns
+// the condition of the partial index.
+func parseWhereDirective(it *lex.ItemIterator, predicate string) (*pb.IndexCondition, error) {
+	if !it.Next() || it.Item().Typ != itemLeftRound {
+		return nil, it.Item().Errorf("Require a condition for @where of pred: %s", predicate)
+	}
+	if !it.Next() || it.Item().Typ != itemText {
+		return nil, it.Item().Errorf("Require a function for @where of pred: %s", predicate)
+	}
+	where := &pb.IndexCondition{FuncName: strings.ToLower(it.Item().Val)}
+	if where.FuncName != "eq" && where.FuncName != "has" {
+		return nil, it.Item().Errorf("Invalid function %s for @where of pred: %s. "+
+			"Only eq and has are supported", it.Item().Val, predicate)
+	}
+	if !it.Next() || it.Item().Typ != itemLeftRound {
+		return nil, it.Item().Errorf("Require arguments for %s in @where of pred: %s",
+			where.FuncName, predicate)
+	}
+	if !it.Next() || it.Item().Typ != itemText {
+		return nil, it.Item().Errorf("Require a predicate for %s in @where of pred: %s",
+			where.FuncName, predicate)
+	}
+	where.Predicate = it.Item().Val
+	if where.FuncName == "eq" {
+		if !it.Next() || it.Item().Typ != itemComma {
+			return nil, it.Item().Errorf("Require a value for eq in @where of pred: %s",
+				predicate)
+		}
+		if !it.Next() || it.Item().Typ != itemText {
+			return nil, it.Item().Errorf("Require a value for eq in @where of pred: %s",
+				predicate)
+		}
+		where.Value = it.Item().Val
+	}
+	for i := 0; i < 2; i++ {
+		if !it.Next() || it.Item().Typ != itemRightRound {
+			return nil, it.Item().Errorf("Unclosed @where of pred: %s", predicate)
+		}
+	}
+	return where, nil
+}
+
+// parseFacetsDirective works on "@facets(index: key1, key2)" and returns the keys of the
+// indexed facets.
+func parseFacetsDirective(it *lex.ItemIterator, predicate string) ([]string, error) {
+	if !it.Next() || it.Item().Typ != itemLeftRound {
+		return nil, it.Item().Errorf("Require facets to index for @facets of pred: %s", predicate)
+	}
+	if !it.Next() || it.Item().Typ != itemText || it.Item().Val != "index" {
+		return nil, it.Item().Errorf("Expected index in @facets of pred: %s", predicate)
+	}
+	if !it.Next() || it.Item().Typ != itemColon {
+		return nil, it.Item().Errorf("Missing colon after index in @facets of pred: %s",
+			predicate)
+	}
+
+	var keys []string
+	seen := make(map[string]bool)
+	for {
+		if !it.Next() || it.Item().Typ != itemText {
+			return nil, it.Item().Errorf("Expected a facet in @facets of pred: %s", predicate)
+		}
+		key := it.Item().Val
+		if seen[key] {
+			return nil, it.Item().Errorf("Duplicate facet %s in @facets of pred: %s", key,
+				predicate)
+		}
+		seen[key] = true
+		keys = append(keys, key)
+
+		if !it.Next() {
+			return nil, it.Item().Errorf("Unclosed @facets of pred: %s", predicate)
+		}
+		switch it.Item().Typ {
+		case itemComma:
+		case itemRightRound:
+			return keys, nil
+		default:
+			return nil, it.Item().Errorf("Expected a comma but got: %v", it.Item().Val)
+		}
 	}
-	return schema, nil
+}
+
+// parseTTLDirective works on "@ttl(24h)" and returns the duration in seconds.
+func parseTTLDirective(it *lex.ItemIterator, predicate string) (uint64, error) {
+	if !it.Next() || it.Item().Typ != itemLeftRound {
+		return 0, it.Item().Errorf("Require a duration for @ttl of pred: %s", predicate)
+	}
+	if !it.Next() || it.Item().Typ != itemText {
+		return 0, it.Item().Errorf("Require a duration for @ttl of pred: %s", predicate)
+	}
+	next := it.Item()
+	dur, err := time.ParseDuration(next.Val)
+	if err != nil {
+		return 0, next.Errorf("Invalid duration %s for @ttl of pred: %s", next.Val, predicate)
+	}
+	if dur < time.Second {
+		return 0, next.Errorf("The @ttl of pred: %s must be at least one second. Got: %s",
+			predicate, next.Val)
+	}
+	if !it.Next() || it.Item().Typ != itemRightRound {
+		return 0, it.Item().Errorf("Unclosed @ttl of pred: %s", predicate)
+	}
+	return uint64(dur / time.Second), nil
 }
 
 // parseIndexDirective works on "@index" or "@index(customtokenizer)".
@@ -305,7 +469,18 @@ func parseTypeDeclaration(it *lex.ItemIterator) (*pb.TypeUpdate, error) {
 	}
 	typeUpdate := &pb.TypeUpdate{TypeName: it.Item().Val}
 
+	// The types of the fields are only kept, and enforced when transactions commit, for the
+	// types declared with @strict.
+	var strict bool
 	it.Next()
+	if it.Item().Typ == itemAt {
+		it.Next()
+		if it.Item().Typ != itemText || it.Item().Val != "strict" {
+			return nil, it.Item().Errorf("Invalid type directive. Got %v", it.Item().Val)
+		}
+		strict = true
+		it.Next()
+	}
 	if it.Item().Typ != itemLeftCurl {
 		return nil, it.Item().Errorf("Expected {. Got %v", it.Item().Val)
 	}
@@ -336,7 +511,7 @@ func parseTypeDeclaration(it *lex.ItemIterator) (*pb.TypeUpdate, error) {
 			typeUpdate.Fields = fields
 			return typeUpdate, nil
 		case itemText:
-			field, err := parseTypeField(it, typeUpdate.TypeName)
+			field, err := parseTypeField(it, typeUpdate.TypeName, strict)
 			if err != nil {
 				return nil, err
 			}
@@ -350,9 +525,8 @@ func parseTypeDeclaration(it *lex.ItemIterator) (*pb.TypeUpdate, error) {
 	return nil, errors.Errorf("Shouldn't reach here.")
 }
 
-func parseTypeField(it *lex.ItemIterator, typeName string) (*pb.SchemaUpdate, error) {
+func parseTypeField(it *lex.ItemIterator, typeName string, strict bool) (*pb.SchemaUpdate, error) {
 	field := &pb.SchemaUpdate{Predicate: it.Item().Val}
-	var list bool
 	it.Next()
 
 	// Simplified type definitions only require the field name. If a new line is found,
@@ -361,15 +535,17 @@ func parseTypeField(it *lex.ItemIterator, typeName string) (*pb.SchemaUpdate, er
 		return field, nil
 	}
 
-	// For the sake of backwards-compatibility, process type definitions in the old format,
-	// but ignore the information after the colon.
 	if it.Item().Typ != itemColon {
 		return nil, it.Item().Errorf("Missing colon in type declaration. Got %v", it.Item().Val)
 	}
 
+	// The type of the field is enforced when transactions commit, e.g. name: string! requires
+	// the nodes of the type to have a name, and friends: [Person] requires the friends of the
+	// nodes to be of type Person. For the sake of backwards-compatibility, the types declared
+	// without @strict ignore the information after the colon.
 	it.Next()
 	if it.Item().Typ == itemLeftSquare {
-		list = true
+		field.List = true
 		it.Next()
 	}
 
@@ -377,19 +553,26 @@ func parseTypeField(it *lex.ItemIterator, typeName string) (*pb.SchemaUpdate, er
 		return nil, it.Item().Errorf("Missing field type in type declaration. Got %v",
 			it.Item().Val)
 	}
+	fieldType := it.Item().Val
+	field.ValueType = getType(fieldType)
+	if field.ValueType == pb.Posting_OBJECT {
+		field.ObjectTypeName = fieldType
+	}
 
 	it.Next()
 	if it.Item().Typ == itemExclamationMark {
+		field.NonNullable = true
 		it.Next()
 	}
 
-	if list {
+	if field.List {
 		if it.Item().Typ != itemRightSquare {
 			return nil, it.Item().Errorf("Expected matching square bracket. Got %v", it.Item().Val)
 		}
 		it.Next()
 
 		if it.Item().Typ == itemExclamationMark {
+			field.NonNullableList = true
 			it.Next()
 		}
 	}
@@ -399,8 +582,12 @@ func parseTypeField(it *lex.ItemIterator, typeName string) (*pb.SchemaUpdate, er
 			it.Item().Val)
 	}
 
-	glog.Warningf("Type declaration for type %s includes deprecated information about field type "+
-		"for field %s which will be ignored.", typeName, field.Predicate)
+	if !strict {
+		glog.Warningf("Type declaration for type %s includes information about the type of "+
+			"field %s which will be ignored, since the type isn't declared with @strict.",
+			typeName, field.Predicate)
+		return &pb.SchemaUpdate{Predicate: field.Predicate}, nil
+	}
 	return field, nil
 }
 
@@ -413,10 +600,76 @@ func getType(typeName string) pb.Posting_ValType {
 	return pb.Posting_OBJECT
 }
 
-// ParsedSchema represents the parsed schema and type updates.
+// ParsedSchema represents the parsed schema and type updates. The schema of the predicates with
+// the @migrate directive is part of their migration instead of Preds.
 type ParsedSchema struct {
-	Preds []*pb.SchemaUpdate
-	Types []*pb.TypeUpdate
+	Preds      []*pb.SchemaUpdate
+	Types      []*pb.TypeUpdate
+	Migrations []*pb.Migration
+	Indexes    []*pb.CompositeIndex
+}
+
+func isIndexDeclaration(item lex.Item, it *lex.ItemIterator) bool {
+	if item.Val != "index" {
+		return false
+	}
+
+	nextItems, err := it.Peek(3)
+	switch {
+	case err != nil || len(nextItems) != 3:
+		return false
+	case nextItems[0].Typ != itemText || nextItems[2].Typ != itemText:
+		return false
+	}
+	return nextItems[1].Typ == itemText && nextItems[1].Val == "on"
+}
+
+// parseIndexDeclaration works on "index name on Type(pred1, pred2, ...)".
+func parseIndexDeclaration(it *lex.ItemIterator) (*pb.CompositeIndex, error) {
+	it.Next()
+	index := &pb.CompositeIndex{Name: it.Item().Val}
+	it.Next()
+	it.Next()
+	index.TypeName = it.Item().Val
+
+	if !it.Next() || it.Item().Typ != itemLeftRound {
+		return nil, it.Item().Errorf("Missing predicates of index %s", index.Name)
+	}
+	seen := make(map[string]struct{})
+	for {
+		if !it.Next() || it.Item().Typ != itemText {
+			return nil, it.Item().Errorf("Expected predicate in index %s. Got %v",
+				index.Name, it.Item().Val)
+		}
+		pred := it.Item().Val
+		if _, ok := seen[pred]; ok {
+			return nil, it.Item().Errorf("Duplicate predicate %s in index %s", pred, index.Name)
+		}
+		seen[pred] = struct{}{}
+		index.Predicates = append(index.Predicates, pred)
+
+		if !it.Next() {
+			return nil, it.Item().Errorf("Unclosed index %s", index.Name)
+		}
+		if it.Item().Typ == itemRightRound {
+			break
+		}
+		if it.Item().Typ != itemComma {
+			return nil, it.Item().Errorf("Expected comma or right round bracket in index %s. "+
+				"Got %v", index.Name, it.Item().Val)
+		}
+	}
+	if len(index.Predicates) < 2 {
+		return nil, it.Item().Errorf("Index %s must have at least two predicates", index.Name)
+	}
+
+	it.Next()
+	if it.Item().Typ != itemNewLine && it.Item().Typ != lex.ItemEOF {
+		return nil, it.Item().Errorf(
+			"Expected new line or EOF after index declaration. Got %v", it.Item())
+	}
+	it.Prev()
+	return index, nil
 }
 
 func isTypeDeclaration(item lex.Item, it *lex.ItemIterator) bool {
@@ -432,7 +685,8 @@ func isTypeDeclaration(item lex.Item, it *lex.ItemIterator) bool {
 	case nextItems[0].Typ != itemText:
 		return false
 
-	case nextItems[1].Typ != itemLeftCurl:
+	case nextItems[1].Typ != itemLeftCurl && nextItems[1].Typ != itemAt:
+		// The type name is followed by its fields, or by a directive like @strict.
 		return false
 	}
 
@@ -454,7 +708,11 @@ func Parse(s string) (*ParsedSchema, error) {
 		item := it.Item()
 		switch item.Typ {
 		case lex.ItemEOF:
-			if err := resolveTokenizers(result.Preds); err != nil {
+			updates := result.Preds
+			for _, migration := range result.Migrations {
+				updates = append(updates, migration.Schema)
+			}
+			if err := resolveTokenizers(updates); err != nil {
 				return nil, errors.Wrapf(err, "failed to enrich schema")
 			}
 			return &result, nil
@@ -468,11 +726,23 @@ func Parse(s string) (*ParsedSchema, error) {
 				result.Types = append(result.Types, typeUpdate)
 				continue
 			}
+			if isIndexDeclaration(item, it) {
+				index, err := parseIndexDeclaration(it)
+				if err != nil {
+					return nil, err
+				}
+				result.Indexes = append(result.Indexes, index)
+				continue
+			}
 
-			schema, err := parseScalarPair(it, item.Val)
+			schema, migration, err := parseScalarPair(it, item.Val)
 			if err != nil {
 				return nil, err
 			}
+			if migration != nil {
+				result.Migrations = append(result.Migrations, migration)
+				continue
+			}
 			result.Preds = append(result.Preds, schema)
 		case itemNewLine:
 			// pass empty line
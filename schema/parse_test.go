@@ -366,6 +366,145 @@ func TestParseUpsert(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestParseTTL(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		session: string @index(exact) @ttl(24h) .
+		token: [uid] @ttl(1h30m) @reverse .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, uint64(24*3600), result.Preds[0].Ttl)
+	require.Equal(t, uint64(5400), result.Preds[1].Ttl)
+	require.Equal(t, pb.SchemaUpdate_REVERSE, result.Preds[1].Directive)
+
+	for _, s := range []string{
+		"session: string @ttl .",
+		"session: string @ttl() .",
+		"session: string @ttl(day) .",
+		"session: string @ttl(10ms) .",
+		"session: string @ttl(1h .",
+	} {
+		_, err := Parse(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestParseMigrate(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		name: string @index(exact) .
+		age: int @index(int) @migrate .
+		fullname: [string] @index(term) @migrate(name) .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result.Preds))
+	require.Equal(t, "name", result.Preds[0].Predicate)
+	require.Equal(t, 2, len(result.Migrations))
+
+	require.Equal(t, "age", result.Migrations[0].From)
+	require.Equal(t, "age", result.Migrations[0].Schema.Predicate)
+	require.Equal(t, pb.Posting_INT, result.Migrations[0].Schema.ValueType)
+	require.Equal(t, []string{"int"}, result.Migrations[0].Schema.Tokenizer)
+
+	require.Equal(t, "name", result.Migrations[1].From)
+	require.Equal(t, "fullname", result.Migrations[1].Schema.Predicate)
+	require.True(t, result.Migrations[1].Schema.List)
+
+	for _, s := range []string{
+		"name: string @migrate() .",
+		"name: string @migrate(age .",
+	} {
+		_, err := Parse(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestParseWhere(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		active: bool .
+		email: string @index(exact) @where(eq(active, true)) .
+		phone: string @where(has(active)) @index(hash) .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(result.Preds))
+	require.Nil(t, result.Preds[0].Where)
+	require.Equal(t, &pb.IndexCondition{FuncName: "eq", Predicate: "active", Value: "true"},
+		result.Preds[1].Where)
+	require.Equal(t, &pb.IndexCondition{FuncName: "has", Predicate: "active"},
+		result.Preds[2].Where)
+	require.Equal(t, "eq(active, true)", IndexConditionString(result.Preds[1].Where))
+
+	for _, s := range []string{
+		"email: string @where(eq(active, true)) .",
+		"email: string @index(exact) @where(eq(email, true)) .",
+		"email: string @index(exact) @where(gt(active, true)) .",
+		"email: string @index(exact) @where(eq(active)) .",
+		"email: string @index(exact) @where(eq(active, true) .",
+		"email: string @index(exact) @where .",
+	} {
+		_, err := Parse(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestParseFacetIndex(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		friend: [uid] @facets(index: since) @reverse .
+		rated: [uid] @count @facets(index: stars, since) .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(result.Preds))
+	require.Equal(t, []string{"since"}, result.Preds[0].FacetIndex)
+	require.Equal(t, pb.SchemaUpdate_REVERSE, result.Preds[0].Directive)
+	require.Equal(t, []string{"stars", "since"}, result.Preds[1].FacetIndex)
+	require.True(t, result.Preds[1].Count)
+
+	for _, s := range []string{
+		"friend: [uid] @facets .",
+		"friend: [uid] @facets(since) .",
+		"friend: [uid] @facets(index since) .",
+		"friend: [uid] @facets(index: ) .",
+		"friend: [uid] @facets(index: since, since) .",
+		"friend: [uid] @facets(index: since .",
+	} {
+		_, err := Parse(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestParseCompositeIndex(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		country: string @index(exact) .
+		status: string .
+		type Person {
+			country
+			status
+		}
+		index country_status on Person(country, status)
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result.Types))
+	require.Equal(t, []*pb.CompositeIndex{{
+		Name:       "country_status",
+		TypeName:   "Person",
+		Predicates: []string{"country", "status"},
+	}}, result.Indexes)
+
+	for _, s := range []string{
+		"index country_status on Person(country)",
+		"index country_status on Person(country, country)",
+		"index country_status on Person(country, status",
+		"index country_status on Person(country, status) .",
+		"index country_status on Person",
+	} {
+		_, err := Parse(s)
+		require.Error(t, err, s)
+	}
+}
+
 func TestParseEmptyType(t *testing.T) {
 	reset()
 	result, err := Parse(`
@@ -530,6 +669,57 @@ func TestOldAndNewTypeFormat(t *testing.T) {
 	}, result.Types[0])
 }
 
+func TestStrictTypeFieldTypes(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		type Person @strict {
+			name: [string!]!
+			address: string!
+			children: [Person]
+			nickname
+		}
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result.Types))
+	require.Equal(t, &pb.TypeUpdate{
+		TypeName: "Person",
+		Fields: []*pb.SchemaUpdate{
+			{
+				Predicate:       "name",
+				ValueType:       pb.Posting_STRING,
+				List:            true,
+				NonNullable:     true,
+				NonNullableList: true,
+			},
+			{
+				Predicate:   "address",
+				ValueType:   pb.Posting_STRING,
+				NonNullable: true,
+			},
+			{
+				Predicate:      "children",
+				ValueType:      pb.Posting_OBJECT,
+				List:           true,
+				ObjectTypeName: "Person",
+			},
+			{
+				Predicate: "nickname",
+			},
+		},
+	}, result.Types[0])
+}
+
+func TestInvalidTypeDirective(t *testing.T) {
+	reset()
+	_, err := Parse(`
+		type Person @unknown {
+			name: string
+		}
+	`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid type directive")
+}
+
 func TestParseTypeErrMissingNewLine(t *testing.T) {
 	reset()
 	_, err := Parse(`
@@ -599,6 +789,40 @@ func TestParseTypeComments(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestLoadBuildingSchema(t *testing.T) {
+	reset()
+	stored := pb.SchemaUpdate{
+		Predicate:          "building",
+		ValueType:          pb.Posting_STRING,
+		Directive:          pb.SchemaUpdate_INDEX,
+		Tokenizer:          []string{"exact", "term"},
+		BuildingTokenizers: []string{"term"},
+		BuildingTs:         10,
+	}
+	data, err := stored.Marshal()
+	require.NoError(t, err)
+	txn := ps.NewTransactionAt(1, true)
+	require.NoError(t, txn.Set(x.SchemaKey("building"), data))
+	require.NoError(t, txn.CommitAt(1, nil))
+
+	require.NoError(t, Load("building"))
+	queried, ok := State().Get("building")
+	require.True(t, ok)
+	require.Equal(t, []string{"exact"}, queried.Tokenizer)
+	require.Equal(t, pb.SchemaUpdate_INDEX, queried.Directive)
+	require.Zero(t, queried.BuildingTs)
+	require.True(t, State().IsIndexing("building"))
+	indexing := State().IndexingSchemas()
+	require.Equal(t, 1, len(indexing))
+	require.Equal(t, []string{"exact", "term"}, indexing[0].Tokenizer)
+	require.Equal(t, []string{"term"}, indexing[0].BuildingTokenizers)
+	require.Equal(t, uint64(10), indexing[0].BuildingTs)
+
+	stored.BuildingTokenizers = []string{"exact", "term"}
+	require.Equal(t, pb.SchemaUpdate_NONE, WithoutBuildingTokenizers(stored).Directive)
+	State().DoneIndexing("building")
+}
+
 var ps *badger.DB
 
 func TestMain(m *testing.M) {
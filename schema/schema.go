@@ -20,7 +20,9 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/dgraph-io/badger/v2"
 	"github.com/golang/glog"
@@ -41,6 +43,7 @@ var (
 
 func (s *state) init() {
 	s.predicate = make(map[string]*pb.SchemaUpdate)
+	s.indexing = make(map[string]*pb.SchemaUpdate)
 	s.types = make(map[string]*pb.TypeUpdate)
 	s.elog = trace.NewEventLog("Dgraph", "Schema")
 }
@@ -49,8 +52,11 @@ type state struct {
 	sync.RWMutex
 	// Map containing predicate to type information.
 	predicate map[string]*pb.SchemaUpdate
-	types     map[string]*pb.TypeUpdate
-	elog      trace.EventLog
+	// Map containing the schema used by the mutations of the predicates whose index is being
+	// built in the background. The queries use the schema in predicate until it's built.
+	indexing map[string]*pb.SchemaUpdate
+	types    map[string]*pb.TypeUpdate
+	elog     trace.EventLog
 }
 
 // State returns the struct holding the current schema.
@@ -66,6 +72,10 @@ func (s *state) DeleteAll() {
 		delete(s.predicate, pred)
 	}
 
+	for pred := range s.indexing {
+		delete(s.indexing, pred)
+	}
+
 	for typ := range s.types {
 		delete(s.types, typ)
 	}
@@ -87,6 +97,7 @@ func (s *state) Delete(attr string) error {
 	}
 
 	delete(s.predicate, attr)
+	delete(s.indexing, attr)
 	return nil
 }
 
@@ -131,6 +142,87 @@ func (s *state) Set(pred string, schema pb.SchemaUpdate) {
 	s.elog.Printf(logUpdate(schema, pred))
 }
 
+// SetIndexing sets the schema used by the mutations of the predicate while its index is being
+// built in the background. The queries keep using the schema set by Set.
+func (s *state) SetIndexing(pred string, schema pb.SchemaUpdate) {
+	s.Lock()
+	defer s.Unlock()
+	s.indexing[pred] = &schema
+	s.elog.Printf("Building index in background. " + logUpdate(schema, pred))
+}
+
+// DoneIndexing makes the mutations of the predicate use the same schema as the queries again.
+func (s *state) DoneIndexing(pred string) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.indexing, pred)
+}
+
+// IsIndexing returns whether the index of the predicate is being built in the background.
+func (s *state) IsIndexing(pred string) bool {
+	s.RLock()
+	defer s.RUnlock()
+	_, ok := s.indexing[pred]
+	return ok
+}
+
+// IndexingSchemas returns the schemas used by the mutations of the predicates whose index is
+// being built in the background.
+func (s *state) IndexingSchemas() []pb.SchemaUpdate {
+	s.RLock()
+	defer s.RUnlock()
+	out := make([]pb.SchemaUpdate, 0, len(s.indexing))
+	for _, schema := range s.indexing {
+		out = append(out, *schema)
+	}
+	return out
+}
+
+// setLoaded sets the schema of the predicate read from the db. A schema stored while the index
+// entries of some of its tokenizers are built in the background is used as is by the mutations,
+// and without these tokenizers by the queries, until the build is done.
+func (s *state) setLoaded(pred string, schema pb.SchemaUpdate) {
+	if len(schema.BuildingTokenizers) == 0 {
+		s.DoneIndexing(pred)
+		s.Set(pred, schema)
+		return
+	}
+	s.SetIndexing(pred, schema)
+	s.Set(pred, WithoutBuildingTokenizers(schema))
+}
+
+// WithoutBuildingTokenizers returns the schema without the tokenizers being built in the
+// background, which is the schema used by the queries until the build is done.
+func WithoutBuildingTokenizers(schema pb.SchemaUpdate) pb.SchemaUpdate {
+	building := make(map[string]struct{}, len(schema.BuildingTokenizers))
+	for _, tokenizer := range schema.BuildingTokenizers {
+		building[tokenizer] = struct{}{}
+	}
+	var tokenizers []string
+	for _, tokenizer := range schema.Tokenizer {
+		if _, ok := building[tokenizer]; !ok {
+			tokenizers = append(tokenizers, tokenizer)
+		}
+	}
+	schema.Tokenizer = tokenizers
+	if len(tokenizers) == 0 && schema.Directive == pb.SchemaUpdate_INDEX {
+		schema.Directive = pb.SchemaUpdate_NONE
+	}
+	schema.BuildingTokenizers = nil
+	schema.BuildingTs = 0
+	return schema
+}
+
+// mutationSchema returns the schema used by the mutations of the predicate. The caller must
+// hold the lock.
+func (s *state) mutationSchema(pred string) (*pb.SchemaUpdate, bool) {
+	if schema, ok := s.indexing[pred]; ok {
+		return schema, true
+	}
+	schema, ok := s.predicate[pred]
+	return schema, ok
+}
+
 // SetType sets the type for the given predicate in memory.
 // schema mutations must flow through the update function, which are synced to the db.
 func (s *state) SetType(typeName string, typ pb.TypeUpdate) {
@@ -182,6 +274,17 @@ func (s *state) IsIndexed(pred string) bool {
 	return false
 }
 
+// IsIndexedForMutation returns whether the mutations of the predicate must add index entries,
+// which is the case once an index starts being built for it.
+func (s *state) IsIndexedForMutation(pred string) bool {
+	s.RLock()
+	defer s.RUnlock()
+	if schema, ok := s.mutationSchema(pred); ok {
+		return len(schema.Tokenizer) > 0
+	}
+	return false
+}
+
 // IndexedFields returns the list of indexed fields
 func (s *state) IndexedFields() []string {
 	s.RLock()
@@ -217,6 +320,45 @@ func (s *state) Types() []string {
 	return out
 }
 
+// NamespacePredicates returns the list of predicates of the namespace ns.
+func (s *state) NamespacePredicates(ns uint64) []string {
+	s.RLock()
+	defer s.RUnlock()
+	var out []string
+	for k := range s.predicate {
+		if x.ParseNamespace(k) == ns {
+			out = append(out, k)
+		}
+	}
+	return out
+}
+
+// CompositeIndexes returns the composite indexes defined by the types of the namespace ns.
+func (s *state) CompositeIndexes(ns uint64) []*pb.CompositeIndex {
+	s.RLock()
+	defer s.RUnlock()
+	var out []*pb.CompositeIndex
+	for k, typ := range s.types {
+		if x.ParseNamespace(k) == ns {
+			out = append(out, typ.Indexes...)
+		}
+	}
+	return out
+}
+
+// NamespaceTypes returns the list of types of the namespace ns.
+func (s *state) NamespaceTypes(ns uint64) []string {
+	s.RLock()
+	defer s.RUnlock()
+	var out []string
+	for k := range s.types {
+		if x.ParseNamespace(k) == ns {
+			out = append(out, k)
+		}
+	}
+	return out
+}
+
 // Tokenizer returns the tokenizer for given predicate
 func (s *state) Tokenizer(pred string) []tok.Tokenizer {
 	s.RLock()
@@ -232,6 +374,22 @@ func (s *state) Tokenizer(pred string) []tok.Tokenizer {
 	return tokenizers
 }
 
+// MutationTokenizer returns the tokenizers the mutations of the predicate must add index
+// entries for, including the ones of an index being built.
+func (s *state) MutationTokenizer(pred string) []tok.Tokenizer {
+	s.RLock()
+	defer s.RUnlock()
+	schema, ok := s.mutationSchema(pred)
+	x.AssertTruef(ok, "schema state not found for %s", pred)
+	var tokenizers []tok.Tokenizer
+	for _, it := range schema.Tokenizer {
+		t, found := tok.GetTokenizer(it)
+		x.AssertTruef(found, "Invalid tokenizer %s", it)
+		tokenizers = append(tokenizers, t)
+	}
+	return tokenizers
+}
+
 // TokenizerNames returns the tokenizer names for given predicate
 func (s *state) TokenizerNames(pred string) []string {
 	var names []string
@@ -292,6 +450,80 @@ func (s *state) HasUpsert(pred string) bool {
 	return false
 }
 
+// IsUnique returns whether the predicate has the @unique directive.
+func (s *state) IsUnique(pred string) bool {
+	s.RLock()
+	defer s.RUnlock()
+	if schema, ok := s.predicate[pred]; ok {
+		return schema.Unique
+	}
+	return false
+}
+
+// TTL returns how long the values of the predicate live, or zero if they don't expire.
+func (s *state) TTL(pred string) time.Duration {
+	s.RLock()
+	defer s.RUnlock()
+	if schema, ok := s.predicate[pred]; ok {
+		return time.Duration(schema.Ttl) * time.Second
+	}
+	return 0
+}
+
+// IndexCondition returns the condition of the partial index of the predicate, or nil if the
+// values of all the nodes are indexed.
+func (s *state) IndexCondition(pred string) *pb.IndexCondition {
+	s.RLock()
+	defer s.RUnlock()
+	if schema, ok := s.predicate[pred]; ok {
+		return schema.Where
+	}
+	return nil
+}
+
+// FacetIndexes returns the keys of the indexed facets of the edges of the predicate.
+func (s *state) FacetIndexes(pred string) []string {
+	s.RLock()
+	defer s.RUnlock()
+	if schema, ok := s.predicate[pred]; ok {
+		return schema.FacetIndex
+	}
+	return nil
+}
+
+// HasFacetIndex returns whether the facet key of the edges of the predicate is indexed.
+func (s *state) HasFacetIndex(pred, key string) bool {
+	for _, k := range s.FacetIndexes(pred) {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// IndexConditionString returns the condition of a partial index the way it's written in the
+// schema, without the namespace of its predicate.
+func IndexConditionString(where *pb.IndexCondition) string {
+	if where.FuncName == "eq" {
+		return fmt.Sprintf("eq(%s, %s)", x.ParseAttr(where.Predicate), where.Value)
+	}
+	return fmt.Sprintf("%s(%s)", where.FuncName, x.ParseAttr(where.Predicate))
+}
+
+// PartialIndexesOn returns the predicates whose partial index has a condition on pred.
+func (s *state) PartialIndexesOn(pred string) []string {
+	s.RLock()
+	defer s.RUnlock()
+	var out []string
+	for k, schema := range s.predicate {
+		if schema.Where != nil && schema.Where.Predicate == pred {
+			out = append(out, k)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
 func (s *state) HasLang(pred string) bool {
 	s.RLock()
 	defer s.RUnlock()
@@ -330,7 +562,7 @@ func Load(predicate string) error {
 	if err != nil {
 		return err
 	}
-	State().Set(predicate, s)
+	State().setLoaded(predicate, s)
 	State().elog.Printf(logUpdate(s, predicate))
 	glog.Infoln(logUpdate(s, predicate))
 	return nil
@@ -370,7 +602,7 @@ func LoadSchemaFromDb() error {
 				s = pb.SchemaUpdate{Predicate: attr, ValueType: pb.Posting_DEFAULT}
 			}
 			x.Checkf(s.Unmarshal(val), "Error while loading schema from db")
-			State().Set(attr, s)
+			State().setLoaded(attr, s)
 			return nil
 		})
 		if err != nil {
@@ -432,6 +664,16 @@ func CompleteInitialSchema() []*pb.SchemaUpdate {
 	return initialSchemaInternal(true)
 }
 
+// NamespaceInitialSchema returns the schema updates of the reserved predicates of the
+// namespace ns. Namespaces rely on the ACL feature, so the ACL predicates are always included.
+func NamespaceInitialSchema(ns uint64) []*pb.SchemaUpdate {
+	initialSchema := CompleteInitialSchema()
+	for _, update := range initialSchema {
+		update.Predicate = x.NamespaceAttr(ns, update.Predicate)
+	}
+	return initialSchema
+}
+
 func initialSchemaInternal(all bool) []*pb.SchemaUpdate {
 	var initialSchema []*pb.SchemaUpdate
 
@@ -480,7 +722,7 @@ func IsReservedPredicateChanged(pred string, update *pb.SchemaUpdate) bool {
 		return false
 	}
 
-	initialSchema := CompleteInitialSchema()
+	initialSchema := NamespaceInitialSchema(x.ParseNamespace(pred))
 	for _, original := range initialSchema {
 		if original.Predicate != pred {
 			continue
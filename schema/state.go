@@ -46,6 +46,9 @@ Loop:
 		case isNameBegin(r):
 			l.Backup()
 			return lexWord
+		case r >= '0' && r <= '9':
+			// Directive arguments such as durations can start with a digit.
+			return lexWord
 		case isSpace(r):
 			l.Ignore()
 		case lex.IsEndOfLine(r):
@@ -18,10 +18,13 @@ package tok
 
 import (
 	"encoding/binary"
+	"math/big"
 	"plugin"
+	"strings"
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/google/uuid"
 	geom "github.com/twpayne/go-geom"
 	"golang.org/x/crypto/blake2b"
 
@@ -49,6 +52,12 @@ const (
 	IdentBool     = 0x9
 	IdentTrigram  = 0xA
 	IdentHash     = 0xB
+	IdentBigInt   = 0xC
+	IdentDecimal  = 0xD
+	IdentDuration = 0xE
+	IdentUUID     = 0xF
+	IdentHNSW     = 0x10
+	IdentFacet    = 0x11
 	IdentCustom   = 0x80
 )
 
@@ -85,6 +94,11 @@ func init() {
 	registerTokenizer(GeoTokenizer{})
 	registerTokenizer(IntTokenizer{})
 	registerTokenizer(FloatTokenizer{})
+	registerTokenizer(BigIntTokenizer{})
+	registerTokenizer(DecimalTokenizer{})
+	registerTokenizer(DurationTokenizer{})
+	registerTokenizer(UUIDTokenizer{})
+	registerTokenizer(HNSWTokenizer{})
 	registerTokenizer(YearTokenizer{})
 	registerTokenizer(HourTokenizer{})
 	registerTokenizer(MonthTokenizer{})
@@ -205,6 +219,70 @@ func (t FloatTokenizer) Identifier() byte { return IdentFloat }
 func (t FloatTokenizer) IsSortable() bool { return true }
 func (t FloatTokenizer) IsLossy() bool    { return true }
 
+// BigIntTokenizer generates tokens from arbitrary-precision integer data.
+type BigIntTokenizer struct{}
+
+func (t BigIntTokenizer) Name() string { return "bigint" }
+func (t BigIntTokenizer) Type() string { return "bigint" }
+func (t BigIntTokenizer) Tokens(v interface{}) ([]string, error) {
+	return []string{encodeDecimal(v.(*big.Int).String())}, nil
+}
+func (t BigIntTokenizer) Identifier() byte { return IdentBigInt }
+func (t BigIntTokenizer) IsSortable() bool { return true }
+func (t BigIntTokenizer) IsLossy() bool    { return false }
+
+// DecimalTokenizer generates tokens from arbitrary-precision decimal data.
+type DecimalTokenizer struct{}
+
+func (t DecimalTokenizer) Name() string { return "decimal" }
+func (t DecimalTokenizer) Type() string { return "decimal" }
+func (t DecimalTokenizer) Tokens(v interface{}) ([]string, error) {
+	return []string{encodeDecimal(types.DecimalString(v.(*big.Rat)))}, nil
+}
+func (t DecimalTokenizer) Identifier() byte { return IdentDecimal }
+func (t DecimalTokenizer) IsSortable() bool { return true }
+func (t DecimalTokenizer) IsLossy() bool    { return false }
+
+// DurationTokenizer generates tokens from duration data.
+type DurationTokenizer struct{}
+
+func (t DurationTokenizer) Name() string { return "duration" }
+func (t DurationTokenizer) Type() string { return "duration" }
+func (t DurationTokenizer) Tokens(v interface{}) ([]string, error) {
+	return []string{encodeInt(int64(v.(time.Duration)))}, nil
+}
+func (t DurationTokenizer) Identifier() byte { return IdentDuration }
+func (t DurationTokenizer) IsSortable() bool { return true }
+func (t DurationTokenizer) IsLossy() bool    { return false }
+
+// UUIDTokenizer generates tokens from UUID data. The token is the UUID itself, like the exact
+// tokenizer for strings. The bytes of UUIDs sort in the same order as their string forms.
+type UUIDTokenizer struct{}
+
+func (t UUIDTokenizer) Name() string { return "uuid" }
+func (t UUIDTokenizer) Type() string { return "uuid" }
+func (t UUIDTokenizer) Tokens(v interface{}) ([]string, error) {
+	u := v.(uuid.UUID)
+	return []string{string(u[:])}, nil
+}
+func (t UUIDTokenizer) Identifier() byte { return IdentUUID }
+func (t UUIDTokenizer) IsSortable() bool { return true }
+func (t UUIDTokenizer) IsLossy() bool    { return false }
+
+// HNSWTokenizer marks the vectors to index in the approximate nearest-neighbour index of the
+// predicate. It has no tokens, the index is a graph of the closest vectors maintained by the
+// posting package.
+type HNSWTokenizer struct{}
+
+func (t HNSWTokenizer) Name() string { return "hnsw" }
+func (t HNSWTokenizer) Type() string { return "float32vector" }
+func (t HNSWTokenizer) Tokens(v interface{}) ([]string, error) {
+	return nil, nil
+}
+func (t HNSWTokenizer) Identifier() byte { return IdentHNSW }
+func (t HNSWTokenizer) IsSortable() bool { return false }
+func (t HNSWTokenizer) IsLossy() bool    { return true }
+
 // YearTokenizer generates year tokens from datetime data.
 type YearTokenizer struct{}
 
@@ -426,6 +504,44 @@ func encodeInt(val int64) string {
 	return string(buf)
 }
 
+// encodeDecimal encodes a number written in base 10, like -12.5, so the encoded numbers sort in
+// the same order as the numbers. The number is 0.d1d2d3... * 10^exp with d1 != 0, and it's
+// encoded as a sign byte followed by exp and the digits d1d2d3... For negative numbers, the bytes
+// after the sign byte are inverted and terminated by 0xff, so larger magnitudes sort first.
+func encodeDecimal(num string) string {
+	neg := strings.HasPrefix(num, "-")
+	num = strings.TrimPrefix(num, "-")
+	intPart, frac := num, ""
+	if i := strings.IndexByte(num, '.'); i >= 0 {
+		intPart, frac = num[:i], num[i+1:]
+	}
+	digits := strings.TrimLeft(intPart, "0")
+	exp := len(digits)
+	if digits == "" {
+		// The leading zeros of the fraction lower the exponent of numbers smaller than 1.
+		digits = strings.TrimLeft(frac, "0")
+		exp = len(digits) - len(frac)
+	} else {
+		digits += frac
+	}
+	digits = strings.TrimRight(digits, "0")
+	if digits == "" {
+		return "\x01"
+	}
+
+	buf := make([]byte, 4, 5+len(digits))
+	// Flip the sign bit so negative exponents sort first.
+	binary.BigEndian.PutUint32(buf, uint32(int32(exp))^(1<<31))
+	buf = append(buf, digits...)
+	if !neg {
+		return "\x02" + string(buf)
+	}
+	for i := range buf {
+		buf[i] = ^buf[i]
+	}
+	return "\x00" + string(append(buf, 0xff))
+}
+
 func encodeToken(tok string, typ byte) string {
 	return string(typ) + tok
 }
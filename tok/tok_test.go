@@ -18,10 +18,12 @@ package tok
 
 import (
 	"math"
+	"math/big"
 	"sort"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
 
@@ -61,6 +63,75 @@ func TestIntEncoding(t *testing.T) {
 	}
 }
 
+func TestDecimalEncoding(t *testing.T) {
+	// The numbers are in ascending order.
+	nums := []string{"-1000000000000000000000", "-123.45", "-123.4", "-12", "-1", "-0.5",
+		"-0.05", "0", "0.000001", "0.01", "0.1", "0.12", "1", "1.5", "9", "10", "12.5", "100",
+		"123456789012345678901234567890"}
+	for i := 1; i < len(nums); i++ {
+		prev, cur := encodeDecimal(nums[i-1]), encodeDecimal(nums[i])
+		require.True(t, prev < cur, "%s %v vs %s %v", nums[i-1], []byte(prev), nums[i],
+			[]byte(cur))
+	}
+	// The encoding doesn't depend on the zeros without meaning.
+	require.Equal(t, encodeDecimal("12.5"), encodeDecimal("012.500"))
+	require.Equal(t, encodeDecimal("0"), encodeDecimal("0.000"))
+}
+
+func TestBigIntAndDecimalTokenizers(t *testing.T) {
+	tokenizer, has := GetTokenizer("bigint")
+	require.True(t, has)
+	require.True(t, tokenizer.IsSortable())
+	i, ok := new(big.Int).SetString("-98765432109876543210", 10)
+	require.True(t, ok)
+	tokens, err := BuildTokens(i, tokenizer)
+	require.NoError(t, err)
+	require.Equal(t, []string{encodeToken(encodeDecimal("-98765432109876543210"),
+		IdentBigInt)}, tokens)
+
+	tokenizer, has = GetTokenizer("decimal")
+	require.True(t, has)
+	require.False(t, tokenizer.IsLossy())
+	tokens, err = BuildTokens(big.NewRat(5, 4), tokenizer)
+	require.NoError(t, err)
+	require.Equal(t, []string{encodeToken(encodeDecimal("1.25"), IdentDecimal)}, tokens)
+}
+
+func TestDurationAndUUIDTokenizers(t *testing.T) {
+	tokenizer, has := GetTokenizer("duration")
+	require.True(t, has)
+	require.True(t, tokenizer.IsSortable())
+	var prev string
+	for _, d := range []time.Duration{-time.Hour, -time.Nanosecond, 0, time.Second, time.Hour} {
+		tokens, err := BuildTokens(d, tokenizer)
+		require.NoError(t, err)
+		require.Len(t, tokens, 1)
+		require.True(t, prev < tokens[0], "%v", d)
+		prev = tokens[0]
+	}
+
+	tokenizer, has = GetTokenizer("uuid")
+	require.True(t, has)
+	require.False(t, tokenizer.IsLossy())
+	u, err := uuid.Parse("123e4567-e89b-12d3-a456-426655440000")
+	require.NoError(t, err)
+	tokens, err := BuildTokens(u, tokenizer)
+	require.NoError(t, err)
+	require.Equal(t, []string{encodeToken(string(u[:]), IdentUUID)}, tokens)
+}
+
+func TestHNSWTokenizer(t *testing.T) {
+	tokenizer, has := GetTokenizer("hnsw")
+	require.True(t, has)
+	require.Equal(t, "float32vector", tokenizer.Type())
+	require.Equal(t, byte(IdentHNSW), tokenizer.Identifier())
+	require.False(t, tokenizer.IsSortable())
+	// The nodes are linked to the graph of the index instead.
+	tokens, err := BuildTokens([]float32{1, 2}, tokenizer)
+	require.NoError(t, err)
+	require.Empty(t, tokens)
+}
+
 func TestFullTextTokenizer(t *testing.T) {
 	tokenizer, has := GetTokenizer("fulltext")
 	require.True(t, has)
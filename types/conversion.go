@@ -21,9 +21,11 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"math"
+	"math/big"
 	"strconv"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	geom "github.com/twpayne/go-geom"
 	"github.com/twpayne/go-geom/encoding/geojson"
@@ -89,6 +91,36 @@ func Convert(from Val, toID TypeID) (Val, error) {
 				*res = w
 			case PasswordID:
 				*res = string(data)
+			case BigIntID:
+				// Bigints and decimals are stored in their decimal form.
+				i, err := ParseBigInt(string(data))
+				if err != nil {
+					return to, err
+				}
+				*res = i
+			case DecimalID:
+				r, err := ParseDecimal(string(data))
+				if err != nil {
+					return to, err
+				}
+				*res = r
+			case DurationID:
+				if len(data) < 8 {
+					return to, errors.Errorf("Invalid data for duration %v", data)
+				}
+				*res = time.Duration(binary.LittleEndian.Uint64(data))
+			case UUIDID:
+				u, err := uuid.FromBytes(data)
+				if err != nil {
+					return to, errors.Wrapf(err, "Invalid data for uuid %v", data)
+				}
+				*res = u
+			case VFloatID:
+				v, err := BytesToVector(data)
+				if err != nil {
+					return to, err
+				}
+				*res = v
 			default:
 				return to, cantConvert(fromID, toID)
 			}
@@ -142,6 +174,36 @@ func Convert(from Val, toID TypeID) (Val, error) {
 					return to, err
 				}
 				*res = p
+			case BigIntID:
+				i, err := ParseBigInt(vc)
+				if err != nil {
+					return to, err
+				}
+				*res = i
+			case DecimalID:
+				r, err := ParseDecimal(vc)
+				if err != nil {
+					return to, err
+				}
+				*res = r
+			case DurationID:
+				d, err := ParseDuration(vc)
+				if err != nil {
+					return to, err
+				}
+				*res = d
+			case UUIDID:
+				u, err := ParseUUID(vc)
+				if err != nil {
+					return to, err
+				}
+				*res = u
+			case VFloatID:
+				v, err := ParseVector(vc)
+				if err != nil {
+					return to, err
+				}
+				*res = v
 			default:
 				return to, cantConvert(fromID, toID)
 			}
@@ -167,6 +229,16 @@ func Convert(from Val, toID TypeID) (Val, error) {
 				*res = strconv.FormatInt(vc, 10)
 			case DateTimeID:
 				*res = time.Unix(vc, 0).UTC()
+			case BigIntID:
+				*res = big.NewInt(vc)
+			case DecimalID:
+				*res = new(big.Rat).SetInt64(vc)
+			case DurationID:
+				// Like datetimes, ints are read as a number of seconds.
+				if vc > math.MaxInt64/nanoSecondsInSec || vc < math.MinInt64/nanoSecondsInSec {
+					return to, errors.Errorf("Int out of duration range")
+				}
+				*res = time.Duration(vc) * time.Second
 			default:
 				return to, cantConvert(fromID, toID)
 			}
@@ -200,6 +272,25 @@ func Convert(from Val, toID TypeID) (Val, error) {
 				fracSecs := vc - float64(secs)
 				nsecs := int64(fracSecs * nanoSecondsInSec)
 				*res = time.Unix(secs, nsecs).UTC()
+			case BigIntID:
+				if math.IsNaN(vc) || math.IsInf(vc, 0) {
+					return to, errors.Errorf("Float out of bigint range")
+				}
+				*res, _ = big.NewFloat(vc).Int(nil)
+			case DecimalID:
+				// Use the shortest decimal form of the float, e.g. 0.1 instead of the exact value
+				// of the float closest to 0.1.
+				r, err := ParseDecimal(strconv.FormatFloat(vc, 'f', -1, 64))
+				if err != nil {
+					return to, err
+				}
+				*res = r
+			case DurationID:
+				nsecs := vc * nanoSecondsInSec
+				if nsecs > math.MaxInt64 || nsecs < math.MinInt64 || math.IsNaN(nsecs) {
+					return to, errors.Errorf("Float out of duration range")
+				}
+				*res = time.Duration(nsecs)
 			default:
 				return to, cantConvert(fromID, toID)
 			}
@@ -302,6 +393,123 @@ func Convert(from Val, toID TypeID) (Val, error) {
 				return to, cantConvert(fromID, toID)
 			}
 		}
+	case BigIntID:
+		{
+			vc, err := ParseBigInt(string(data))
+			if err != nil {
+				return to, err
+			}
+			switch toID {
+			case BigIntID:
+				*res = vc
+			case BinaryID:
+				*res = []byte(vc.String())
+			case StringID, DefaultID:
+				*res = vc.String()
+			case IntID:
+				if !vc.IsInt64() {
+					return to, errors.Errorf("Bigint out of int64 range")
+				}
+				*res = vc.Int64()
+			case FloatID:
+				*res, _ = new(big.Float).SetInt(vc).Float64()
+			case DecimalID:
+				*res = new(big.Rat).SetInt(vc)
+			case BoolID:
+				*res = vc.Sign() != 0
+			default:
+				return to, cantConvert(fromID, toID)
+			}
+		}
+	case DecimalID:
+		{
+			vc, err := ParseDecimal(string(data))
+			if err != nil {
+				return to, err
+			}
+			switch toID {
+			case DecimalID:
+				*res = vc
+			case BinaryID:
+				*res = []byte(DecimalString(vc))
+			case StringID, DefaultID:
+				*res = DecimalString(vc)
+			case FloatID:
+				*res, _ = vc.Float64()
+			case IntID:
+				// Like floats, decimals are truncated towards zero.
+				i := new(big.Int).Quo(vc.Num(), vc.Denom())
+				if !i.IsInt64() {
+					return to, errors.Errorf("Decimal out of int64 range")
+				}
+				*res = i.Int64()
+			case BigIntID:
+				*res = new(big.Int).Quo(vc.Num(), vc.Denom())
+			case BoolID:
+				*res = vc.Sign() != 0
+			default:
+				return to, cantConvert(fromID, toID)
+			}
+		}
+	case DurationID:
+		{
+			if len(data) < 8 {
+				return to, errors.Errorf("Invalid data for duration %v", data)
+			}
+			vc := time.Duration(binary.LittleEndian.Uint64(data))
+			switch toID {
+			case DurationID:
+				*res = vc
+			case BinaryID:
+				var bs [8]byte
+				binary.LittleEndian.PutUint64(bs[:], uint64(vc))
+				*res = bs[:]
+			case StringID, DefaultID:
+				*res = FormatDuration(vc)
+			case IntID:
+				*res = int64(vc / time.Second)
+			case FloatID:
+				*res = vc.Seconds()
+			case BoolID:
+				*res = vc != 0
+			default:
+				return to, cantConvert(fromID, toID)
+			}
+		}
+	case UUIDID:
+		{
+			vc, err := uuid.FromBytes(data)
+			if err != nil {
+				return to, errors.Wrapf(err, "Invalid data for uuid %v", data)
+			}
+			switch toID {
+			case UUIDID:
+				*res = vc
+			case BinaryID:
+				*res = vc[:]
+			case StringID, DefaultID:
+				*res = vc.String()
+			default:
+				return to, cantConvert(fromID, toID)
+			}
+		}
+	case VFloatID:
+		{
+			vc, err := BytesToVector(data)
+			if err != nil {
+				return to, err
+			}
+			switch toID {
+			case VFloatID:
+				*res = vc
+			case BinaryID:
+				*res = VectorToBytes(vc)
+			case StringID, DefaultID:
+				*res = FormatVector(vc)
+			default:
+				return to, cantConvert(fromID, toID)
+			}
+		}
 	default:
 		return to, cantConvert(fromID, toID)
 	}
@@ -433,6 +641,60 @@ func Marshal(from Val, to *Val) error {
 		default:
 			return cantConvert(fromID, toID)
 		}
+	case BigIntID:
+		vc := val.(*big.Int)
+		switch toID {
+		case StringID, DefaultID:
+			*res = vc.String()
+		case BinaryID:
+			*res = []byte(vc.String())
+		default:
+			return cantConvert(fromID, toID)
+		}
+	case DecimalID:
+		vc := val.(*big.Rat)
+		switch toID {
+		case StringID, DefaultID:
+			*res = DecimalString(vc)
+		case BinaryID:
+			*res = []byte(DecimalString(vc))
+		default:
+			return cantConvert(fromID, toID)
+		}
+	case DurationID:
+		vc := val.(time.Duration)
+		switch toID {
+		case StringID, DefaultID:
+			*res = FormatDuration(vc)
+		case BinaryID:
+			var bs [8]byte
+			binary.LittleEndian.PutUint64(bs[:], uint64(vc))
+			*res = bs[:]
+		default:
+			return cantConvert(fromID, toID)
+		}
+	case UUIDID:
+		vc := val.(uuid.UUID)
+		switch toID {
+		case StringID, DefaultID:
+			*res = vc.String()
+		case BinaryID:
+			b := make([]byte, len(vc))
+			copy(b, vc[:])
+			*res = b
+		default:
+			return cantConvert(fromID, toID)
+		}
+	case VFloatID:
+		vc := val.([]float32)
+		switch toID {
+		case StringID, DefaultID:
+			*res = FormatVector(vc)
+		case BinaryID:
+			*res = VectorToBytes(vc)
+		default:
+			return cantConvert(fromID, toID)
+		}
 	default:
 		return cantConvert(fromID, toID)
 	}
@@ -501,6 +763,14 @@ func ObjectValue(id TypeID, value interface{}) (*api.Value, error) {
 			return def, errors.Errorf("Expected value of type password. Got : %v", value)
 		}
 		return &api.Value{Val: &api.Value_PasswordVal{PasswordVal: v}}, nil
+	// api.Value has no bigint, decimal, duration, uuid and vector values, so they are sent in
+	// their string form and converted to the type of the predicate.
+	case BigIntID, DecimalID, DurationID, UUIDID, VFloatID:
+		str := ValueForType(StringID)
+		if err := Marshal(Val{id, value}, &str); err != nil {
+			return def, err
+		}
+		return &api.Value{Val: &api.Value_StrVal{StrVal: str.Value.(string)}}, nil
 	default:
 		return def, errors.Errorf("ObjectValue not available for: %v", id)
 	}
@@ -535,6 +805,17 @@ func (v Val) MarshalJSON() ([]byte, error) {
 		return json.Marshal(v.Safe().(string))
 	case PasswordID:
 		return json.Marshal(v.Value.(string))
+	case BigIntID:
+		// Written as strings, so clients don't lose precision parsing them as doubles.
+		return json.Marshal(v.Value.(*big.Int).String())
+	case DecimalID:
+		return json.Marshal(DecimalString(v.Value.(*big.Rat)))
+	case DurationID:
+		return json.Marshal(FormatDuration(v.Value.(time.Duration)))
+	case UUIDID:
+		return json.Marshal(v.Value.(uuid.UUID).String())
+	case VFloatID:
+		return json.Marshal(v.Value.([]float32))
 	}
 	return nil, errors.Errorf("Invalid type for MarshalJSON: %v", v.Tid)
 }
@@ -19,9 +19,12 @@ package types
 import (
 	"encoding/binary"
 	"math"
+	"math/big"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
 
@@ -849,3 +852,196 @@ func TestConvertToString(t *testing.T) {
 		require.EqualValues(t, Val{Tid: StringID, Value: tc.out}, out)
 	}
 }
+
+func TestConvertBigInt(t *testing.T) {
+	huge := "123456789012345678901234567890"
+	out, err := Convert(Val{Tid: StringID, Value: []byte(huge)}, BigIntID)
+	require.NoError(t, err)
+	require.Equal(t, huge, out.Value.(*big.Int).String())
+
+	b := ValueForType(BinaryID)
+	require.NoError(t, Marshal(out, &b))
+	tests := []struct {
+		to  TypeID
+		out interface{}
+	}{
+		{to: StringID, out: huge},
+		{to: DecimalID, out: "123456789012345678901234567890"},
+		{to: FloatID, out: 1.2345678901234568e+29},
+		{to: BoolID, out: true},
+	}
+	for _, tc := range tests {
+		out, err := Convert(Val{Tid: BigIntID, Value: b.Value}, tc.to)
+		require.NoError(t, err)
+		if tc.to == DecimalID {
+			require.Equal(t, tc.out, DecimalString(out.Value.(*big.Rat)))
+			continue
+		}
+		require.Equal(t, tc.out, out.Value)
+	}
+
+	_, err = Convert(Val{Tid: BigIntID, Value: b.Value}, IntID)
+	require.Error(t, err)
+	out, err = Convert(Val{Tid: BigIntID, Value: []byte("-42")}, IntID)
+	require.NoError(t, err)
+	require.Equal(t, int64(-42), out.Value)
+
+	_, err = Convert(Val{Tid: StringID, Value: []byte("1.5")}, BigIntID)
+	require.Error(t, err)
+}
+
+func TestConvertDecimal(t *testing.T) {
+	tests := []struct {
+		in  Val
+		out string
+	}{
+		{in: Val{Tid: StringID, Value: []byte("12.50")}, out: "12.5"},
+		{in: Val{Tid: StringID, Value: []byte("-0.000000000000000000001")},
+			out: "-0.000000000000000000001"},
+		{in: Val{Tid: StringID, Value: []byte("1.5e3")}, out: "1500"},
+		{in: Val{Tid: FloatID, Value: bs(0.1)}, out: "0.1"},
+		{in: Val{Tid: IntID, Value: bs(int64(-7))}, out: "-7"},
+		{in: Val{Tid: BinaryID, Value: []byte("99999999999999999999.99")},
+			out: "99999999999999999999.99"},
+	}
+	for _, tc := range tests {
+		out, err := Convert(tc.in, DecimalID)
+		require.NoError(t, err)
+		str := ValueForType(StringID)
+		require.NoError(t, Marshal(out, &str))
+		require.Equal(t, tc.out, str.Value)
+	}
+
+	out, err := Convert(Val{Tid: DecimalID, Value: []byte("-12.75")}, IntID)
+	require.NoError(t, err)
+	require.Equal(t, int64(-12), out.Value)
+	out, err = Convert(Val{Tid: DecimalID, Value: []byte("-12.75")}, FloatID)
+	require.NoError(t, err)
+	require.Equal(t, -12.75, out.Value)
+
+	for _, in := range []string{"1/3", "abc", ""} {
+		_, err := Convert(Val{Tid: StringID, Value: []byte(in)}, DecimalID)
+		require.Error(t, err, in)
+	}
+
+	// Decimals without an exact decimal form are rounded.
+	require.Equal(t, "0.3333333333333333333333333333333333", DecimalString(big.NewRat(1, 3)))
+	require.Equal(t, "0.125", DecimalString(big.NewRat(1, 8)))
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in  string
+		out time.Duration
+	}{
+		{in: "PT1H30M", out: 90 * time.Minute},
+		{in: "P1DT2H", out: 26 * time.Hour},
+		{in: "P2W", out: 14 * 24 * time.Hour},
+		{in: "PT0.5S", out: 500 * time.Millisecond},
+		{in: "PT1,25M", out: 75 * time.Second},
+		{in: "-PT10S", out: -10 * time.Second},
+		{in: "PT0.000000001S", out: time.Nanosecond},
+		{in: "1h30m", out: 90 * time.Minute},
+		{in: "-1.5s", out: -1500 * time.Millisecond},
+	}
+	for _, tc := range tests {
+		d, err := ParseDuration(tc.in)
+		require.NoError(t, err, tc.in)
+		require.Equal(t, tc.out, d, tc.in)
+	}
+
+	for _, in := range []string{"", "P", "PT", "P1DT", "P1Y", "P1M", "PT1D", "P1H", "PT1S2M",
+		"--PT1S", "1 hour", "P1DT1H1M1S1S"} {
+		_, err := ParseDuration(in)
+		require.Error(t, err, in)
+	}
+}
+
+func TestConvertDuration(t *testing.T) {
+	out, err := Convert(Val{Tid: StringID, Value: []byte("P1DT2H0.25S")}, DurationID)
+	require.NoError(t, err)
+	require.Equal(t, 26*time.Hour+250*time.Millisecond, out.Value)
+
+	b := ValueForType(BinaryID)
+	require.NoError(t, Marshal(out, &b))
+	tests := []struct {
+		to  TypeID
+		out interface{}
+	}{
+		{to: StringID, out: "PT26H0.25S"},
+		{to: IntID, out: int64(93600)},
+		{to: FloatID, out: 93600.25},
+		{to: BoolID, out: true},
+		{to: DurationID, out: 26*time.Hour + 250*time.Millisecond},
+	}
+	for _, tc := range tests {
+		out, err := Convert(Val{Tid: DurationID, Value: b.Value}, tc.to)
+		require.NoError(t, err)
+		require.Equal(t, tc.out, out.Value)
+	}
+
+	out, err = Convert(Val{Tid: IntID, Value: []byte{60, 0, 0, 0, 0, 0, 0, 0}}, DurationID)
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, out.Value)
+
+	for d, str := range map[time.Duration]string{
+		0:                            "PT0S",
+		-90 * time.Second:            "-PT1M30S",
+		time.Millisecond:             "PT0.001S",
+		time.Duration(math.MinInt64): "-PT2562047H47M16.854775808S",
+	} {
+		require.Equal(t, str, FormatDuration(d))
+		parsed, err := ParseDuration(str)
+		require.NoError(t, err)
+		require.Equal(t, d, parsed)
+	}
+}
+
+func TestConvertUUID(t *testing.T) {
+	str := "123e4567-e89b-12d3-a456-426655440000"
+	out, err := Convert(Val{Tid: StringID, Value: []byte(strings.ToUpper(str))}, UUIDID)
+	require.NoError(t, err)
+	require.Equal(t, str, out.Value.(uuid.UUID).String())
+
+	b := ValueForType(BinaryID)
+	require.NoError(t, Marshal(out, &b))
+	require.Len(t, b.Value, 16)
+	out, err = Convert(Val{Tid: UUIDID, Value: b.Value}, StringID)
+	require.NoError(t, err)
+	require.Equal(t, str, out.Value)
+
+	u, err := uuid.Parse(str)
+	require.NoError(t, err)
+	js, err := Val{Tid: UUIDID, Value: u}.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, `"`+str+`"`, string(js))
+
+	_, err = Convert(Val{Tid: StringID, Value: []byte("123e4567")}, UUIDID)
+	require.Error(t, err)
+	_, err = Convert(Val{Tid: UUIDID, Value: b.Value}, IntID)
+	require.Error(t, err)
+}
+
+func TestConvertVector(t *testing.T) {
+	out, err := Convert(Val{Tid: StringID, Value: []byte("[0.5, -1, 2e3]")}, VFloatID)
+	require.NoError(t, err)
+	require.Equal(t, []float32{0.5, -1, 2000}, out.Value)
+
+	b := ValueForType(BinaryID)
+	require.NoError(t, Marshal(out, &b))
+	require.Len(t, b.Value, 12)
+	out, err = Convert(Val{Tid: VFloatID, Value: b.Value}, StringID)
+	require.NoError(t, err)
+	require.Equal(t, "[0.5, -1, 2000]", out.Value)
+
+	js, err := Val{Tid: VFloatID, Value: []float32{0.25, 3}}.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, "[0.25,3]", string(js))
+
+	for _, s := range []string{"[]", "[1, \"a\"]", "1"} {
+		_, err = Convert(Val{Tid: StringID, Value: []byte(s)}, VFloatID)
+		require.Error(t, err, s)
+	}
+	_, err = Convert(Val{Tid: VFloatID, Value: []byte{1, 2, 3}}, StringID)
+	require.Error(t, err)
+}
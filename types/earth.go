@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/golang/geo/s1"
+	geom "github.com/twpayne/go-geom"
 )
 
 // Helper functions for earth distances
@@ -40,6 +41,11 @@ func EarthAngle(dist float64) s1.Angle {
 	return s1.Angle(dist / EarthRadiusMeters)
 }
 
+// PointDistance returns the great-circle distance on earth between two points.
+func PointDistance(a, b *geom.Point) Length {
+	return EarthDistance(pointFromPoint(a).Distance(pointFromPoint(b)))
+}
+
 // Area denotes an area on Earth
 type Area float64
 
@@ -0,0 +1,114 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package facets
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+
+	"github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/pkg/errors"
+)
+
+// IndexTypes are the types of the values of the facets, which are indexed separately.
+var IndexTypes = []types.TypeID{types.IntID, types.FloatID, types.BoolID, types.DateTimeID,
+	types.StringID}
+
+// IndexKeyPrefix returns the prefix of the index tokens of the facet key. The index tokens of a
+// facet are tok.IdentFacet, the key, a zero byte, the type of the value and the value, encoded so
+// that the tokens of the values of a type sort like the values.
+func IndexKeyPrefix(key string) string {
+	return string([]byte{tok.IdentFacet}) + key + "\x00"
+}
+
+// IndexTokenPrefix returns the prefix of the index tokens of the facet key for the values of
+// type typ.
+func IndexTokenPrefix(key string, typ types.TypeID) string {
+	return IndexKeyPrefix(key) + string([]byte{byte(typ)})
+}
+
+// IndexToken returns the index token of the value of the facet key. The value must have one of
+// the IndexTypes.
+func IndexToken(key string, val types.Val) (string, error) {
+	var buf []byte
+	switch val.Tid {
+	case types.IntID:
+		buf = make([]byte, 8)
+		binary.BigEndian.PutUint64(buf, uint64(val.Value.(int64))^(1<<63))
+	case types.FloatID:
+		f := val.Value.(float64)
+		if f == 0 {
+			// -0 and 0 are equal.
+			f = 0
+		}
+		bits := math.Float64bits(f)
+		if bits&(1<<63) == 0 {
+			bits ^= 1 << 63
+		} else {
+			bits = ^bits
+		}
+		buf = make([]byte, 8)
+		binary.BigEndian.PutUint64(buf, bits)
+	case types.BoolID:
+		buf = []byte{0}
+		if val.Value.(bool) {
+			buf[0] = 1
+		}
+	case types.DateTimeID:
+		t := val.Value.(time.Time)
+		buf = make([]byte, 12)
+		binary.BigEndian.PutUint64(buf, uint64(t.Unix())^(1<<63))
+		binary.BigEndian.PutUint32(buf[8:], uint32(t.Nanosecond()))
+	case types.StringID:
+		buf = []byte(val.Value.(string))
+	default:
+		return "", errors.Errorf("Cannot index facet %s of type %s", key, val.Tid.Name())
+	}
+	return IndexTokenPrefix(key, val.Tid) + string(buf), nil
+}
+
+// IndexTokens returns the index tokens of the facets with one of the keys.
+func IndexTokens(fs []*api.Facet, keys []string) ([]string, error) {
+	var tokens []string
+	for _, f := range fs {
+		if !indexedKey(f.Key, keys) {
+			continue
+		}
+		val, err := ValFor(f)
+		if err != nil {
+			return nil, err
+		}
+		token, err := IndexToken(f.Key, val)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
+func indexedKey(key string, keys []string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package facets
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/types"
+)
+
+func TestIndexTokenOrder(t *testing.T) {
+	date := func(s string) time.Time {
+		d, err := types.ParseTime(s)
+		require.NoError(t, err)
+		return d
+	}
+	for _, vals := range [][]types.Val{
+		{
+			{Tid: types.IntID, Value: int64(math.MinInt64)},
+			{Tid: types.IntID, Value: int64(-5)},
+			{Tid: types.IntID, Value: int64(0)},
+			{Tid: types.IntID, Value: int64(3)},
+			{Tid: types.IntID, Value: int64(math.MaxInt64)},
+		},
+		{
+			{Tid: types.FloatID, Value: math.Inf(-1)},
+			{Tid: types.FloatID, Value: -2.5},
+			{Tid: types.FloatID, Value: -0.1},
+			{Tid: types.FloatID, Value: 0.0},
+			{Tid: types.FloatID, Value: 0.1},
+			{Tid: types.FloatID, Value: 1e10},
+		},
+		{
+			{Tid: types.BoolID, Value: false},
+			{Tid: types.BoolID, Value: true},
+		},
+		{
+			{Tid: types.DateTimeID, Value: date("1960-01-01")},
+			{Tid: types.DateTimeID, Value: date("2018-05-01")},
+			{Tid: types.DateTimeID, Value: date("2018-05-01T10:00:00.5")},
+			{Tid: types.DateTimeID, Value: date("2019-01-01")},
+		},
+		{
+			{Tid: types.StringID, Value: "a"},
+			{Tid: types.StringID, Value: "ab"},
+			{Tid: types.StringID, Value: "b"},
+		},
+	} {
+		var tokens []string
+		for _, val := range vals {
+			token, err := IndexToken("since", val)
+			require.NoError(t, err)
+			require.True(t, strings.HasPrefix(token, IndexTokenPrefix("since", val.Tid)))
+			tokens = append(tokens, token)
+		}
+		require.True(t, sort.StringsAreSorted(tokens), "%v", vals)
+	}
+
+	neg, err := IndexToken("since", types.Val{Tid: types.FloatID, Value: math.Copysign(0, -1)})
+	require.NoError(t, err)
+	zero, err := IndexToken("since", types.Val{Tid: types.FloatID, Value: 0.0})
+	require.NoError(t, err)
+	require.Equal(t, zero, neg)
+}
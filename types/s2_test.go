@@ -53,3 +53,11 @@ func TestConvertToGeoJson_PolyError2(t *testing.T) {
 	_, err := convertToGeom(s)
 	require.Error(t, err)
 }
+
+func TestPointDistance(t *testing.T) {
+	// One degree of longitude at the equator.
+	a := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0, 0})
+	b := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{1, 0})
+	require.InDelta(t, 111195, float64(PointDistance(a, b)), 1)
+	require.Zero(t, PointDistance(a, a))
+}
@@ -17,9 +17,18 @@
 package types
 
 import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
 	geom "github.com/twpayne/go-geom"
 )
 
@@ -50,6 +59,16 @@ const (
 	PasswordID = TypeID(pb.Posting_PASSWORD)
 	// StringID represents the string type.
 	StringID = TypeID(pb.Posting_STRING)
+	// BigIntID represents the arbitrary-precision integer type.
+	BigIntID = TypeID(pb.Posting_BIGINT)
+	// DecimalID represents the arbitrary-precision decimal type.
+	DecimalID = TypeID(pb.Posting_DECIMAL)
+	// DurationID represents the duration type.
+	DurationID = TypeID(pb.Posting_DURATION)
+	// UUIDID represents the UUID type.
+	UUIDID = TypeID(pb.Posting_UUID)
+	// VFloatID represents the vector of float32 type.
+	VFloatID = TypeID(pb.Posting_VFLOAT)
 	// UndefinedID represents the undefined type.
 	UndefinedID = TypeID(100)
 )
@@ -65,6 +84,12 @@ var typeNameMap = map[string]TypeID{
 	"uid":      UidID,
 	"string":   StringID,
 	"password": PasswordID,
+	"bigint":   BigIntID,
+	"decimal":  DecimalID,
+	"duration": DurationID,
+	"uuid":     UUIDID,
+
+	"float32vector": VFloatID,
 }
 
 // TypeID represents the type of the data.
@@ -98,6 +123,16 @@ func (t TypeID) Name() string {
 		return "string"
 	case PasswordID:
 		return "password"
+	case BigIntID:
+		return "bigint"
+	case DecimalID:
+		return "decimal"
+	case DurationID:
+		return "duration"
+	case UUIDID:
+		return "uuid"
+	case VFloatID:
+		return "float32vector"
 	}
 	return ""
 }
@@ -182,6 +217,24 @@ func ValueForType(id TypeID) Val {
 		var p string
 		return Val{PasswordID, p}
 
+	case BigIntID:
+		return Val{BigIntID, new(big.Int)}
+
+	case DecimalID:
+		return Val{DecimalID, new(big.Rat)}
+
+	case DurationID:
+		var d time.Duration
+		return Val{DurationID, &d}
+
+	case UUIDID:
+		var u uuid.UUID
+		return Val{UUIDID, &u}
+
+	case VFloatID:
+		var v []float32
+		return Val{VFloatID, &v}
+
 	default:
 		return Val{}
 	}
@@ -210,6 +263,233 @@ func ParseTime(val string) (time.Time, error) {
 	return time.Parse(dateFormatY, val)
 }
 
+// ParseBigInt parses an arbitrary-precision integer written in base 10.
+func ParseBigInt(val string) (*big.Int, error) {
+	i, ok := new(big.Int).SetString(strings.TrimSpace(val), 10)
+	if !ok {
+		return nil, errors.Errorf("Invalid bigint value: %q", val)
+	}
+	return i, nil
+}
+
+// ParseDecimal parses an arbitrary-precision decimal number, e.g. 12.50 or -1.5e-3.
+// The value is exact, unlike the float type.
+func ParseDecimal(val string) (*big.Rat, error) {
+	val = strings.TrimSpace(val)
+	// Rat also accepts fractions like 1/3, which have no exact decimal form.
+	if strings.Contains(val, "/") {
+		return nil, errors.Errorf("Invalid decimal value: %q", val)
+	}
+	r, ok := new(big.Rat).SetString(val)
+	if !ok {
+		return nil, errors.Errorf("Invalid decimal value: %q", val)
+	}
+	return r, nil
+}
+
+// MaxDecimalDigits is the number of digits kept after the point for decimals without an exact
+// decimal form, like the average of 1, 0 and 0.
+const MaxDecimalDigits = 34
+
+// DecimalString returns the decimal form of the number, without trailing zeros.
+func DecimalString(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	// The number of digits needed after the point is the number of 10, 2 or 5 factors of the
+	// denominator. Any other factor means the decimal form is infinite.
+	ten, two, five := big.NewInt(10), big.NewInt(2), big.NewInt(5)
+	d := new(big.Int).Set(r.Denom())
+	var mod big.Int
+	digits := 0
+loop:
+	for d.Cmp(big.NewInt(1)) != 0 {
+		switch {
+		case mod.Mod(d, ten).Sign() == 0:
+			d.Quo(d, ten)
+		case mod.Mod(d, two).Sign() == 0:
+			d.Quo(d, two)
+		case mod.Mod(d, five).Sign() == 0:
+			d.Quo(d, five)
+		default:
+			digits = MaxDecimalDigits
+			break loop
+		}
+		digits++
+	}
+	str := r.FloatString(digits)
+	if strings.Contains(str, ".") {
+		str = strings.TrimRight(strings.TrimRight(str, "0"), ".")
+	}
+	return str
+}
+
+type durationUnit struct {
+	designator byte
+	length     time.Duration
+}
+
+// Years and months have no fixed length, so they can't be used in durations.
+var (
+	durationDateUnits = []durationUnit{{'W', 7 * 24 * time.Hour}, {'D', 24 * time.Hour}}
+	durationTimeUnits = []durationUnit{{'H', time.Hour}, {'M', time.Minute}, {'S', time.Second}}
+)
+
+// ParseDuration parses a duration written in ISO-8601, e.g. P1DT2H30M or PT0.5S, or in the
+// syntax of Go, e.g. 26h30m or 500ms. ISO-8601 days and weeks are 24 and 168 hours long.
+func ParseDuration(val string) (time.Duration, error) {
+	val = strings.TrimSpace(val)
+	s := strings.TrimLeft(val, "+-")
+	if !strings.HasPrefix(s, "P") {
+		d, err := time.ParseDuration(val)
+		if err != nil {
+			return 0, errors.Errorf("Invalid duration value: %q", val)
+		}
+		return d, nil
+	}
+	if len(val)-len(s) > 1 {
+		return 0, errors.Errorf("Invalid duration value: %q", val)
+	}
+
+	// The duration is added up in nanoseconds, so fractions of any unit are exact.
+	total := new(big.Rat)
+	units := durationDateUnits
+	next := 0
+	s = s[1:]
+	for len(s) > 0 {
+		if s[0] == 'T' && len(s) > 1 && units[0].designator == 'W' {
+			units, next = durationTimeUnits, 0
+			s = s[1:]
+			continue
+		}
+		i := strings.IndexFunc(s, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.' && r != ','
+		})
+		if i <= 0 {
+			return 0, errors.Errorf("Invalid duration value: %q", val)
+		}
+		designator := s[i]
+		if designator == 'Y' || (designator == 'M' && units[0].designator == 'W') {
+			return 0, errors.Errorf("Years and months have no fixed length in duration %q", val)
+		}
+		j := next
+		for j < len(units) && units[j].designator != designator {
+			j++
+		}
+		if j == len(units) {
+			return 0, errors.Errorf("Invalid duration value: %q", val)
+		}
+		next = j + 1
+
+		num, ok := new(big.Rat).SetString(strings.Replace(s[:i], ",", ".", 1))
+		if !ok {
+			return 0, errors.Errorf("Invalid duration value: %q", val)
+		}
+		total.Add(total, num.Mul(num, new(big.Rat).SetInt64(int64(units[j].length))))
+		s = s[i+1:]
+	}
+	if next == 0 {
+		return 0, errors.Errorf("Invalid duration value: %q", val)
+	}
+
+	if strings.HasPrefix(val, "-") {
+		total.Neg(total)
+	}
+	nanos := new(big.Int).Quo(total.Num(), total.Denom())
+	if !nanos.IsInt64() {
+		return 0, errors.Errorf("Duration out of range: %q", val)
+	}
+	return time.Duration(nanos.Int64()), nil
+}
+
+// FormatDuration writes the duration in ISO-8601, e.g. PT26H30M. Days aren't used, so the
+// duration doesn't depend on the length of the days around daylight saving time changes.
+func FormatDuration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+	var b strings.Builder
+	if d < 0 {
+		b.WriteByte('-')
+	}
+	// The absolute value of the smallest duration doesn't fit in a time.Duration.
+	u := uint64(d)
+	if d < 0 {
+		u = -u
+	}
+	b.WriteString("PT")
+	if h := u / uint64(time.Hour); h > 0 {
+		b.WriteString(strconv.FormatUint(h, 10) + "H")
+	}
+	if m := u % uint64(time.Hour) / uint64(time.Minute); m > 0 {
+		b.WriteString(strconv.FormatUint(m, 10) + "M")
+	}
+	if ns := u % uint64(time.Minute); ns > 0 {
+		b.WriteString(strconv.FormatUint(ns/uint64(time.Second), 10))
+		if frac := ns % uint64(time.Second); frac > 0 {
+			b.WriteString("." + strings.TrimRight(fmt.Sprintf("%09d", frac), "0"))
+		}
+		b.WriteString("S")
+	}
+	return b.String()
+}
+
+// ParseUUID parses a UUID, e.g. 123e4567-e89b-12d3-a456-426655440000.
+func ParseUUID(val string) (uuid.UUID, error) {
+	u, err := uuid.Parse(strings.TrimSpace(val))
+	if err != nil {
+		return u, errors.Wrapf(err, "Invalid uuid value: %q", val)
+	}
+	return u, nil
+}
+
+// ParseVector parses a vector of float32 written as a JSON array, e.g. [0.1, -2.5, 3].
+func ParseVector(val string) ([]float32, error) {
+	var v []float32
+	if err := json.Unmarshal([]byte(val), &v); err != nil {
+		return nil, errors.Wrapf(err, "Invalid float32vector value: %q", val)
+	}
+	if len(v) == 0 {
+		return nil, errors.Errorf("Invalid float32vector value: %q. It can't be empty", val)
+	}
+	return v, nil
+}
+
+// FormatVector writes the vector as a JSON array.
+func FormatVector(v []float32) string {
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, f := range v {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(strconv.FormatFloat(float64(f), 'g', -1, 32))
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+// VectorToBytes encodes the vector as little-endian float32 values.
+func VectorToBytes(v []float32) []byte {
+	b := make([]byte, 4*len(v))
+	for i, f := range v {
+		binary.LittleEndian.PutUint32(b[4*i:], math.Float32bits(f))
+	}
+	return b
+}
+
+// BytesToVector decodes a vector encoded by VectorToBytes.
+func BytesToVector(b []byte) ([]float32, error) {
+	if len(b) == 0 || len(b)%4 != 0 {
+		return nil, errors.Errorf("Invalid data for float32vector %v", b)
+	}
+	v := make([]float32, len(b)/4)
+	for i := range v {
+		v[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[4*i:]))
+	}
+	return v, nil
+}
+
 const dateFormatYMDZone = "2006-01-02 15:04:05 -0700 MST"
 const dateFormatYMD = "2006-01-02"
 const dateFormatYM = "2006-01"
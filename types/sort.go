@@ -17,11 +17,14 @@
 package types
 
 import (
+	"bytes"
+	"math/big"
 	"sort"
 	"time"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/x"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"golang.org/x/text/collate"
 	"golang.org/x/text/language"
@@ -91,7 +94,8 @@ func SortWithFacet(v [][]Val, ul *pb.List, l []*pb.Facets, desc []bool, lang str
 
 	typ := v[0][0].Tid
 	switch typ {
-	case DateTimeID, IntID, FloatID, StringID, DefaultID:
+	case DateTimeID, IntID, FloatID, StringID, DefaultID, BigIntID, DecimalID,
+		DurationID, UUIDID:
 		// Don't do anything, we can sort values of this type.
 	default:
 		return errors.Errorf("Value of type: %s isn't sortable", typ.Name())
@@ -124,7 +128,8 @@ func Less(a, b Val) (bool, error) {
 	}
 	typ := a.Tid
 	switch typ {
-	case DateTimeID, UidID, IntID, FloatID, StringID, DefaultID:
+	case DateTimeID, UidID, IntID, FloatID, StringID, DefaultID, BigIntID, DecimalID,
+		DurationID, UUIDID:
 		// Don't do anything, we can sort values of this type.
 	default:
 		return false, errors.Errorf("Compare not supported for type: %v", a.Tid)
@@ -145,6 +150,15 @@ func less(a, b Val, cl *collate.Collator) bool {
 		return (a.Value.(float64)) < (b.Value.(float64))
 	case UidID:
 		return (a.Value.(uint64) < b.Value.(uint64))
+	case BigIntID:
+		return a.Value.(*big.Int).Cmp(b.Value.(*big.Int)) < 0
+	case DecimalID:
+		return a.Value.(*big.Rat).Cmp(b.Value.(*big.Rat)) < 0
+	case DurationID:
+		return a.Value.(time.Duration) < b.Value.(time.Duration)
+	case UUIDID:
+		aVal, bVal := a.Value.(uuid.UUID), b.Value.(uuid.UUID)
+		return bytes.Compare(aVal[:], bVal[:]) < 0
 	case StringID, DefaultID:
 		// Use language comparator.
 		if cl != nil {
@@ -180,7 +194,8 @@ func Equal(a, b Val) (bool, error) {
 	}
 	typ := a.Tid
 	switch typ {
-	case DateTimeID, IntID, FloatID, StringID, DefaultID, BoolID:
+	case DateTimeID, IntID, FloatID, StringID, DefaultID, BoolID, BigIntID, DecimalID,
+		DurationID, UUIDID:
 		// Don't do anything, we can sort values of this type.
 	default:
 		return false, errors.Errorf("Equal not supported for type: %v", a.Tid)
@@ -213,6 +228,22 @@ func equal(a, b Val) bool {
 		aVal, aOk := a.Value.(bool)
 		bVal, bOk := b.Value.(bool)
 		return aOk && bOk && aVal == bVal
+	case BigIntID:
+		aVal, aOk := a.Value.(*big.Int)
+		bVal, bOk := b.Value.(*big.Int)
+		return aOk && bOk && aVal.Cmp(bVal) == 0
+	case DecimalID:
+		aVal, aOk := a.Value.(*big.Rat)
+		bVal, bOk := b.Value.(*big.Rat)
+		return aOk && bOk && aVal.Cmp(bVal) == 0
+	case DurationID:
+		aVal, aOk := a.Value.(time.Duration)
+		bVal, bOk := b.Value.(time.Duration)
+		return aOk && bOk && aVal == bVal
+	case UUIDID:
+		aVal, aOk := a.Value.(uuid.UUID)
+		bVal, bOk := b.Value.(uuid.UUID)
+		return aOk && bOk && aVal == bVal
 	}
 	return false
 }
@@ -204,3 +204,31 @@ func TestSortMismatchedTypes(t *testing.T) {
 	require.True(t, idx21 < idx33)
 	require.True(t, idx33 < idx55)
 }
+
+func TestSortBigInts(t *testing.T) {
+	list := getInput(t, BigIntID, []string{"100000000000000000000", "-5", "99999999999999999999", "0"})
+	ul := getUIDList(4)
+	require.NoError(t, Sort(list, ul, []bool{false}, ""))
+	require.EqualValues(t, []uint64{200, 400, 300, 100}, ul.Uids)
+}
+
+func TestSortDecimals(t *testing.T) {
+	list := getInput(t, DecimalID, []string{"0.30", "0.1", "-2.5", "0.25"})
+	ul := getUIDList(4)
+	require.NoError(t, Sort(list, ul, []bool{true}, ""))
+	require.EqualValues(t, []uint64{100, 400, 200, 300}, ul.Uids)
+	require.EqualValues(t, []string{"0.3", "0.25", "0.1", "-2.5"}, toString(t, list, DecimalID))
+
+	eq, err := Equal(list[0][0], getInput(t, DecimalID, []string{"0.3000"})[0][0])
+	require.NoError(t, err)
+	require.True(t, eq)
+}
+
+func TestSortDurations(t *testing.T) {
+	list := getInput(t, DurationID, []string{"PT1H", "-PT5S", "59m", "P1D"})
+	ul := getUIDList(4)
+	require.NoError(t, Sort(list, ul, []bool{false}, ""))
+	require.EqualValues(t, []uint64{200, 300, 100, 400}, ul.Uids)
+	require.EqualValues(t, []string{"-PT5S", "PT59M", "PT1H", "PT24H"},
+		toString(t, list, DurationID))
+}
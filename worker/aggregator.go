@@ -28,10 +28,25 @@ func couldApplyAggregatorOn(agrtr string, typ types.TypeID) bool {
 			typ == types.FloatID ||
 			typ == types.DateTimeID ||
 			typ == types.StringID ||
-			typ == types.DefaultID)
+			typ == types.DefaultID ||
+			typ == types.BigIntID ||
+			typ == types.DecimalID ||
+			typ == types.DurationID)
+	case "median", "percentile":
+		return (typ == types.IntID ||
+			typ == types.FloatID ||
+			typ == types.DateTimeID)
 	case "sum", "avg":
+		return (typ == types.IntID ||
+			typ == types.FloatID ||
+			typ == types.BigIntID ||
+			typ == types.DecimalID ||
+			typ == types.DurationID)
+	case "stddev", "variance":
 		return (typ == types.IntID ||
 			typ == types.FloatID)
+	case "countdistinct":
+		return true
 	default:
 		return false
 	}
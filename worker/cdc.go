@@ -0,0 +1,411 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/types/facets"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// maxCDCBatchSize is the maximum number of transactions sent in a single batch by a group.
+const maxCDCBatchSize = 1000
+
+var errCDCDisabled = errors.New("Change data capture is disabled on this server")
+
+// recordCDC keeps the edges of a mutation which has been applied, so they can be written to
+// the change log once its transaction commits.
+func (n *node) recordCDC(m *pb.Mutations) {
+	if !x.WorkerConfig.CDC {
+		return
+	}
+	n.cdcLock.Lock()
+	defer n.cdcLock.Unlock()
+	switch {
+	case (m.DropOp == pb.Mutations_DATA || m.DropOp == pb.Mutations_ALL) && m.DropValue == "":
+		// The pending transactions have been reset along with the data.
+		n.cdcEdges = make(map[uint64][]*pb.DirectedEdge)
+		return
+	case m.DropOp != pb.Mutations_NONE || len(m.Schema) > 0 || len(m.Types) > 0:
+		return
+	}
+	for _, edge := range m.Edges {
+		if isDeletePredicateEdge(edge) {
+			// Dropping a predicate isn't part of any transaction.
+			return
+		}
+	}
+	if len(m.Edges) > 0 {
+		n.cdcEdges[m.StartTs] = append(n.cdcEdges[m.StartTs], m.Edges...)
+	}
+}
+
+// writeCDC writes the edges of the transactions committed by the delta to the change log,
+// at their commit timestamp. The edges of the aborted transactions are dropped.
+func (n *node) writeCDC(writer *posting.TxnWriter, delta *pb.OracleDelta) error {
+	n.cdcLock.Lock()
+	defer n.cdcLock.Unlock()
+	for _, status := range delta.Txns {
+		edges, ok := n.cdcEdges[status.StartTs]
+		if !ok {
+			continue
+		}
+		delete(n.cdcEdges, status.StartTs)
+		if status.CommitTs == 0 {
+			continue
+		}
+
+		entry := &pb.CDCEntry{CommitTs: status.CommitTs, Edges: edges}
+		data, err := entry.Marshal()
+		if err != nil {
+			return err
+		}
+		key := x.CDCKey(status.CommitTs)
+		if err := writer.SetAt(key, data, posting.BitCDCEntry, status.CommitTs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// minCDCStartTs returns the smallest start timestamp of the transactions whose edges are
+// waiting to be written to the change log, or math.MaxUint64 if there are none. Snapshots must
+// not go past their mutations, so that the edges can be recorded again after a restart.
+func (n *node) minCDCStartTs() uint64 {
+	n.cdcLock.Lock()
+	defer n.cdcLock.Unlock()
+	minTs := uint64(math.MaxUint64)
+	for startTs := range n.cdcEdges {
+		minTs = x.Min(minTs, startTs)
+	}
+	return minTs
+}
+
+// readCDC reads the entries of the change log committed after sinceTs, as seen at readTs.
+// If the batch fills up, its done timestamp is that of the last entry read.
+func readCDC(sinceTs, readTs uint64) (*pb.CDCBatch, error) {
+	batch := &pb.CDCBatch{DoneTs: readTs}
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	prefix := x.CDCPrefix()
+	iopt := badger.DefaultIteratorOptions
+	iopt.Prefix = prefix
+	itr := txn.NewIterator(iopt)
+	defer itr.Close()
+
+	for itr.Seek(x.CDCKey(sinceTs + 1)); itr.ValidForPrefix(prefix); itr.Next() {
+		entry := &pb.CDCEntry{}
+		err := itr.Item().Value(func(val []byte) error {
+			return entry.Unmarshal(val)
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "while reading CDC entry")
+		}
+		batch.Entries = append(batch.Entries, entry)
+		if len(batch.Entries) == maxCDCBatchSize {
+			batch.DoneTs = entry.CommitTs
+			break
+		}
+	}
+	return batch, nil
+}
+
+// pruneCDC deletes the entries of the change log committed before the CDC retention window,
+// as of readTs, so that the log doesn't grow forever.
+func pruneCDC(readTs uint64) error {
+	if x.WorkerConfig.CDCRetention == 0 {
+		return nil
+	}
+	pruneTs, err := TimestampAt(time.Now().Add(-x.WorkerConfig.CDCRetention))
+	if err != nil {
+		// No timestamp is known to be old enough yet, so everything must be kept.
+		return nil
+	}
+	return deleteCDC(x.Min(pruneTs, readTs), readTs)
+}
+
+// deleteCDC deletes the entries of the change log committed up to pruneTs, at readTs.
+func deleteCDC(pruneTs, readTs uint64) error {
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	prefix := x.CDCPrefix()
+	iopt := badger.DefaultIteratorOptions
+	iopt.Prefix = prefix
+	iopt.PrefetchValues = false
+	itr := txn.NewIterator(iopt)
+	defer itr.Close()
+
+	wb := pstore.NewWriteBatchAt(readTs)
+	defer wb.Cancel()
+	var num int
+	// The keys are ordered by commit timestamp.
+	for itr.Rewind(); itr.ValidForPrefix(prefix); itr.Next() {
+		item := itr.Item()
+		if item.Version() > pruneTs {
+			break
+		}
+		if err := wb.Delete(item.KeyCopy(nil)); err != nil {
+			return err
+		}
+		num++
+	}
+	if err := wb.Flush(); err != nil {
+		return err
+	}
+	if num > 0 {
+		glog.Infof("Deleted %d CDC entries committed up to ts: %d", num, pruneTs)
+	}
+	return nil
+}
+
+// streamCDC sends the change log of this group, starting after sinceTs, until the context is
+// done or send fails. A batch is sent every time the max assigned timestamp moves ahead, so the
+// receiver knows up to which timestamp the group is done, even when nothing was committed.
+func streamCDC(ctx context.Context, sinceTs uint64, send func(*pb.CDCBatch) error) error {
+	if !x.WorkerConfig.CDC {
+		return errCDCDisabled
+	}
+	for {
+		readTs := posting.Oracle().MaxAssigned()
+		if readTs <= sinceTs {
+			// This server hasn't caught up with the requested timestamp yet.
+			if err := posting.Oracle().WaitForTs(ctx, sinceTs+1); err != nil {
+				return err
+			}
+			continue
+		}
+
+		batch, err := readCDC(sinceTs, readTs)
+		if err != nil {
+			return err
+		}
+		if err := send(batch); err != nil {
+			return err
+		}
+		sinceTs = batch.DoneTs
+		if sinceTs < readTs {
+			// The batch was full, there might be more entries to read.
+			continue
+		}
+		if err := posting.Oracle().WaitForTs(ctx, readTs+1); err != nil {
+			return err
+		}
+	}
+}
+
+// StreamCDC implements the Worker interface.
+func (w *grpcWorker) StreamCDC(req *pb.CDCRequest, stream pb.Worker_StreamCDCServer) error {
+	return streamCDC(stream.Context(), req.SinceTs, stream.Send)
+}
+
+// streamGroupCDC streams the change log of the given group, either from this server or from
+// any server of the group.
+func streamGroupCDC(ctx context.Context, gid uint32, sinceTs uint64,
+	send func(*pb.CDCBatch) error) error {
+
+	if groups().ServesGroup(gid) {
+		return streamCDC(ctx, sinceTs, send)
+	}
+
+	pl := groups().AnyServer(gid)
+	if pl == nil {
+		return errors.Errorf("Unable to reach any server in group %d", gid)
+	}
+	c := pb.NewWorkerClient(pl.Get())
+	stream, err := c.StreamCDC(ctx, &pb.CDCRequest{SinceTs: sinceTs})
+	if err != nil {
+		return err
+	}
+	for {
+		batch, err := stream.Recv()
+		if err != nil {
+			return errors.Wrapf(err, "while streaming changes from group %d", gid)
+		}
+		if err := send(batch); err != nil {
+			return err
+		}
+	}
+}
+
+type groupBatch struct {
+	gid   uint32
+	batch *pb.CDCBatch
+}
+
+// StreamCDC sends the transactions committed across all the groups after sinceTs, ordered by
+// their commit timestamps. A transaction is only sent once every group is done up to its
+// commit timestamp, so its changes in all the groups are sent together.
+func StreamCDC(ctx context.Context, sinceTs uint64, send func(*pb.CDCTxn) error) error {
+	if err := x.HealthCheck(); err != nil {
+		return err
+	}
+	if !x.WorkerConfig.CDC {
+		return errCDCDisabled
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	gids := groups().KnownGroups()
+	if len(gids) == 0 {
+		return errors.New("No groups found to stream changes from")
+	}
+	batchCh := make(chan groupBatch, len(gids))
+	errCh := make(chan error, len(gids))
+	for _, gid := range gids {
+		go func(gid uint32) {
+			errCh <- streamGroupCDC(ctx, gid, sinceTs, func(batch *pb.CDCBatch) error {
+				select {
+				case batchCh <- groupBatch{gid: gid, batch: batch}:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			})
+		}(gid)
+	}
+
+	doneTs := make(map[uint32]uint64, len(gids))
+	var pending []*pb.CDCEntry
+	for {
+		select {
+		case err := <-errCh:
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		case gb := <-batchCh:
+			doneTs[gb.gid] = gb.batch.DoneTs
+			pending = append(pending, gb.batch.Entries...)
+		}
+		if len(doneTs) < len(gids) {
+			continue
+		}
+
+		minTs := uint64(0)
+		for _, ts := range doneTs {
+			if minTs == 0 || ts < minTs {
+				minTs = ts
+			}
+		}
+		sort.SliceStable(pending, func(i, j int) bool {
+			return pending[i].CommitTs < pending[j].CommitTs
+		})
+		var txn *pb.CDCTxn
+		i := 0
+		for ; i < len(pending) && pending[i].CommitTs <= minTs; i++ {
+			entry := pending[i]
+			if txn != nil && txn.CommitTs != entry.CommitTs {
+				if err := send(txn); err != nil {
+					return err
+				}
+				txn = nil
+			}
+			if txn == nil {
+				txn = &pb.CDCTxn{CommitTs: entry.CommitTs}
+			}
+			txn.Events = append(txn.Events, toCDCEvents(entry.Edges)...)
+		}
+		if txn != nil {
+			if err := send(txn); err != nil {
+				return err
+			}
+		}
+		pending = pending[i:]
+	}
+}
+
+func toCDCEvents(edges []*pb.DirectedEdge) []*pb.CDCEvent {
+	events := make([]*pb.CDCEvent, 0, len(edges))
+	for _, edge := range edges {
+		nquad, err := edgeToNQuad(edge)
+		if err != nil {
+			glog.Errorf("Ignoring error while converting edge to N-Quad: %+v", err)
+			continue
+		}
+		op := pb.CDCEvent_SET
+		if edge.Op == pb.DirectedEdge_DEL {
+			op = pb.CDCEvent_DEL
+		}
+		events = append(events, &pb.CDCEvent{Op: op, Nquad: nquad,
+			Namespace: x.ParseNamespace(edge.Attr)})
+	}
+	return events
+}
+
+// edgeToNQuad converts an edge in its stored form back to an RDF N-Quad, in the same format
+// used by exports. The namespace of the predicate isn't part of the N-Quad.
+func edgeToNQuad(edge *pb.DirectedEdge) (string, error) {
+	bp := new(bytes.Buffer)
+	fmt.Fprintf(bp, uidFmtStrRdf+" <%s> ", edge.Entity, x.ParseAttr(edge.Attr))
+	switch {
+	case edge.ValueId != 0:
+		fmt.Fprintf(bp, uidFmtStrRdf, edge.ValueId)
+	case isStarAll(edge.Value):
+		fmt.Fprint(bp, "*")
+	default:
+		tid := types.TypeID(edge.ValueType)
+		str, err := valToStr(types.Val{Tid: tid, Value: edge.Value})
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprint(bp, escapedString(str))
+		if edge.Lang != "" {
+			fmt.Fprint(bp, "@"+edge.Lang)
+		} else if rdfType, ok := rdfTypeMap[tid]; ok && tid != types.DefaultID {
+			fmt.Fprint(bp, "^^<"+rdfType+">")
+		}
+	}
+
+	if len(edge.Facets) != 0 {
+		fmt.Fprint(bp, " (")
+		for i, fct := range edge.Facets {
+			if i != 0 {
+				fmt.Fprint(bp, ",")
+			}
+			str, err := facetToString(fct)
+			if err != nil {
+				return "", err
+			}
+			tid, err := facets.TypeIDFor(fct)
+			if err != nil {
+				return "", err
+			}
+			if tid == types.StringID {
+				str = escapedString(str)
+			}
+			fmt.Fprint(bp, fct.Key+"="+str)
+		}
+		fmt.Fprint(bp, ")")
+	}
+	fmt.Fprint(bp, " .")
+	return bp.String(), nil
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestEdgeToNQuad(t *testing.T) {
+	tests := []struct {
+		edge  *pb.DirectedEdge
+		nquad string
+	}{
+		{
+			edge:  &pb.DirectedEdge{Entity: 1, Attr: "friend", ValueId: 2},
+			nquad: `<0x1> <friend> <0x2> .`,
+		},
+		{
+			edge: &pb.DirectedEdge{Entity: 1, Attr: "name", Value: []byte(`Al "the" pal`),
+				ValueType: pb.Posting_STRING},
+			nquad: `<0x1> <name> "Al \"the\" pal"^^<xs:string> .`,
+		},
+		{
+			edge: &pb.DirectedEdge{Entity: 1, Attr: "name", Value: []byte("Alice"),
+				ValueType: pb.Posting_DEFAULT, Lang: "en"},
+			nquad: `<0x1> <name> "Alice"@en .`,
+		},
+		{
+			edge: &pb.DirectedEdge{Entity: 1, Attr: "age",
+				Value: []byte{0x0d, 0, 0, 0, 0, 0, 0, 0}, ValueType: pb.Posting_INT},
+			nquad: `<0x1> <age> "13"^^<xs:int> .`,
+		},
+		{
+			edge: &pb.DirectedEdge{Entity: 1, Attr: "friend", Value: []byte(x.Star),
+				Op: pb.DirectedEdge_DEL},
+			nquad: `<0x1> <friend> * .`,
+		},
+		{
+			edge: &pb.DirectedEdge{Entity: 1, Attr: "friend", ValueId: 3,
+				Facets: []*api.Facet{
+					{Key: "close", Value: []byte{1}, ValType: api.Facet_BOOL},
+					{Key: "since", Value: []byte("school"), ValType: api.Facet_STRING},
+				}},
+			nquad: `<0x1> <friend> <0x3> (close=true,since="school") .`,
+		},
+		{
+			edge:  &pb.DirectedEdge{Entity: 1, Attr: x.NamespaceAttr(2, "friend"), ValueId: 2},
+			nquad: `<0x1> <friend> <0x2> .`,
+		},
+	}
+	for _, tc := range tests {
+		nquad, err := edgeToNQuad(tc.edge)
+		require.NoError(t, err)
+		require.Equal(t, tc.nquad, nquad)
+	}
+}
+
+func TestCDCLog(t *testing.T) {
+	x.WorkerConfig.CDC = true
+	defer func() { x.WorkerConfig.CDC = false }()
+
+	n := &node{cdcEdges: make(map[uint64][]*pb.DirectedEdge)}
+	set := func(startTs uint64, attr string) {
+		n.recordCDC(&pb.Mutations{StartTs: startTs, Edges: []*pb.DirectedEdge{{
+			Entity: startTs, Attr: attr, Value: []byte("v"), ValueType: pb.Posting_STRING,
+		}}})
+	}
+	set(101, "cdc.a")
+	set(102, "cdc.b")
+	set(103, x.NamespaceAttr(2, "cdc.c"))
+	set(101, "cdc.d")
+	require.Equal(t, uint64(101), n.minCDCStartTs())
+
+	writer := posting.NewTxnWriter(pstore)
+	require.NoError(t, n.writeCDC(writer, &pb.OracleDelta{Txns: []*pb.TxnStatus{
+		{StartTs: 101, CommitTs: 110},
+		{StartTs: 102, CommitTs: 0},
+		{StartTs: 103, CommitTs: 111},
+	}}))
+	require.NoError(t, writer.Flush())
+	require.Empty(t, n.cdcEdges)
+	require.Equal(t, uint64(math.MaxUint64), n.minCDCStartTs())
+
+	batch, err := readCDC(100, 120)
+	require.NoError(t, err)
+	require.Equal(t, uint64(120), batch.DoneTs)
+	require.Len(t, batch.Entries, 2)
+	require.Equal(t, uint64(110), batch.Entries[0].CommitTs)
+	require.Len(t, batch.Entries[0].Edges, 2)
+	require.Equal(t, uint64(111), batch.Entries[1].CommitTs)
+
+	// Entries aren't visible before they're committed, nor after sinceTs has passed them.
+	batch, err = readCDC(100, 110)
+	require.NoError(t, err)
+	require.Len(t, batch.Entries, 1)
+	batch, err = readCDC(110, 120)
+	require.NoError(t, err)
+	require.Len(t, batch.Entries, 1)
+	require.Equal(t, uint64(111), batch.Entries[0].CommitTs)
+
+	events := toCDCEvents(batch.Entries[0].Edges)
+	require.Equal(t, []*pb.CDCEvent{{Op: pb.CDCEvent_SET,
+		Nquad: `<0x67> <cdc.c> "v"^^<xs:string> .`, Namespace: 2}}, events)
+}
+
+func TestDeleteCDC(t *testing.T) {
+	writer := posting.NewTxnWriter(pstore)
+	for _, ts := range []uint64{210, 211, 212} {
+		data, err := (&pb.CDCEntry{CommitTs: ts}).Marshal()
+		require.NoError(t, err)
+		require.NoError(t, writer.SetAt(x.CDCKey(ts), data, posting.BitCDCEntry, ts))
+	}
+	require.NoError(t, writer.Flush())
+
+	require.NoError(t, deleteCDC(211, 220))
+	batch, err := readCDC(200, 220)
+	require.NoError(t, err)
+	require.Len(t, batch.Entries, 1)
+	require.Equal(t, uint64(212), batch.Entries[0].CommitTs)
+
+	// The deleted entries are still there when read before the deletion.
+	batch, err = readCDC(200, 219)
+	require.NoError(t, err)
+	require.Len(t, batch.Entries, 3)
+}
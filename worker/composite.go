@@ -0,0 +1,324 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"sort"
+
+	"github.com/dgraph-io/dgo/v2"
+	"github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// compositeBatchSize is the number of nodes indexed per transaction when a composite index is
+// built for the existing nodes.
+const compositeBatchSize = 10000
+
+// PredicateSchemas returns the schema of the predicates, holding their value types and whether
+// they're lists or have language tags.
+func PredicateSchemas(ctx context.Context, preds []string) (map[string]*pb.SchemaUpdate, error) {
+	nodes, err := GetSchemaOverNetwork(ctx, &pb.SchemaRequest{
+		Predicates: preds,
+		Fields:     []string{"type", "list", "lang"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]*pb.SchemaUpdate, len(nodes))
+	for _, node := range nodes {
+		if typ, ok := types.TypeForName(node.Type); ok {
+			out[node.Predicate] = &pb.SchemaUpdate{Predicate: node.Predicate,
+				ValueType: typ.Enum(), List: node.List, Lang: node.Lang}
+		}
+	}
+	for _, pred := range preds {
+		if _, ok := out[pred]; !ok {
+			return nil, errors.Errorf("Schema not defined for predicate: %s", x.ParseAttr(pred))
+		}
+	}
+	return out, nil
+}
+
+// IndexedPredicates returns whether the predicates are indexed.
+func IndexedPredicates(ctx context.Context, preds []string) (map[string]bool, error) {
+	nodes, err := GetSchemaOverNetwork(ctx, &pb.SchemaRequest{
+		Predicates: preds,
+		Fields:     []string{"index"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		out[node.Predicate] = node.Index
+	}
+	return out, nil
+}
+
+// CompositeIndexTypes returns the value types of the predicates of the composite index, which
+// were recorded when the index was defined.
+func CompositeIndexTypes(index *pb.CompositeIndex) (map[string]types.TypeID, error) {
+	if len(index.ValueTypes) != len(index.Predicates) {
+		return nil, errors.Errorf("Index %s doesn't have the types of its predicates", index.Name)
+	}
+	out := make(map[string]types.TypeID, len(index.Predicates))
+	for i, pred := range index.Predicates {
+		typ, ok := types.TypeForName(index.ValueTypes[i])
+		if !ok {
+			return nil, errors.Errorf("Invalid type %s of predicate %s in index %s",
+				index.ValueTypes[i], x.ParseAttr(pred), index.Name)
+		}
+		out[pred] = typ
+	}
+	return out, nil
+}
+
+// addCompositeIndexEdges adds to the mutation the edges keeping the composite indexes of the
+// mutated predicates up to date.
+func addCompositeIndexEdges(ctx context.Context, m *pb.Mutations) error {
+	byPred := make(map[string][]*pb.DirectedEdge)
+	namespaces := make(map[uint64]struct{})
+	for _, edge := range m.Edges {
+		byPred[edge.Attr] = append(byPred[edge.Attr], edge)
+		namespaces[x.ParseNamespace(edge.Attr)] = struct{}{}
+	}
+
+	for ns := range namespaces {
+		for _, index := range schema.State().CompositeIndexes(ns) {
+			seen := make(map[uint64]struct{})
+			var uids []uint64
+			for _, pred := range index.Predicates {
+				for _, edge := range byPred[pred] {
+					if _, ok := seen[edge.Entity]; !ok && len(edge.Lang) == 0 {
+						seen[edge.Entity] = struct{}{}
+						uids = append(uids, edge.Entity)
+					}
+				}
+			}
+			if len(uids) == 0 {
+				continue
+			}
+			sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+
+			edges, err := compositeIndexEdges(ctx, index, uids, byPred, m.StartTs)
+			if err != nil {
+				return errors.Wrapf(err, "while updating index %s", index.Name)
+			}
+			m.Edges = append(m.Edges, edges...)
+		}
+	}
+	return nil
+}
+
+// compositeIndexEdges returns the edges setting the tuples of values of the composite index for
+// the nodes, once the given edges are applied on top of the values as of startTs. The uids must
+// be sorted.
+func compositeIndexEdges(ctx context.Context, index *pb.CompositeIndex, uids []uint64,
+	byPred map[string][]*pb.DirectedEdge, startTs uint64) ([]*pb.DirectedEdge, error) {
+	typs, err := CompositeIndexTypes(index)
+	if err != nil {
+		return nil, err
+	}
+	pos := make(map[uint64]int, len(uids))
+	for i, uid := range uids {
+		pos[uid] = i
+	}
+
+	vals := make([][]*types.Val, len(uids))
+	for i := range vals {
+		vals[i] = make([]*types.Val, len(index.Predicates))
+	}
+	for j, pred := range index.Predicates {
+		typ := typs[pred]
+		// The values written earlier by the transaction are read too.
+		res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+			Attr:    pred,
+			UidList: &pb.List{Uids: uids},
+			ReadTs:  startTs,
+			Cache:   UseTxnCache,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for i, list := range res.ValueMatrix {
+			if i >= len(uids) || len(list.Values) == 0 || len(list.Values[0].Val) == 0 {
+				continue
+			}
+			tv := list.Values[0]
+			val, err := types.Convert(
+				types.Val{Tid: types.TypeID(tv.ValType), Value: tv.Val}, typ)
+			if err != nil {
+				return nil, err
+			}
+			vals[i][j] = &val
+		}
+	}
+
+	var out []*pb.DirectedEdge
+	attr := schema.CompositeIndexPredicate(index)
+	for i, uid := range uids {
+		before := isComplete(vals[i])
+		for j, pred := range index.Predicates {
+			for _, edge := range byPred[pred] {
+				if edge.Entity != uid || len(edge.Lang) > 0 {
+					continue
+				}
+				if err := applyCompositeEdge(&vals[i][j], edge, typs[pred]); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if !isComplete(vals[i]) {
+			if before {
+				// The node isn't indexed anymore.
+				out = append(out, &pb.DirectedEdge{
+					Entity: uid,
+					Attr:   attr,
+					Value:  []byte(x.Star),
+					Op:     pb.DirectedEdge_DEL,
+				})
+			}
+			continue
+		}
+		tuple := make([]types.Val, len(vals[i]))
+		for j, val := range vals[i] {
+			tuple[j] = *val
+		}
+		value, err := schema.CompositeIndexValue(tuple)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &pb.DirectedEdge{
+			Entity:    uid,
+			Attr:      attr,
+			Value:     []byte(value),
+			ValueType: pb.Posting_STRING,
+			Op:        pb.DirectedEdge_SET,
+		})
+	}
+	return out, nil
+}
+
+// applyCompositeEdge updates the value of a predicate of a node with the edge.
+func applyCompositeEdge(val **types.Val, edge *pb.DirectedEdge, typ types.TypeID) error {
+	if edge.Op == pb.DirectedEdge_DEL && string(edge.Value) == x.Star {
+		*val = nil
+		return nil
+	}
+	converted, err := types.Convert(
+		types.Val{Tid: types.TypeID(edge.ValueType), Value: edge.Value}, typ)
+	if err != nil {
+		return err
+	}
+	if edge.Op == pb.DirectedEdge_SET {
+		*val = &converted
+		return nil
+	}
+
+	// Like for the predicate, deleting a value other than the current one does nothing.
+	if *val == nil {
+		return nil
+	}
+	current, err := schema.CompositeIndexValue([]types.Val{**val})
+	if err != nil {
+		return err
+	}
+	deleted, err := schema.CompositeIndexValue([]types.Val{converted})
+	if err != nil {
+		return err
+	}
+	if current == deleted {
+		*val = nil
+	}
+	return nil
+}
+
+func isComplete(vals []*types.Val) bool {
+	for _, val := range vals {
+		if val == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// BuildCompositeIndex sets the tuples of values of the composite index for the existing nodes.
+// The mutations committed afterwards keep the index up to date themselves.
+func BuildCompositeIndex(ctx context.Context, index *pb.CompositeIndex) error {
+	ts, err := Timestamps(ctx, &pb.Num{Val: 1})
+	if err != nil {
+		return err
+	}
+	// Only the nodes having a value for the first predicate can be indexed.
+	res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+		Attr:    index.Predicates[0],
+		SrcFunc: &pb.SrcFunction{Name: "has"},
+		ReadTs:  ts.StartId,
+	})
+	if err != nil {
+		return err
+	}
+	var uids []uint64
+	if len(res.UidMatrix) > 0 {
+		uids = res.UidMatrix[0].Uids
+	}
+
+	for start := 0; start < len(uids); start += compositeBatchSize {
+		end := start + compositeBatchSize
+		if end > len(uids) {
+			end = len(uids)
+		}
+		// The batch conflicts with the transactions changing the values of its nodes in the
+		// meantime, in which case it's indexed again.
+		err := buildCompositeIndexBatch(ctx, index, uids[start:end])
+		for retry := 0; err == dgo.ErrAborted && retry < 10; retry++ {
+			err = buildCompositeIndexBatch(ctx, index, uids[start:end])
+		}
+		if err != nil {
+			return err
+		}
+	}
+	glog.Infof("Built composite index %s for %d nodes", index.Name, len(uids))
+	return nil
+}
+
+func buildCompositeIndexBatch(ctx context.Context, index *pb.CompositeIndex,
+	uids []uint64) error {
+	ts, err := Timestamps(ctx, &pb.Num{Val: 1})
+	if err != nil {
+		return err
+	}
+	edges, err := compositeIndexEdges(ctx, index, uids, nil, ts.StartId)
+	if err != nil || len(edges) == 0 {
+		return err
+	}
+	tctx, err := MutateOverNetwork(ctx, &pb.Mutations{StartTs: ts.StartId, Edges: edges})
+	if err != nil {
+		_, _ = CommitOverNetwork(ctx, &api.TxnContext{StartTs: ts.StartId, Aborted: true})
+		return err
+	}
+	_, err = CommitOverNetwork(ctx, tctx)
+	return err
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"sort"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -67,6 +68,13 @@ type node struct {
 	elog        trace.EventLog
 
 	pendingSize int64
+
+	// cdcEdges holds the edges applied by pending transactions, keyed by their start
+	// timestamp, until they're committed or aborted. Only used when CDC is enabled. It's only
+	// kept in memory: snapshots never go past the mutations buffered here, so they're applied
+	// again from the Raft log after a restart, which rebuilds it.
+	cdcLock  sync.Mutex
+	cdcEdges map[uint64][]*pb.DirectedEdge
 }
 
 // Now that we apply txn updates via Raft, waiting based on Txn timestamps is
@@ -93,6 +101,7 @@ func newNode(store *raftwal.DiskStorage, gid uint32, id uint64, myAddr string) *
 		rollupCh: make(chan uint64, 3),
 		elog:     trace.NewEventLog("Dgraph", "ApplyCh"),
 		closer:   y.NewCloser(3), // Matches CLOSER:1
+		cdcEdges: make(map[uint64][]*pb.DirectedEdge),
 	}
 	return n
 }
@@ -149,15 +158,36 @@ func detectPendingTxns(attr string) error {
 func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr error) {
 	span := otrace.FromContext(ctx)
 
+	if op := proposal.Mutations.DropOp; (op == pb.Mutations_DATA || op == pb.Mutations_ALL) &&
+		len(proposal.Mutations.DropValue) > 0 {
+		ns, err := strconv.ParseUint(proposal.Mutations.DropValue, 0, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid namespace %q", proposal.Mutations.DropValue)
+		}
+		// Only the transactions writing to the namespace have to be aborted.
+		for _, attr := range schema.State().NamespacePredicates(ns) {
+			if err := detectPendingTxns(attr); err != nil {
+				return err
+			}
+		}
+		cancelIndexBuilds(func(attr string) bool { return x.ParseNamespace(attr) == ns })
+		cancelMigrations(func(attr string) bool { return x.ParseNamespace(attr) == ns })
+		return posting.DeleteNamespace(ctx, ns, op == pb.Mutations_DATA)
+	}
+
 	if proposal.Mutations.DropOp == pb.Mutations_DATA {
 		// Ensures nothing get written to disk due to commit proposals.
 		posting.Oracle().ResetTxns()
+		cancelIndexBuilds(func(string) bool { return true })
+		cancelMigrations(func(string) bool { return true })
 		return posting.DeleteData()
 	}
 
 	if proposal.Mutations.DropOp == pb.Mutations_ALL {
 		// Ensures nothing get written to disk due to commit proposals.
 		posting.Oracle().ResetTxns()
+		cancelIndexBuilds(func(string) bool { return true })
+		cancelMigrations(func(string) bool { return true })
 		schema.State().DeleteAll()
 
 		if err := posting.DeleteAll(); err != nil {
@@ -192,6 +222,16 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 	}
 	startTs := proposal.Mutations.StartTs
 
+	if m := proposal.Mutations.Migration; m != nil {
+		span.Annotatef(nil, "Migrating %s", m.From)
+		for _, attr := range []string{m.From, m.Schema.GetPredicate()} {
+			if err := detectPendingTxns(attr); err != nil {
+				return err
+			}
+		}
+		return applyMigration(m, startTs)
+	}
+
 	if len(proposal.Mutations.Schema) > 0 || len(proposal.Mutations.Types) > 0 {
 		span.Annotatef(nil, "Applying schema and types")
 		for _, supdate := range proposal.Mutations.Schema {
@@ -229,9 +269,20 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 				span.Annotatef(nil, "Found pending transactions. Retry later.")
 				return err
 			}
+			if preds := schema.State().PartialIndexesOn(edge.Attr); len(preds) > 0 {
+				return errors.Errorf("Predicate %s is the @where condition of the index of %s",
+					x.ParseAttr(edge.Attr), x.ParseAttr(preds[0]))
+			}
 			span.Annotatef(nil, "Deleting predicate: %s", edge.Attr)
+			cancelIndexBuilds(func(attr string) bool { return attr == edge.Attr })
+			cancelMigrations(func(attr string) bool { return attr == edge.Attr })
 			return posting.DeletePredicate(ctx, edge.Attr)
 		}
+		// The values of a predicate being migrated are read as of the start of the migration.
+		if isMigrating(edge.Attr) {
+			return errors.Errorf("Predicate %s is being migrated. Please retry later",
+				x.ParseAttr(edge.Attr))
+		}
 		// Dont derive schema when doing deletion.
 		if edge.Op == pb.DirectedEdge_DEL {
 			continue
@@ -306,7 +357,10 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 	span.Annotatef(nil, "To apply: %d edges. NumGo: %d. Width: %d", len(m.Edges), numGo, width)
 
 	if numGo == 1 {
-		return process(m.Edges)
+		if err := process(m.Edges); err != nil {
+			return err
+		}
+		return txn.UpdatePartialIndexes(ctx, m.Edges)
 	}
 	errCh := make(chan error, numGo)
 	for i := 0; i < numGo; i++ {
@@ -324,7 +378,7 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 			return err
 		}
 	}
-	return nil
+	return txn.UpdatePartialIndexes(ctx, m.Edges)
 }
 
 func (n *node) applyCommitted(proposal *pb.Proposal) error {
@@ -340,6 +394,7 @@ func (n *node) applyCommitted(proposal *pb.Proposal) error {
 			span.Annotatef(nil, "While applying mutations: %v", err)
 			return err
 		}
+		n.recordCDC(proposal.Mutations)
 		span.Annotate(nil, "Done")
 		return nil
 	}
@@ -357,6 +412,8 @@ func (n *node) applyCommitted(proposal *pb.Proposal) error {
 
 	case len(proposal.CleanPredicate) > 0:
 		n.elog.Printf("Cleaning predicate: %s", proposal.CleanPredicate)
+		cancelIndexBuilds(func(attr string) bool { return attr == proposal.CleanPredicate })
+		cancelMigrations(func(attr string) bool { return attr == proposal.CleanPredicate })
 		return posting.DeletePredicate(ctx, proposal.CleanPredicate)
 
 	case proposal.Delta != nil:
@@ -410,16 +467,21 @@ func (n *node) processRollups() {
 		case readTs = <-n.rollupCh:
 		case <-tick.C:
 			glog.V(3).Infof("Evaluating rollup readTs:%d last:%d rollup:%v", readTs, last, readTs > last)
-			if readTs <= last {
+			// Versions within the history retention window must outlive the rollup, and so
+			// must the versions read by the index builds and migrations running in the
+			// background.
+			rollupTs := x.Min(x.Min(readTs, historyHorizon()),
+				x.Min(indexBuildHorizon(), migrationHorizon()))
+			if rollupTs <= last {
 				break // Break out of the select case.
 			}
-			if err := n.rollupLists(readTs); err != nil {
+			if err := n.rollupLists(rollupTs); err != nil {
 				// If we encounter error here, we don't need to do anything about
 				// it. Just let the user know.
-				glog.Errorf("Error while rolling up lists at %d: %v\n", readTs, err)
+				glog.Errorf("Error while rolling up lists at %d: %v\n", rollupTs, err)
 			} else {
-				last = readTs // Update last only if we succeeded.
-				glog.Infof("List rollup at Ts %d: OK.\n", readTs)
+				last = rollupTs // Update last only if we succeeded.
+				glog.Infof("List rollup at Ts %d: OK.\n", rollupTs)
 			}
 		}
 	}
@@ -531,6 +593,12 @@ func (n *node) commitOrAbort(pkey string, delta *pb.OracleDelta) error {
 	for _, status := range delta.Txns {
 		toDisk(status.StartTs, status.CommitTs)
 	}
+	if err := n.writeCDC(writer, delta); err != nil {
+		return errors.Wrapf(err, "while writing CDC entries")
+	}
+	if err := indexTime(writer, delta); err != nil {
+		return errors.Wrapf(err, "while indexing delta time")
+	}
 	if err := writer.Flush(); err != nil {
 		return errors.Wrapf(err, "while flushing to disk")
 	}
@@ -606,6 +674,11 @@ func (n *node) retrieveSnapshot(snap pb.Snapshot) error {
 	// commits up until then have already been written to pstore. And the way we take snapshots, we
 	// keep all the pre-writes for a pending transaction, so they will come back to memory, as Raft
 	// logs are replayed.
+	//
+	// The index builds and migrations are run again from the state of the snapshot, if they
+	// aren't done.
+	cancelIndexBuilds(func(string) bool { return true })
+	cancelMigrations(func(string) bool { return true })
 	if _, err := n.populateSnapshot(snap, pool); err != nil {
 		return errors.Wrapf(err, "cannot retrieve snapshot from peer")
 	}
@@ -614,6 +687,8 @@ func (n *node) retrieveSnapshot(snap pb.Snapshot) error {
 	if err := schema.LoadFromDb(); err != nil {
 		return errors.Wrapf(err, "while initializing schema")
 	}
+	resumeIndexBuilds()
+	resumeMigrations()
 	groups().triggerMembershipSync()
 	return nil
 }
@@ -720,6 +795,7 @@ func (n *node) checkpointAndClose(done chan struct{}) {
 					}
 				}
 				go n.abortOldTransactions()
+				go n.deleteExpired()
 			}
 
 		case <-n.closer.HasBeenClosed():
@@ -1036,7 +1112,7 @@ func (n *node) rollupLists(readTs uint64) error {
 		case posting.BitSchemaPosting, posting.BitCompletePosting, posting.BitEmptyPosting:
 			addTo(item.Key(), item.EstimatedSize())
 			return false
-		case x.ByteUnused:
+		case x.ByteUnused, posting.BitCDCEntry, posting.BitTimeIndex:
 			return false
 		default:
 			return true
@@ -1072,6 +1148,13 @@ func (n *node) rollupLists(readTs uint64) error {
 	// For all the keys, let's see if they're in the LRU cache. If so, we can roll them up.
 	glog.Infof("Rolled up %d keys. Done", atomic.LoadUint64(&numKeys))
 
+	if err := pruneCDC(readTs); err != nil {
+		return err
+	}
+	if err := pruneTimeIndex(readTs); err != nil {
+		return err
+	}
+
 	// We can now discard all invalid versions of keys below this ts.
 	pstore.SetDiscardTs(readTs)
 
@@ -1092,6 +1175,11 @@ func (n *node) rollupLists(readTs uint64) error {
 				total += size
 				return true
 			})
+			// Also sample the cardinality of the predicates, used by queries to estimate the cost of
+			// their functions.
+			if err := collectStats(readTs, tablets); err != nil {
+				glog.Warningf("While collecting predicate statistics. Error: %v", err)
+			}
 			// Update Zero with the tablet sizes. If Zero sees a tablet which does not belong to
 			// this group, it would send instruction to delete that tablet. There's an edge case
 			// here if the followers are still running Rollup, and happen to read a key before and
@@ -1254,7 +1342,7 @@ func (n *node) calculateSnapshot(startIdx uint64, discardN int) (*pb.Snapshot, e
 	// So, we iterate over logs. If we hit MinPendingStartTs, that generates our
 	// snapshotIdx. In any case, we continue picking up txn updates, to generate
 	// a maxCommitTs, which would become the readTs for the snapshot.
-	minPendingStart := posting.Oracle().MinPendingStartTs()
+	minPendingStart := x.Min(posting.Oracle().MinPendingStartTs(), n.minCDCStartTs())
 	maxCommitTs := snap.ReadTs
 	var snapshotIdx uint64
 
@@ -39,6 +39,7 @@ import (
 
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/types/facets"
 	"github.com/dgraph-io/dgraph/x"
@@ -85,6 +86,11 @@ var rdfTypeMap = map[types.TypeID]string{
 	types.GeoID:      "geo:geojson",
 	types.BinaryID:   "xs:base64Binary",
 	types.PasswordID: "xs:password",
+	types.BigIntID:   "xs:integer",
+	types.DecimalID:  "xs:decimal",
+	types.DurationID: "xs:duration",
+	types.UUIDID:     "xs:uuid",
+	types.VFloatID:   "xs:float32vector",
 }
 
 // UIDs like 0x1 look weird but 64-bit ones like 0x0000000000000001 are too long.
@@ -305,6 +311,18 @@ func toSchema(attr string, update pb.SchemaUpdate) (*bpb.KVList, error) {
 	if update.Upsert {
 		buf.WriteString(" @upsert")
 	}
+	if update.Unique {
+		buf.WriteString(" @unique")
+	}
+	if update.Ttl > 0 {
+		buf.WriteString(fmt.Sprintf(" @ttl(%s)", time.Duration(update.Ttl)*time.Second))
+	}
+	if update.Where != nil {
+		buf.WriteString(fmt.Sprintf(" @where(%s)", schema.IndexConditionString(update.Where)))
+	}
+	if len(update.FacetIndex) > 0 {
+		buf.WriteString(fmt.Sprintf(" @facets(index: %s)", strings.Join(update.FacetIndex, ", ")))
+	}
 	buf.WriteString(" . \n")
 	kv := &bpb.KV{
 		Value:   buf.Bytes(),
@@ -315,12 +333,27 @@ func toSchema(attr string, update pb.SchemaUpdate) (*bpb.KVList, error) {
 
 func toType(attr string, update pb.TypeUpdate) (*bpb.KVList, error) {
 	var buf bytes.Buffer
-	buf.WriteString(fmt.Sprintf("type %s {\n", attr))
+	// The types of the fields are only kept for the types declared with @strict.
+	directive := ""
+	for _, field := range update.Fields {
+		if field.ValueType != pb.Posting_DEFAULT || field.ObjectTypeName != "" {
+			directive = " @strict"
+		}
+	}
+	buf.WriteString(fmt.Sprintf("type %s%s {\n", attr, directive))
 	for _, field := range update.Fields {
 		buf.WriteString(fieldToString(field))
 	}
 
 	buf.WriteString("}\n")
+	for _, index := range update.Indexes {
+		preds := make([]string, 0, len(index.Predicates))
+		for _, pred := range index.Predicates {
+			preds = append(preds, x.ParseAttr(pred))
+		}
+		buf.WriteString(fmt.Sprintf("index %s on %s(%s)\n", index.Name, attr,
+			strings.Join(preds, ", ")))
+	}
 
 	kv := &bpb.KV{
 		Value:   buf.Bytes(),
@@ -332,7 +365,24 @@ func toType(attr string, update pb.TypeUpdate) (*bpb.KVList, error) {
 func fieldToString(update *pb.SchemaUpdate) string {
 	var builder strings.Builder
 	builder.WriteString("\t")
-	builder.WriteString(update.Predicate)
+	builder.WriteString(x.ParseAttr(update.Predicate))
+	if update.ValueType != pb.Posting_DEFAULT || update.ObjectTypeName != "" {
+		typ := update.ObjectTypeName
+		if typ == "" {
+			typ = types.TypeID(update.ValueType).Name()
+		}
+		if update.NonNullable {
+			typ += "!"
+		}
+		if update.List {
+			typ = "[" + typ + "]"
+			if update.NonNullableList {
+				typ += "!"
+			}
+		}
+		builder.WriteString(": ")
+		builder.WriteString(typ)
+	}
 	builder.WriteString("\n")
 	return builder.String()
 }
@@ -371,6 +421,13 @@ func (writer *fileWriter) Close() error {
 	return writer.fd.Close()
 }
 
+// exportWriter holds the files the data and the schema of a namespace are exported to.
+type exportWriter struct {
+	data          *fileWriter
+	schema        *fileWriter
+	hasDataBefore bool
+}
+
 // export creates a export of data by exporting it as an RDF gzip.
 func export(ctx context.Context, in *pb.ExportRequest) error {
 	if in.GroupId != groups().groupId() {
@@ -386,38 +443,66 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 	glog.Infof("Running export for group %d at timestamp %d.", in.GroupId, in.ReadTs)
 
 	uts := time.Unix(in.UnixTs, 0)
-	bdir := path.Join(x.WorkerConfig.ExportPath, fmt.Sprintf(
-		"dgraph.r%d.u%s", in.ReadTs, uts.UTC().Format("0102.1504")))
+	dir := fmt.Sprintf("dgraph.r%d.u%s", in.ReadTs, uts.UTC().Format("0102.1504"))
+	if !in.AllNamespaces && in.Namespace != x.GalaxyNamespace {
+		dir = fmt.Sprintf("dgraph.r%d.ns%d.u%s", in.ReadTs, in.Namespace,
+			uts.UTC().Format("0102.1504"))
+	}
+	bdir := path.Join(x.WorkerConfig.ExportPath, dir)
 
 	if err := os.MkdirAll(bdir, 0700); err != nil {
 		return err
 	}
 
 	xfmt := exportFormats[in.Format]
-	path := func(suffix string) (string, error) {
+	path := func(ns uint64, suffix string) (string, error) {
+		// When all the namespaces are exported, the ones other than the galaxy namespace are
+		// written to files of their own, so that each of them can be loaded into a namespace.
+		if in.AllNamespaces && ns != x.GalaxyNamespace {
+			suffix = fmt.Sprintf(".ns%d%s", ns, suffix)
+		}
 		return filepath.Abs(path.Join(bdir, fmt.Sprintf("g%02d%s", in.GroupId, suffix)))
 	}
 
-	// Open data file now.
-	dataPath, err := path(xfmt.ext + ".gz")
-	if err != nil {
-		return err
-	}
+	writers := make(map[uint64]*exportWriter)
+	writerFor := func(ns uint64) (*exportWriter, error) {
+		if w, ok := writers[ns]; ok {
+			return w, nil
+		}
 
-	glog.Infof("Exporting data for group: %d at %s\n", in.GroupId, dataPath)
-	dataWriter := &fileWriter{}
-	if err := dataWriter.open(dataPath); err != nil {
-		return err
-	}
+		// Open data file now.
+		dataPath, err := path(ns, xfmt.ext+".gz")
+		if err != nil {
+			return nil, err
+		}
+		glog.Infof("Exporting data for group: %d at %s\n", in.GroupId, dataPath)
+		w := &exportWriter{data: &fileWriter{}, schema: &fileWriter{}}
+		if err := w.data.open(dataPath); err != nil {
+			return nil, err
+		}
 
-	// Open schema file now.
-	schemaPath, err := path(".schema.gz")
-	if err != nil {
-		return err
+		// Open schema file now.
+		schemaPath, err := path(ns, ".schema.gz")
+		if err != nil {
+			return nil, err
+		}
+		glog.Infof("Exporting schema for group: %d at %s\n", in.GroupId, schemaPath)
+		if err := w.schema.open(schemaPath); err != nil {
+			return nil, err
+		}
+
+		if _, err = w.data.gw.Write([]byte(xfmt.pre)); err != nil {
+			return nil, err
+		}
+		writers[ns] = w
+		return w, nil
+	}
+	// The files of the exported namespace are written even if it holds no data.
+	firstNs := in.Namespace
+	if in.AllNamespaces {
+		firstNs = x.GalaxyNamespace
 	}
-	glog.Infof("Exporting schema for group: %d at %s\n", in.GroupId, schemaPath)
-	schemaWriter := &fileWriter{}
-	if err := schemaWriter.open(schemaPath); err != nil {
+	if _, err := writerFor(firstNs); err != nil {
 		return err
 	}
 
@@ -436,6 +521,18 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 			return false
 		}
 
+		// The change log, the time index and the migrations aren't part of the data.
+		if pk.IsCDC() || pk.IsTime() || pk.IsMigration() {
+			return false
+		}
+		if !in.AllNamespaces && x.ParseNamespace(pk.Attr) != in.Namespace {
+			return false
+		}
+		// The composite indexes are built again when the exported schema is loaded.
+		if schema.IsCompositeIndexPredicate(pk.Attr) {
+			return false
+		}
+
 		if !pk.IsType() {
 			if servesTablet, err := groups().ServesTablet(pk.Attr); err != nil || !servesTablet {
 				return false
@@ -446,7 +543,7 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 		// written to a different file.
 		return pk.IsData() || pk.IsSchema() || pk.IsType()
 	}
-	stream.KeyToList = func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
+	keyToList := func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
 		item := itr.Item()
 		pk, err := x.Parse(item.Key())
 		if err != nil {
@@ -456,7 +553,8 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 			readTs: in.ReadTs,
 		}
 		e.uid = pk.Uid
-		e.attr = pk.Attr
+		// The export of a namespace can be loaded into any other namespace.
+		e.attr = x.ParseAttr(pk.Attr)
 
 		// Schema and type keys should be handled first because schema keys are also
 		// considered data keys.
@@ -471,7 +569,7 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 				glog.Errorf("Unable to unmarshal schema: %+v. Err=%v\n", pk, err)
 				return nil, nil
 			}
-			return toSchema(pk.Attr, update)
+			return toSchema(e.attr, update)
 
 		case pk.IsType():
 			var update pb.TypeUpdate
@@ -483,7 +581,7 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 				glog.Errorf("Unable to unmarshal type: %+v. Err=%v\n", pk, err)
 				return nil, nil
 			}
-			return toType(pk.Attr, update)
+			return toType(e.attr, update)
 
 		case pk.IsData():
 			e.pl, err = posting.ReadPostingList(key, itr)
@@ -504,8 +602,17 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 		}
 		return nil, nil
 	}
+	stream.KeyToList = func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
+		list, err := keyToList(key, itr)
+		if list != nil {
+			// Send picks the files of the namespace of each entry through its key.
+			for _, kv := range list.Kv {
+				kv.Key = key
+			}
+		}
+		return list, err
+	}
 
-	hasDataBefore := false
 	var separator []byte
 	switch in.Format {
 	case "json":
@@ -519,25 +626,34 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 
 	stream.Send = func(list *bpb.KVList) error {
 		for _, kv := range list.Kv {
+			pk, err := x.Parse(kv.Key)
+			if err != nil {
+				return err
+			}
+			w, err := writerFor(x.ParseNamespace(pk.Attr))
+			if err != nil {
+				return err
+			}
+
 			var writer *fileWriter
 			switch kv.Version {
 			case 1: // data
-				writer = dataWriter
+				writer = w.data
 			case 2: // schema and types
-				writer = schemaWriter
+				writer = w.schema
 			default:
 				glog.Fatalf("Invalid data type found: %x", kv.Key)
 			}
 
 			if kv.Version == 1 { // only insert separator for data
-				if hasDataBefore {
+				if w.hasDataBefore {
 					if _, err := writer.gw.Write(separator); err != nil {
 						return err
 					}
 				}
 				// change the hasDataBefore flag so that the next data entry will have a separator
 				// prepended
-				hasDataBefore = true
+				w.hasDataBefore = true
 			}
 			if _, err := writer.gw.Write(kv.Value); err != nil {
 				return err
@@ -548,20 +664,19 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 	}
 
 	// All prepwork done. Time to roll.
-	if _, err = dataWriter.gw.Write([]byte(xfmt.pre)); err != nil {
-		return err
-	}
 	if err := stream.Orchestrate(ctx); err != nil {
 		return err
 	}
-	if _, err = dataWriter.gw.Write([]byte(xfmt.post)); err != nil {
-		return err
-	}
-	if err := dataWriter.Close(); err != nil {
-		return err
-	}
-	if err := schemaWriter.Close(); err != nil {
-		return err
+	for _, w := range writers {
+		if _, err := w.data.gw.Write([]byte(xfmt.post)); err != nil {
+			return err
+		}
+		if err := w.data.Close(); err != nil {
+			return err
+		}
+		if err := w.schema.Close(); err != nil {
+			return err
+		}
 	}
 	glog.Infof("Export DONE for group %d at timestamp %d.", in.GroupId, in.ReadTs)
 	return nil
@@ -605,8 +720,9 @@ func handleExportOverNetwork(ctx context.Context, in *pb.ExportRequest) error {
 	return err
 }
 
-// ExportOverNetwork sends export requests to all the known groups.
-func ExportOverNetwork(ctx context.Context, format string) error {
+// ExportOverNetwork sends export requests to all the known groups, to export the predicates
+// and types of the namespace ns, or of every namespace if allNamespaces is set.
+func ExportOverNetwork(ctx context.Context, format string, ns uint64, allNamespaces bool) error {
 	// If we haven't even had a single membership update, don't run export.
 	if err := x.HealthCheck(); err != nil {
 		glog.Errorf("Rejecting export request due to health check error: %v\n", err)
@@ -629,10 +745,12 @@ func ExportOverNetwork(ctx context.Context, format string) error {
 	for _, gid := range gids {
 		go func(group uint32) {
 			req := &pb.ExportRequest{
-				GroupId: group,
-				ReadTs:  readTs,
-				UnixTs:  time.Now().Unix(),
-				Format:  format,
+				GroupId:       group,
+				ReadTs:        readTs,
+				UnixTs:        time.Now().Unix(),
+				Format:        format,
+				Namespace:     ns,
+				AllNamespaces: allNamespaces,
 			}
 			ch <- handleExportOverNetwork(ctx, req)
 		}(gid)
@@ -322,6 +322,75 @@ func TestExportJson(t *testing.T) {
 	checkExportSchema(t, schemaFileList)
 }
 
+func readExportFile(t *testing.T, file string) string {
+	f, err := os.Open(file)
+	require.NoError(t, err)
+	defer f.Close()
+
+	r, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestExportNamespaces(t *testing.T) {
+	initTestExport(t, "name:string @index .")
+
+	val, err := (&pb.SchemaUpdate{ValueType: pb.Posting_STRING}).Marshal()
+	require.NoError(t, err)
+	txn := pstore.NewTransactionAt(math.MaxUint64, true)
+	require.NoError(t, txn.Set(x.SchemaKey(x.NamespaceAttr(2, "name")), val))
+	require.NoError(t, txn.CommitAt(1, nil))
+	txn.Discard()
+
+	l := &lex.Lexer{}
+	nq, err := chunker.ParseRDF(`<7> <name> "tenant" .`, l)
+	require.NoError(t, err)
+	rnq := gql.NQuad{NQuad: &nq}
+	e, err := rnq.ToEdgeUsing(map[string]uint64{"7": 7})
+	require.NoError(t, err)
+	e.Attr = x.NamespaceAttr(2, e.Attr)
+	addEdge(t, e, getOrCreate(x.DataKey(e.Attr, e.Entity)))
+
+	bdir, err := ioutil.TempDir("", "export")
+	require.NoError(t, err)
+	defer os.RemoveAll(bdir)
+
+	time.Sleep(1 * time.Second)
+
+	x.WorkerConfig.ExportPath = bdir
+	readTs := timestamp()
+	// Do the following so export won't block forever for readTs.
+	posting.Oracle().ProcessDelta(&pb.OracleDelta{MaxAssigned: readTs})
+	req := pb.ExportRequest{ReadTs: readTs, GroupId: 1, Format: "rdf", AllNamespaces: true}
+	require.NoError(t, export(context.Background(), &req))
+
+	galaxyFiles, err := filepath.Glob(filepath.Join(bdir, "*", "g01.rdf.gz"))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(galaxyFiles))
+	galaxyData := readExportFile(t, galaxyFiles[0])
+	require.Contains(t, galaxyData, `<0x1> <friend> <0x5> .`)
+	require.NotContains(t, galaxyData, "tenant")
+
+	nsFiles, err := filepath.Glob(filepath.Join(bdir, "*", "g01.ns2.rdf.gz"))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(nsFiles))
+	require.Equal(t, "<0x7> <name> \"tenant\" .\n", readExportFile(t, nsFiles[0]))
+
+	nsSchemas, err := filepath.Glob(filepath.Join(bdir, "*", "g01.ns2.schema.gz"))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(nsSchemas))
+	require.Contains(t, readExportFile(t, nsSchemas[0]), "<name>:string")
+
+	// Only the namespace given in the request is exported, to files loadable in any namespace.
+	require.NoError(t, os.RemoveAll(bdir))
+	req = pb.ExportRequest{ReadTs: readTs, GroupId: 1, Format: "rdf", Namespace: 2}
+	require.NoError(t, export(context.Background(), &req))
+	dataFiles, _ := getExportFileList(t, bdir)
+	require.Equal(t, "<0x7> <name> \"tenant\" .\n", readExportFile(t, dataFiles[0]))
+}
+
 func TestExportFormat(t *testing.T) {
 	tmpdir, err := ioutil.TempDir("", "export")
 	require.NoError(t, err)
@@ -349,6 +418,20 @@ type skv struct {
 	schema pb.SchemaUpdate
 }
 
+func TestFieldToString(t *testing.T) {
+	fields := map[string]*pb.SchemaUpdate{
+		"\tname\n": {Predicate: "name"},
+		"\tname: [string!]!\n": {Predicate: "name", ValueType: pb.Posting_STRING, List: true,
+			NonNullable: true, NonNullableList: true},
+		"\tage: int!\n": {Predicate: "age", ValueType: pb.Posting_INT, NonNullable: true},
+		"\tfriend: [Person]\n": {Predicate: "friend", ValueType: pb.Posting_OBJECT,
+			List: true, ObjectTypeName: "Person"},
+	}
+	for expected, field := range fields {
+		require.Equal(t, expected, fieldToString(field))
+	}
+}
+
 func TestToSchema(t *testing.T) {
 	testCases := []struct {
 		skv      *skv
@@ -459,6 +542,19 @@ func TestToSchema(t *testing.T) {
 			},
 			expected: "<data.base>:string @lang . \n",
 		},
+		{
+			skv: &skv{
+				attr: "session",
+				schema: pb.SchemaUpdate{
+					Predicate: "",
+					ValueType: pb.Posting_STRING,
+					Directive: pb.SchemaUpdate_INDEX,
+					Tokenizer: []string{"exact"},
+					Ttl:       24 * 3600,
+				},
+			},
+			expected: "<session>:string @index(exact) @ttl(24h0m0s) . \n",
+		},
 	}
 	for _, testCase := range testCases {
 		list, err := toSchema(testCase.skv.attr, testCase.skv.schema)
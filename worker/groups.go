@@ -145,6 +145,8 @@ func StartRaftNodes(walStore *badger.DB, bindall bool) {
 	gr.Node = newNode(store, gid, x.WorkerConfig.RaftId, x.WorkerConfig.MyAddr)
 
 	x.Checkf(schema.LoadFromDb(), "Error while initializing schema")
+	resumeIndexBuilds()
+	resumeMigrations()
 	raftServer.UpdateNode(gr.Node.Node)
 	gr.Node.InitAndStartNode()
 	x.UpdateHealthStatus(true)
@@ -417,6 +419,14 @@ func (g *groupi) BelongsToReadOnly(key string) (uint32, error) {
 	return out.GetGroupId(), nil
 }
 
+// knownTablet returns the tablet of the key if this server knows about it, without asking
+// Zero. Do not modify the returned Tablet.
+func (g *groupi) knownTablet(key string) *pb.Tablet {
+	g.RLock()
+	defer g.RUnlock()
+	return g.tablets[key]
+}
+
 func (g *groupi) ServesTablet(key string) (bool, error) {
 	if tablet, err := g.Tablet(key); err != nil {
 		return false, err
@@ -584,6 +594,29 @@ func KnownGroups() []uint32 {
 	return groups().KnownGroups()
 }
 
+// Namespaces returns the namespaces which have predicates in the cluster. The galaxy namespace
+// is always included.
+func Namespaces() []uint64 {
+	g := groups()
+	g.RLock()
+	defer g.RUnlock()
+	seen := map[uint64]struct{}{x.GalaxyNamespace: {}}
+	nss := []uint64{x.GalaxyNamespace}
+	if g.state == nil {
+		return nss
+	}
+	for _, group := range g.state.Groups {
+		for pred := range group.Tablets {
+			ns := x.ParseNamespace(pred)
+			if _, ok := seen[ns]; !ok {
+				seen[ns] = struct{}{}
+				nss = append(nss, ns)
+			}
+		}
+	}
+	return nss
+}
+
 func (g *groupi) triggerMembershipSync() {
 	// It's ok if we miss the trigger, periodic membership sync runs every minute.
 	select {
@@ -914,6 +947,9 @@ func (g *groupi) processOracleDeltaStream() {
 					batch++
 					delta.Txns = append(delta.Txns, more.Txns...)
 					delta.MaxAssigned = x.Max(delta.MaxAssigned, more.MaxAssigned)
+					if more.UnixTime > delta.UnixTime {
+						delta.UnixTime = more.UnixTime
+					}
 				default:
 					break SLURP
 				}
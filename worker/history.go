@@ -0,0 +1,252 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// indexTime records the max assigned timestamp of the delta under the time Zero sent it, so
+// that wall clock times can be mapped to timestamps. Deltas sent within the same second write
+// newer versions of the same key, so the entry ends up with the last timestamp of that second.
+func indexTime(writer *posting.TxnWriter, delta *pb.OracleDelta) error {
+	if delta.UnixTime == 0 || delta.MaxAssigned == 0 {
+		return nil
+	}
+	var val [8]byte
+	binary.BigEndian.PutUint64(val[:], delta.MaxAssigned)
+	return writer.SetAt(x.TimeKey(delta.UnixTime), val[:], posting.BitTimeIndex,
+		delta.MaxAssigned)
+}
+
+// TimestampAt returns the max assigned timestamp as of the given time. Reading at this
+// timestamp shows the data as it was at that time.
+func TimestampAt(t time.Time) (uint64, error) {
+	txn := pstore.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+
+	prefix := x.TimePrefix()
+	iopt := badger.DefaultIteratorOptions
+	iopt.Prefix = prefix
+	iopt.Reverse = true
+	itr := txn.NewIterator(iopt)
+	defer itr.Close()
+
+	// Iterating in reverse, Seek finds the latest entry at or before t.
+	itr.Seek(x.TimeKey(t.Unix()))
+	if !itr.ValidForPrefix(prefix) {
+		return 0, errors.Errorf("No timestamp is known as of %s", t.Format(time.RFC3339))
+	}
+	var ts uint64
+	err := itr.Item().Value(func(val []byte) error {
+		if len(val) != 8 {
+			return errors.Errorf("Invalid time index entry of length %d", len(val))
+		}
+		ts = binary.BigEndian.Uint64(val)
+		return nil
+	})
+	return ts, err
+}
+
+// historyHorizon returns the timestamp up to which posting lists can be rolled up and their
+// older versions discarded, without losing any version within the history retention window.
+func historyHorizon() uint64 {
+	if x.WorkerConfig.HistoryRetention == 0 {
+		return math.MaxUint64
+	}
+	ts, err := TimestampAt(time.Now().Add(-x.WorkerConfig.HistoryRetention))
+	if err != nil {
+		// No timestamp is known to be old enough yet, so everything must be kept.
+		return 0
+	}
+	return ts
+}
+
+// pruneTimeIndex deletes the entries of the time index which aren't needed anymore, at readTs.
+// Times are only looked up within the history and CDC retention windows, and the versions older
+// than readTs are discarded by the rollup. Of the entries older than both, the latest is kept,
+// so that the times since then still map to a timestamp.
+func pruneTimeIndex(readTs uint64) error {
+	retention := x.WorkerConfig.HistoryRetention
+	if x.WorkerConfig.CDC && x.WorkerConfig.CDCRetention > retention {
+		retention = x.WorkerConfig.CDCRetention
+	}
+	cutoff := x.TimeKey(time.Now().Add(-retention).Unix())
+
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	prefix := x.TimePrefix()
+	iopt := badger.DefaultIteratorOptions
+	iopt.Prefix = prefix
+	iopt.PrefetchValues = false
+	itr := txn.NewIterator(iopt)
+	defer itr.Close()
+
+	wb := pstore.NewWriteBatchAt(readTs)
+	defer wb.Cancel()
+	var last []byte
+	for itr.Rewind(); itr.ValidForPrefix(prefix); itr.Next() {
+		key := itr.Item().Key()
+		if bytes.Compare(key, cutoff) > 0 {
+			break
+		}
+		if last != nil {
+			if err := wb.Delete(last); err != nil {
+				return err
+			}
+		}
+		last = itr.Item().KeyCopy(nil)
+	}
+	return wb.Flush()
+}
+
+// ValidateAsOf returns an error if queries can't read the data as of the given timestamp.
+func ValidateAsOf(ts uint64) error {
+	if maxTs := posting.Oracle().MaxAssigned(); ts > maxTs {
+		return errors.Errorf("Timestamp %d is ahead of the max assigned timestamp %d",
+			ts, maxTs)
+	}
+	if x.WorkerConfig.HistoryRetention > 0 && ts < historyHorizon() {
+		return errors.Errorf("Timestamp %d is older than the history retention window of %s",
+			ts, x.WorkerConfig.HistoryRetention)
+	}
+	return nil
+}
+
+// readHistory returns the changes of the posting list of the data key committed at or after the
+// horizon, oldest first. If the older versions were rolled up, the oldest entries hold the
+// values the list had at the time of the rollup.
+func readHistory(txn *badger.Txn, key []byte, horizon uint64) ([]*pb.HistoryEntry, error) {
+	iopt := badger.DefaultIteratorOptions
+	iopt.AllVersions = true
+	iopt.Prefix = key
+	itr := txn.NewIterator(iopt)
+	defer itr.Close()
+
+	// The versions are iterated from the newest to the oldest.
+	var versions [][]*pb.HistoryEntry
+Loop:
+	for itr.Seek(key); itr.Valid(); itr.Next() {
+		item := itr.Item()
+		if !bytes.Equal(item.Key(), key) || item.Version() < horizon ||
+			item.IsDeletedOrExpired() {
+			break
+		}
+
+		var entries []*pb.HistoryEntry
+		switch item.UserMeta() {
+		case posting.BitEmptyPosting:
+			break Loop
+		case posting.BitCompletePosting:
+			l, err := posting.ReadPostingList(item.KeyCopy(nil), itr)
+			if err != nil {
+				return nil, err
+			}
+			err = l.Iterate(item.Version(), 0, func(p *pb.Posting) error {
+				entries = append(entries, historyEntry(p, posting.Set, item.Version()))
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			versions = append(versions, entries)
+			break Loop
+		case posting.BitDeltaPosting:
+			err := item.Value(func(val []byte) error {
+				var plist pb.PostingList
+				if err := plist.Unmarshal(val); err != nil {
+					return err
+				}
+				for _, p := range plist.Postings {
+					entries = append(entries, historyEntry(p, p.Op, item.Version()))
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			versions = append(versions, entries)
+		default:
+			return nil, errors.Errorf("Unexpected meta: %d for the history of key: %x",
+				item.UserMeta(), key)
+		}
+		if item.DiscardEarlierVersions() {
+			break
+		}
+	}
+
+	var history []*pb.HistoryEntry
+	for i := len(versions) - 1; i >= 0; i-- {
+		history = append(history, versions[i]...)
+	}
+	return history, nil
+}
+
+func historyEntry(p *pb.Posting, op uint32, commitTs uint64) *pb.HistoryEntry {
+	entry := &pb.HistoryEntry{CommitTs: commitTs, Op: op, LangTag: p.LangTag}
+	switch {
+	case p.PostingType == pb.Posting_REF:
+		entry.Uid = p.Uid
+	case !bytes.Equal(p.Value, []byte(x.Star)):
+		// Deleting all the values stores a star value, which isn't kept in the entry.
+		entry.Value = p.Value
+		entry.ValType = p.ValType
+	}
+	return entry
+}
+
+// handleHistory fills the result with the changes of the predicate on every uid, within the
+// history retention window if it's set.
+func (qs *queryState) handleHistory(ctx context.Context, args funcArgs) error {
+	q := args.q
+	if q.Reverse {
+		return errors.Errorf("Function history can't be used on the reverse of predicate %s",
+			q.Attr)
+	}
+	var horizon uint64
+	if x.WorkerConfig.HistoryRetention > 0 {
+		horizon = historyHorizon()
+	}
+
+	txn := pstore.NewTransactionAt(q.ReadTs, false)
+	defer txn.Discard()
+	for _, uid := range q.UidList.GetUids() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		entries, err := readHistory(txn, x.DataKey(q.Attr, uid), horizon)
+		if err != nil {
+			return err
+		}
+		args.out.HistoryMatrix = append(args.out.HistoryMatrix,
+			&pb.HistoryList{Entries: entries})
+		args.out.UidMatrix = append(args.out.UidMatrix, &pb.List{})
+	}
+	return nil
+}
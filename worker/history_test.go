@@ -0,0 +1,157 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/codec"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestTimeIndex(t *testing.T) {
+	now := time.Now().Unix()
+	writer := posting.NewTxnWriter(pstore)
+	for _, delta := range []*pb.OracleDelta{
+		{UnixTime: now - 7200, MaxAssigned: 200},
+		{UnixTime: now - 3600, MaxAssigned: 300},
+		// A later delta in the same second overrides the earlier one.
+		{UnixTime: now - 3600, MaxAssigned: 310},
+		{UnixTime: now - 60, MaxAssigned: 400},
+	} {
+		require.NoError(t, indexTime(writer, delta))
+	}
+	require.NoError(t, writer.Flush())
+
+	at := func(ago time.Duration) uint64 {
+		ts, err := TimestampAt(time.Unix(now, 0).Add(-ago))
+		require.NoError(t, err)
+		return ts
+	}
+	require.Equal(t, uint64(200), at(2*time.Hour))
+	require.Equal(t, uint64(200), at(90*time.Minute))
+	require.Equal(t, uint64(310), at(time.Hour))
+	require.Equal(t, uint64(400), at(0))
+
+	_, err := TimestampAt(time.Unix(now-3*3600, 0))
+	require.Error(t, err)
+
+	defer func() { x.WorkerConfig.HistoryRetention = 0 }()
+	require.Equal(t, uint64(math.MaxUint64), historyHorizon())
+	x.WorkerConfig.HistoryRetention = 90 * time.Minute
+	require.Equal(t, uint64(200), historyHorizon())
+	x.WorkerConfig.HistoryRetention = 3 * time.Hour
+	require.Equal(t, uint64(0), historyHorizon())
+}
+
+func TestPruneTimeIndex(t *testing.T) {
+	now := time.Now().Unix()
+	day := int64(24 * 3600)
+	writer := posting.NewTxnWriter(pstore)
+	for _, delta := range []*pb.OracleDelta{
+		{UnixTime: now - 30*day, MaxAssigned: 100},
+		{UnixTime: now - 29*day, MaxAssigned: 110},
+		{UnixTime: now - 28*day, MaxAssigned: 120},
+	} {
+		require.NoError(t, indexTime(writer, delta))
+	}
+	require.NoError(t, writer.Flush())
+
+	x.WorkerConfig.HistoryRetention = time.Hour
+	defer func() { x.WorkerConfig.HistoryRetention = 0 }()
+	// The entry at 120 is newer than the discarded versions, so it's kept along with the
+	// latest of the older ones.
+	require.NoError(t, pruneTimeIndex(115))
+
+	_, err := TimestampAt(time.Unix(now-30*day, 0))
+	require.Error(t, err)
+	ts, err := TimestampAt(time.Unix(now-29*day, 0))
+	require.NoError(t, err)
+	require.Equal(t, uint64(110), ts)
+	ts, err = TimestampAt(time.Unix(now-28*day, 0))
+	require.NoError(t, err)
+	require.Equal(t, uint64(120), ts)
+}
+
+func TestReadHistory(t *testing.T) {
+	writer := posting.NewTxnWriter(pstore)
+	set := func(key []byte, plist *pb.PostingList, meta byte, ts uint64) {
+		val, err := plist.Marshal()
+		require.NoError(t, err)
+		require.NoError(t, writer.SetAt(key, val, meta, ts))
+	}
+
+	// A rolled up list of uids, followed by changes.
+	friend := x.DataKey("history_friend", 1)
+	enc := codec.Encoder{BlockSize: 10}
+	enc.Add(5)
+	set(friend, &pb.PostingList{Pack: enc.Done()}, posting.BitCompletePosting, 10)
+	set(friend, &pb.PostingList{Postings: []*pb.Posting{{Uid: 6, Op: posting.Set}}},
+		posting.BitDeltaPosting, 12)
+	set(friend, &pb.PostingList{Postings: []*pb.Posting{{Uid: 5, Op: posting.Del}}},
+		posting.BitDeltaPosting, 14)
+
+	// A value, and all the values deleted.
+	name := x.DataKey("history_name", 1)
+	set(name, &pb.PostingList{Postings: []*pb.Posting{{
+		Uid:         math.MaxUint64,
+		Value:       []byte("Alice"),
+		ValType:     pb.Posting_STRING,
+		PostingType: pb.Posting_VALUE,
+		Op:          posting.Set,
+	}}}, posting.BitDeltaPosting, 12)
+	set(name, &pb.PostingList{Postings: []*pb.Posting{{
+		Uid:         math.MaxUint64,
+		Value:       []byte(x.Star),
+		PostingType: pb.Posting_VALUE,
+		Op:          posting.Del,
+	}}}, posting.BitDeltaPosting, 14)
+	require.NoError(t, writer.Flush())
+
+	read := func(key []byte, readTs, horizon uint64) []*pb.HistoryEntry {
+		txn := pstore.NewTransactionAt(readTs, false)
+		defer txn.Discard()
+		entries, err := readHistory(txn, key, horizon)
+		require.NoError(t, err)
+		return entries
+	}
+	require.Equal(t, []*pb.HistoryEntry{
+		{CommitTs: 10, Op: posting.Set, Uid: 5},
+		{CommitTs: 12, Op: posting.Set, Uid: 6},
+		{CommitTs: 14, Op: posting.Del, Uid: 5},
+	}, read(friend, 20, 0))
+	require.Equal(t, []*pb.HistoryEntry{
+		{CommitTs: 10, Op: posting.Set, Uid: 5},
+		{CommitTs: 12, Op: posting.Set, Uid: 6},
+	}, read(friend, 13, 0))
+	require.Equal(t, []*pb.HistoryEntry{
+		{CommitTs: 12, Op: posting.Set, Uid: 6},
+		{CommitTs: 14, Op: posting.Del, Uid: 5},
+	}, read(friend, 20, 11))
+
+	require.Equal(t, []*pb.HistoryEntry{
+		{CommitTs: 12, Op: posting.Set, Value: []byte("Alice"), ValType: pb.Posting_STRING},
+		{CommitTs: 14, Op: posting.Del},
+	}, read(name, 20, 0))
+	require.Empty(t, read(x.DataKey("history_name", 2), 20, 0))
+}
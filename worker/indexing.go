@@ -0,0 +1,225 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// The states of an index build.
+const (
+	IndexBuildRunning   = "running"
+	IndexBuildDone      = "done"
+	IndexBuildFailed    = "failed"
+	IndexBuildCancelled = "cancelled"
+)
+
+// IndexBuildStatus reports the progress of the build of the index entries of new tokenizers of
+// a predicate, on this server.
+type IndexBuildStatus struct {
+	Namespace  uint64     `json:"namespace"`
+	Predicate  string     `json:"predicate"`
+	Tokenizers []string   `json:"tokenizers"`
+	State      string     `json:"state"`
+	Error      string     `json:"error,omitempty"`
+	Indexed    uint64     `json:"indexed"`
+	Started    time.Time  `json:"started"`
+	Finished   *time.Time `json:"finished,omitempty"`
+
+	build  *posting.IndexBuild
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// indexBuilds holds the status of the last index build of each predicate, keyed by attribute.
+var indexBuilds = struct {
+	sync.Mutex
+	status map[string]*IndexBuildStatus
+}{status: make(map[string]*IndexBuildStatus)}
+
+// IndexBuilds returns the status of the index builds run on this server, sorted by predicate.
+func IndexBuilds() []IndexBuildStatus {
+	indexBuilds.Lock()
+	defer indexBuilds.Unlock()
+	out := make([]IndexBuildStatus, 0, len(indexBuilds.status))
+	for _, status := range indexBuilds.status {
+		s := *status
+		s.Indexed = atomic.LoadUint64(&s.build.Indexed)
+		out = append(out, s)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Namespace != out[j].Namespace {
+			return out[i].Namespace < out[j].Namespace
+		}
+		return out[i].Predicate < out[j].Predicate
+	})
+	return out
+}
+
+// startIndexBuild builds the index entries of the given tokenizers of the schema in the
+// background. The mutations use the schema right away, so that the entries for the values they
+// write are added by them. The queries use it once the build is done.
+func startIndexBuild(update *pb.SchemaUpdate, startTs uint64, tokenizers []string) error {
+	attr := update.Predicate
+	build := &posting.IndexBuild{Attr: attr, StartTs: startTs, Tokenizers: tokenizers}
+	target := *update
+	target.BuildingTokenizers = tokenizers
+	target.BuildingTs = startTs
+	schema.State().SetIndexing(attr, target)
+	if err := build.Prepare(); err != nil {
+		schema.State().DoneIndexing(attr)
+		return err
+	}
+	// The schema is stored along with the tokenizers being built, so that the build is resumed
+	// if the server restarts before it's done (see resumeIndexBuilds).
+	if err := writeSchema(&target); err != nil {
+		schema.State().DoneIndexing(attr)
+		return err
+	}
+	runIndexBuild(build, update)
+	return nil
+}
+
+// resumeIndexBuilds resumes the index builds of the schema loaded from disk which weren't done.
+// The entries written by a build are the same every time it runs, since it reads the values as
+// of the same timestamp, and the mutations committed since then added their own entries.
+func resumeIndexBuilds() {
+	for _, target := range schema.State().IndexingSchemas() {
+		indexBuilds.Lock()
+		status, ok := indexBuilds.status[target.Predicate]
+		running := ok && status.State == IndexBuildRunning
+		indexBuilds.Unlock()
+		if running || len(target.BuildingTokenizers) == 0 {
+			continue
+		}
+
+		glog.Infof("Resuming index build of %s for tokenizers %v", target.Predicate,
+			target.BuildingTokenizers)
+		build := &posting.IndexBuild{Attr: target.Predicate, StartTs: target.BuildingTs,
+			Tokenizers: target.BuildingTokenizers}
+		update := target
+		update.BuildingTokenizers = nil
+		update.BuildingTs = 0
+		runIndexBuild(build, &update)
+	}
+}
+
+// runIndexBuild runs the build in the background, and sets the schema once it's done.
+func runIndexBuild(build *posting.IndexBuild, update *pb.SchemaUpdate) {
+	attr := update.Predicate
+	ctx, cancel := context.WithCancel(context.Background())
+	ns, pred := x.ParseNamespaceAttr(attr)
+	status := &IndexBuildStatus{
+		Namespace:  ns,
+		Predicate:  pred,
+		Tokenizers: build.Tokenizers,
+		State:      IndexBuildRunning,
+		Started:    time.Now(),
+		build:      build,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+	}
+	indexBuilds.Lock()
+	indexBuilds.status[attr] = status
+	indexBuilds.Unlock()
+
+	current := *update
+	go status.run(ctx, &current)
+}
+
+func (status *IndexBuildStatus) run(ctx context.Context, update *pb.SchemaUpdate) {
+	defer close(status.done)
+	err := status.build.Run(ctx)
+
+	indexBuilds.Lock()
+	defer indexBuilds.Unlock()
+	switch {
+	case ctx.Err() != nil:
+		// The predicate is being dropped, the schema is left to the drop.
+		status.State = IndexBuildCancelled
+	case err != nil:
+		status.State = IndexBuildFailed
+		status.Error = err.Error()
+		// The schema is stored again without the tokenizers which failed to be built, so that
+		// the build isn't resumed on restart.
+		if old, ok := schema.State().Get(update.Predicate); ok {
+			if werr := writeSchema(&old); werr != nil {
+				glog.Errorf("While storing the schema of %s: %v", update.Predicate, werr)
+			}
+		}
+	default:
+		// The queries can use the index now.
+		if err = updateSchema(update); err != nil {
+			status.State = IndexBuildFailed
+			status.Error = err.Error()
+		} else {
+			status.State = IndexBuildDone
+		}
+	}
+	schema.State().DoneIndexing(update.Predicate)
+	now := time.Now()
+	status.Finished = &now
+	if status.State == IndexBuildFailed {
+		glog.Errorf("Index build of %s failed: %s", update.Predicate, status.Error)
+	} else {
+		glog.Infof("Index build of %s %s", update.Predicate, status.State)
+	}
+}
+
+// cancelIndexBuilds cancels the index builds of the predicates matching the function, and
+// waits for them to stop writing.
+func cancelIndexBuilds(match func(attr string) bool) {
+	var running []*IndexBuildStatus
+	indexBuilds.Lock()
+	for attr, status := range indexBuilds.status {
+		if status.State == IndexBuildRunning && match(attr) {
+			status.cancel()
+			running = append(running, status)
+		}
+	}
+	indexBuilds.Unlock()
+
+	for _, status := range running {
+		<-status.done
+	}
+}
+
+// indexBuildHorizon returns the timestamp the posting lists can be rolled up at without
+// hiding the entries being written by the index builds.
+func indexBuildHorizon() uint64 {
+	indexBuilds.Lock()
+	defer indexBuilds.Unlock()
+	horizon := uint64(math.MaxUint64)
+	for _, status := range indexBuilds.status {
+		if status.State == IndexBuildRunning {
+			horizon = x.Min(horizon, status.build.StartTs-1)
+		}
+	}
+	return horizon
+}
@@ -0,0 +1,566 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// The states of a migration.
+const (
+	MigrationStarted   = "started"
+	MigrationRunning   = "running"
+	MigrationDone      = "done"
+	MigrationFailed    = "failed"
+	MigrationCancelled = "cancelled"
+)
+
+// MigrationStatus reports the progress of a migration, as seen by this server. The servers
+// serving the migrated predicate run it, and count the nodes migrated so far. The server which
+// started it only knows whether the group serving the predicate accepted it.
+type MigrationStatus struct {
+	Id        uint64     `json:"id"`
+	Namespace uint64     `json:"namespace"`
+	From      string     `json:"from"`
+	To        string     `json:"to"`
+	ValueType string     `json:"type"`
+	State     string     `json:"state"`
+	Error     string     `json:"error,omitempty"`
+	Migrated  uint64     `json:"migrated"`
+	Started   time.Time  `json:"started"`
+	Finished  *time.Time `json:"finished,omitempty"`
+
+	record    *pb.MigrationRecord
+	migration *posting.Migration
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+var migrations = struct {
+	sync.Mutex
+	status map[uint64]*MigrationStatus
+}{status: make(map[uint64]*MigrationStatus)}
+
+func newMigrationStatus(m *pb.Migration, state string, started time.Time) *MigrationStatus {
+	ns, from := x.ParseNamespaceAttr(m.From)
+	return &MigrationStatus{
+		Id:        m.Id,
+		Namespace: ns,
+		From:      from,
+		To:        x.ParseAttr(m.Schema.Predicate),
+		ValueType: types.TypeID(m.Schema.ValueType).Name(),
+		State:     state,
+		Started:   started,
+	}
+}
+
+// finish records the outcome of the migration.
+func (status *MigrationStatus) finish(err error) {
+	migrations.Lock()
+	defer migrations.Unlock()
+	now := time.Now()
+	status.Finished = &now
+	status.State = MigrationDone
+	if err != nil {
+		status.State = MigrationFailed
+		status.Error = err.Error()
+	}
+}
+
+// Migrations returns the status of the migrations started by, or run on this server, the most
+// recent first.
+func Migrations() []MigrationStatus {
+	migrations.Lock()
+	defer migrations.Unlock()
+	out := make([]MigrationStatus, 0, len(migrations.status))
+	for _, status := range migrations.status {
+		s := *status
+		if s.migration != nil {
+			s.Migrated = atomic.LoadUint64(&s.migration.Migrated)
+		}
+		out = append(out, s)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Id > out[j].Id })
+	return out
+}
+
+// StartMigration asks the group serving the predicate m.From to move its values into the schema
+// m.Schema in the background, and returns the id of the migration. The predicate is renamed if
+// the schema is for another predicate. The values which can't be converted to the new type fail
+// the migration, leaving the predicates as they were.
+func StartMigration(ctx context.Context, m *pb.Migration) (uint64, error) {
+	if m.Schema == nil || len(m.From) == 0 {
+		return 0, errors.Errorf("Migration must have a predicate and a schema")
+	}
+	ts, err := Timestamps(ctx, &pb.Num{Val: 1})
+	if err != nil {
+		return 0, err
+	}
+	m.Id = ts.StartId
+	status := newMigrationStatus(m, MigrationRunning, time.Now())
+	migrations.Lock()
+	migrations.status[m.Id] = status
+	migrations.Unlock()
+	glog.Infof("Starting migration %d of %s to %+v", m.Id, m.From, m.Schema)
+
+	go func() {
+		err := runMigrationOverNetwork(context.Background(), m)
+		if err != nil {
+			glog.Errorf("Migration %d of %s failed: %v", m.Id, m.From, err)
+			status.finish(err)
+			return
+		}
+		migrations.Lock()
+		defer migrations.Unlock()
+		// The status is replaced by the one of the group if this server runs the migration.
+		if status.State == MigrationRunning {
+			status.State = MigrationStarted
+		}
+	}()
+	return m.Id, nil
+}
+
+func runMigrationOverNetwork(ctx context.Context, m *pb.Migration) error {
+	// The migration can only start once the pending transactions on the predicates are done.
+	var err error
+	for i := 0; i < 10; i++ {
+		if i > 0 {
+			time.Sleep(time.Second)
+		}
+		var ts *pb.AssignedIds
+		if ts, err = Timestamps(ctx, &pb.Num{Val: 1}); err != nil {
+			return err
+		}
+		_, err = MutateOverNetwork(ctx, &pb.Mutations{StartTs: ts.StartId, Migration: m})
+		if err == nil || !strings.Contains(err.Error(), errHasPendingTxns.Error()) {
+			break
+		}
+	}
+	return err
+}
+
+// renameTypeFields renames the fields of the types, and the predicates of their composite
+// indexes, from the migrated predicate to the new one.
+func renameTypeFields(ctx context.Context, m *pb.Migration) error {
+	var updates []*pb.TypeUpdate
+	for _, typeName := range schema.State().Types() {
+		typ, ok := schema.State().GetType(typeName)
+		if !ok {
+			continue
+		}
+		// The fields are shared with the schema state, copy them.
+		fields := make([]*pb.SchemaUpdate, len(typ.Fields))
+		renamed := false
+		for i, field := range typ.Fields {
+			fields[i] = field
+			if field.Predicate == m.From {
+				fields[i] = &pb.SchemaUpdate{Predicate: m.Schema.Predicate}
+				renamed = true
+			}
+		}
+		if !renamed {
+			continue
+		}
+		// So are the predicates of the composite indexes, whose tuples are left as they are.
+		indexes := make([]*pb.CompositeIndex, len(typ.Indexes))
+		for i, index := range typ.Indexes {
+			renamedIndex := *index
+			renamedIndex.Predicates = make([]string, len(index.Predicates))
+			for j, pred := range index.Predicates {
+				renamedIndex.Predicates[j] = pred
+				if pred == m.From {
+					renamedIndex.Predicates[j] = m.Schema.Predicate
+				}
+			}
+			indexes[i] = &renamedIndex
+		}
+		updates = append(updates, &pb.TypeUpdate{
+			TypeName: typ.TypeName,
+			Fields:   fields,
+			Indexes:  indexes,
+		})
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+	ts, err := Timestamps(ctx, &pb.Num{Val: 1})
+	if err != nil {
+		return err
+	}
+	_, err = MutateOverNetwork(ctx, &pb.Mutations{StartTs: ts.StartId, Types: updates})
+	return err
+}
+
+// checkMigration returns an error if the values of a predicate with the schema from can't be
+// migrated to the schema to.
+func checkMigration(from, to *pb.SchemaUpdate) error {
+	fromType, toType := types.TypeID(from.ValueType), types.TypeID(to.ValueType)
+	switch {
+	case fromType == types.PasswordID || toType == types.PasswordID:
+		if fromType != toType {
+			return errors.Errorf("Cannot migrate predicate %s from %s to %s",
+				from.Predicate, fromType.Name(), toType.Name())
+		}
+	case fromType.IsScalar() != toType.IsScalar():
+		return errors.Errorf("Cannot migrate predicate %s from scalar to uid or vice versa",
+			from.Predicate)
+	case from.List && !to.List:
+		return errors.Errorf("Cannot migrate list predicate %s to a non-list predicate",
+			from.Predicate)
+	case from.Lang && !to.Lang:
+		return errors.Errorf("Cannot migrate predicate %s with @lang to a predicate without it",
+			from.Predicate)
+	}
+	return nil
+}
+
+// applyMigration checks that the values of m.From can be migrated into the schema m.Schema, and
+// starts migrating them in the background. Like index builds, the migration isn't run by the
+// Raft apply loop. Its state is stored so that it's resumed after a restart, and the mutations
+// of the predicates are rejected until it's done.
+func applyMigration(m *pb.Migration, startTs uint64) error {
+	if _, err := readMigration(m.Id); err == nil {
+		// The proposal is applied again while replaying the Raft log, the migration has been
+		// started already.
+		return nil
+	} else if err != badger.ErrKeyNotFound {
+		return err
+	}
+
+	to := m.Schema
+	for _, attr := range []string{m.From, to.Predicate} {
+		if tablet, err := groups().Tablet(attr); err != nil {
+			return err
+		} else if tablet.GetGroupId() != groups().groupId() {
+			return errors.Errorf("Predicate %s isn't being served by group %d. Tablet: %+v",
+				attr, groups().groupId(), tablet)
+		}
+	}
+
+	for _, attr := range []string{m.From, to.Predicate} {
+		if schema.State().IsIndexing(attr) {
+			return errors.Errorf("The index of predicate %s is being built. Please retry later",
+				x.ParseAttr(attr))
+		}
+		if isMigrating(attr) {
+			return errors.Errorf("Predicate %s is being migrated. Please retry later",
+				x.ParseAttr(attr))
+		}
+	}
+
+	old, ok := schema.State().Get(m.From)
+	if !ok {
+		return errors.Errorf("Predicate %s has no schema", x.ParseAttr(m.From))
+	}
+	rename := m.From != to.Predicate
+	if preds := schema.State().PartialIndexesOn(m.From); len(preds) > 0 && rename {
+		return errors.Errorf("Cannot rename predicate %s, the @where condition of the index of %s",
+			x.ParseAttr(m.From), x.ParseAttr(preds[0]))
+	}
+	if rename && hasEdges(to.Predicate, math.MaxUint64) {
+		return errors.Errorf("Cannot rename predicate %s to %s, which already has data",
+			x.ParseAttr(m.From), x.ParseAttr(to.Predicate))
+	}
+	if err := checkMigration(&old, to); err != nil {
+		return err
+	}
+	if err := checkSchema(to); err != nil {
+		return err
+	}
+
+	record := &pb.MigrationRecord{
+		Migration: m,
+		StartTs:   startTs,
+		State:     MigrationRunning,
+		Started:   time.Now().UnixNano(),
+	}
+	if err := writeMigration(record); err != nil {
+		return err
+	}
+	runMigration(record, &old)
+	return nil
+}
+
+// resumeMigrations reports the migrations stored on disk, and resumes those which weren't done.
+// A migration writes the same values every time it runs, since it reads them as of the same
+// timestamp, and no mutation of its predicates has been applied since.
+func resumeMigrations() {
+	records, err := readMigrations()
+	if err != nil {
+		glog.Errorf("While reading the migrations: %v", err)
+		return
+	}
+	for _, record := range records {
+		m := record.Migration
+		migrations.Lock()
+		status, ok := migrations.status[m.Id]
+		running := ok && status.done != nil && status.State == MigrationRunning
+		migrations.Unlock()
+		if running {
+			continue
+		}
+		if record.State != MigrationRunning {
+			status = newMigrationStatus(m, record.State, time.Unix(0, record.Started))
+			status.Error = record.Error
+			finished := time.Unix(0, record.Finished)
+			status.Finished = &finished
+			status.record = record
+			migrations.Lock()
+			migrations.status[m.Id] = status
+			migrations.Unlock()
+			continue
+		}
+
+		glog.Infof("Resuming migration %d of %s", m.Id, m.From)
+		// The schema of the migrated predicate is the one stored before the migration, unless
+		// the migration was done writing it.
+		old, _ := schema.State().Get(m.From)
+		runMigration(record, &old)
+	}
+}
+
+// runMigration migrates the values in the background, and stores the outcome once it's done.
+func runMigration(record *pb.MigrationRecord, old *pb.SchemaUpdate) {
+	m := record.Migration
+	ctx, cancel := context.WithCancel(context.Background())
+	status := newMigrationStatus(m, MigrationRunning, time.Unix(0, record.Started))
+	status.record = record
+	status.migration = &posting.Migration{From: m.From, To: m.Schema.Predicate,
+		StartTs: record.StartTs}
+	status.cancel = cancel
+	status.done = make(chan struct{})
+	migrations.Lock()
+	migrations.status[m.Id] = status
+	migrations.Unlock()
+
+	// Sets only in memory, the values are migrated to the new schema first.
+	schema.State().Set(m.Schema.Predicate, *m.Schema)
+	go status.run(ctx, old)
+}
+
+func (status *MigrationStatus) run(ctx context.Context, old *pb.SchemaUpdate) {
+	defer close(status.done)
+	m := status.record.Migration
+	err := migrate(ctx, status.migration, old, m.Schema)
+
+	if err != nil {
+		if rerr := restoreSchema(m.Schema.Predicate); rerr != nil {
+			glog.Errorf("Unable to restore the schema of %s: %v", m.Schema.Predicate, rerr)
+		}
+	}
+	switch {
+	case ctx.Err() != nil:
+		// The predicates are being dropped.
+		migrations.Lock()
+		status.State = MigrationCancelled
+		now := time.Now()
+		status.Finished = &now
+		migrations.Unlock()
+	case err != nil:
+		glog.Errorf("Migration %d of %s failed: %v", m.Id, m.From, err)
+		status.finish(err)
+	default:
+		glog.Infof("Migration %d of %s done", m.Id, m.From)
+		status.finish(nil)
+	}
+
+	migrations.Lock()
+	record := *status.record
+	record.State = status.State
+	record.Error = status.Error
+	record.Finished = status.Finished.UnixNano()
+	migrations.Unlock()
+	if err := writeMigration(&record); err != nil {
+		glog.Errorf("While storing the state of migration %d: %v", m.Id, err)
+	}
+
+	if record.State != MigrationDone || m.From == m.Schema.Predicate ||
+		!groups().Node.AmLeader() {
+		return
+	}
+	// The fields of the types are renamed too.
+	if err := renameTypeFields(context.Background(), m); err != nil {
+		glog.Errorf("While renaming the fields of migration %d: %v", m.Id, err)
+	}
+}
+
+// restoreSchema restores the schema of the predicate in memory from the disk. The schema of a
+// predicate which had none before the migration is deleted.
+func restoreSchema(attr string) error {
+	txn := pstore.NewTransactionAt(1, false)
+	_, err := txn.Get(x.SchemaKey(attr))
+	txn.Discard()
+	switch {
+	case err == badger.ErrKeyNotFound:
+		return schema.State().Delete(attr)
+	case err != nil:
+		return err
+	}
+	return schema.Load(attr)
+}
+
+// isMigrating returns whether the values of the predicate are being migrated, or the predicate
+// is the target of a running migration.
+func isMigrating(attr string) bool {
+	migrations.Lock()
+	defer migrations.Unlock()
+	for _, status := range migrations.status {
+		if status.done == nil || status.State != MigrationRunning {
+			continue
+		}
+		if m := status.record.Migration; m.From == attr || m.Schema.Predicate == attr {
+			return true
+		}
+	}
+	return false
+}
+
+// cancelMigrations cancels the running migrations of the predicates matching the function, and
+// waits for them to stop writing.
+func cancelMigrations(match func(attr string) bool) {
+	var running []*MigrationStatus
+	migrations.Lock()
+	for _, status := range migrations.status {
+		if status.done == nil || status.State != MigrationRunning {
+			continue
+		}
+		if m := status.record.Migration; match(m.From) || match(m.Schema.Predicate) {
+			status.cancel()
+			running = append(running, status)
+		}
+	}
+	migrations.Unlock()
+
+	for _, status := range running {
+		<-status.done
+	}
+}
+
+// migrationHorizon returns the timestamp the posting lists can be rolled up at without hiding
+// the values being written by the migrations.
+func migrationHorizon() uint64 {
+	migrations.Lock()
+	defer migrations.Unlock()
+	horizon := uint64(math.MaxUint64)
+	for _, status := range migrations.status {
+		if status.done != nil && status.State == MigrationRunning {
+			horizon = x.Min(horizon, status.record.StartTs-1)
+		}
+	}
+	return horizon
+}
+
+func writeMigration(record *pb.MigrationRecord) error {
+	txn := pstore.NewTransactionAt(1, true)
+	defer txn.Discard()
+	data, err := record.Marshal()
+	if err != nil {
+		return err
+	}
+	err = txn.SetEntry(&badger.Entry{
+		Key:      x.MigrationKey(record.Migration.Id),
+		Value:    data,
+		UserMeta: posting.BitSchemaPosting,
+	})
+	if err != nil {
+		return err
+	}
+	return txn.CommitAt(1, nil)
+}
+
+func readMigration(id uint64) (*pb.MigrationRecord, error) {
+	txn := pstore.NewTransactionAt(1, false)
+	defer txn.Discard()
+	item, err := txn.Get(x.MigrationKey(id))
+	if err != nil {
+		return nil, err
+	}
+	record := &pb.MigrationRecord{}
+	err = item.Value(func(val []byte) error {
+		return record.Unmarshal(val)
+	})
+	return record, err
+}
+
+func readMigrations() ([]*pb.MigrationRecord, error) {
+	txn := pstore.NewTransactionAt(1, false)
+	defer txn.Discard()
+	prefix := x.MigrationPrefix()
+	iopt := badger.DefaultIteratorOptions
+	iopt.Prefix = prefix
+	itr := txn.NewIterator(iopt)
+	defer itr.Close()
+
+	var records []*pb.MigrationRecord
+	for itr.Seek(prefix); itr.ValidForPrefix(prefix); itr.Next() {
+		record := &pb.MigrationRecord{}
+		err := itr.Item().Value(func(val []byte) error {
+			return record.Unmarshal(val)
+		})
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func migrate(ctx context.Context, migration *posting.Migration, old,
+	to *pb.SchemaUpdate) error {
+	if err := migration.Run(ctx); err != nil {
+		return err
+	}
+
+	rebuild := posting.IndexRebuild{
+		Attr:          to.Predicate,
+		StartTs:       migration.StartTs,
+		CurrentSchema: to,
+	}
+	if migration.From == migration.To {
+		// The values have been written with the value ids of the new schema already, so the
+		// values of the predicate don't have to be made into a list again.
+		prev := *old
+		prev.List = to.List
+		rebuild.OldSchema = &prev
+	}
+	if err := rebuild.Run(ctx); err != nil {
+		return err
+	}
+	if err := updateSchema(to); err != nil {
+		return err
+	}
+	if migration.From == migration.To {
+		return nil
+	}
+	return posting.DeletePredicate(ctx, migration.From)
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright 2017-2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+func TestCheckMigration(t *testing.T) {
+	for _, test := range []struct {
+		from, to pb.SchemaUpdate
+		ok       bool
+	}{
+		{pb.SchemaUpdate{ValueType: pb.Posting_STRING}, pb.SchemaUpdate{ValueType: pb.Posting_INT}, true},
+		{pb.SchemaUpdate{ValueType: pb.Posting_INT}, pb.SchemaUpdate{ValueType: pb.Posting_STRING,
+			List: true}, true},
+		{pb.SchemaUpdate{ValueType: pb.Posting_UID}, pb.SchemaUpdate{ValueType: pb.Posting_UID,
+			List: true}, true},
+		{pb.SchemaUpdate{ValueType: pb.Posting_STRING},
+			pb.SchemaUpdate{ValueType: pb.Posting_UID}, false},
+		{pb.SchemaUpdate{ValueType: pb.Posting_STRING},
+			pb.SchemaUpdate{ValueType: pb.Posting_PASSWORD}, false},
+		{pb.SchemaUpdate{ValueType: pb.Posting_INT, List: true},
+			pb.SchemaUpdate{ValueType: pb.Posting_INT}, false},
+		{pb.SchemaUpdate{ValueType: pb.Posting_STRING, Lang: true},
+			pb.SchemaUpdate{ValueType: pb.Posting_STRING}, false},
+	} {
+		err := checkMigration(&test.from, &test.to)
+		if test.ok {
+			require.NoError(t, err, "%+v", test)
+		} else {
+			require.Error(t, err, "%+v", test)
+		}
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 
@@ -112,9 +113,211 @@ func runMutation(ctx context.Context, edge *pb.DirectedEdge, txn *posting.Txn) e
 	if err != nil {
 		return err
 	}
+	if su.Unique && edge.Op == pb.DirectedEdge_SET {
+		if err := checkUnique(edge, &su, txn); err != nil {
+			return err
+		}
+	}
 	return plist.AddMutationWithIndex(ctx, edge, txn)
 }
 
+// checkIndexCondition returns an error if the condition of the partial index of the predicate
+// can't be checked.
+func checkIndexCondition(s *pb.SchemaUpdate) error {
+	where := s.Where
+	switch {
+	case where == nil:
+		return nil
+	case s.Directive != pb.SchemaUpdate_INDEX:
+		return errors.Errorf("@where requires an index on predicate %s", x.ParseAttr(s.Predicate))
+	case s.Unique:
+		// The unique values are looked up in the index.
+		return errors.Errorf("Predicate %s with @unique cannot have a partial index",
+			x.ParseAttr(s.Predicate))
+	case where.Predicate == s.Predicate:
+		return errors.Errorf("The @where condition of predicate %s cannot be on itself",
+			x.ParseAttr(s.Predicate))
+	case where.FuncName == "has":
+		return nil
+	case where.FuncName != "eq":
+		return errors.Errorf("Invalid function %s in the @where condition of predicate %s",
+			where.FuncName, x.ParseAttr(s.Predicate))
+	}
+
+	typ, err := schema.State().TypeOf(where.Predicate)
+	if err != nil {
+		return errors.Errorf("Predicate %s of the @where condition of %s has no schema",
+			x.ParseAttr(where.Predicate), x.ParseAttr(s.Predicate))
+	}
+	if !typ.IsScalar() || typ == types.PasswordID {
+		return errors.Errorf("Predicate %s of the @where condition of %s must be a scalar",
+			x.ParseAttr(where.Predicate), x.ParseAttr(s.Predicate))
+	}
+	src := types.Val{Tid: types.StringID, Value: []byte(where.Value)}
+	if _, err := types.Convert(src, typ); err != nil {
+		return errors.Wrapf(err, "invalid value in the @where condition of predicate %s",
+			x.ParseAttr(s.Predicate))
+	}
+	return nil
+}
+
+// uniqueTokenizer returns the tokenizer of the index used to find the nodes with a value of the
+// predicate, or nil if it has neither an exact nor a hash index.
+func uniqueTokenizer(su *pb.SchemaUpdate) tok.Tokenizer {
+	for _, name := range su.Tokenizer {
+		if name == "exact" || name == "hash" {
+			tokenizer, _ := tok.GetTokenizer(name)
+			return tokenizer
+		}
+	}
+	return nil
+}
+
+// checkUnique returns an error if another node already has the value set by the edge, for a
+// predicate with the @unique directive. The transaction conflicts with the other ones using the
+// index keys of the value, so only one of the transactions concurrently setting the same value
+// on different nodes can commit, even if they run on different Alphas.
+func checkUnique(edge *pb.DirectedEdge, su *pb.SchemaUpdate, txn *posting.Txn) error {
+	tokenizer := uniqueTokenizer(su)
+	if tokenizer == nil {
+		return errors.Errorf("Predicate %s is unique but has no exact or hash index",
+			x.ParseAttr(edge.Attr))
+	}
+	val, err := types.Convert(types.Val{Tid: types.TypeID(edge.ValueType), Value: edge.Value},
+		types.TypeID(su.ValueType))
+	if err != nil {
+		return err
+	}
+	tokens, err := tok.BuildTokens(val.Value, tok.GetLangTokenizer(tokenizer, edge.Lang))
+	if err != nil {
+		return err
+	}
+
+	for _, token := range tokens {
+		key := x.IndexKey(edge.Attr, token)
+		pl, err := txn.Get(key)
+		if err != nil {
+			return err
+		}
+		uids, err := pl.Uids(posting.ListOptions{ReadTs: txn.StartTs})
+		if err != nil {
+			return err
+		}
+		for _, uid := range uids.Uids {
+			if uid == edge.Entity {
+				continue
+			}
+			// Different values can have the same hash.
+			data, err := txn.Get(x.DataKey(edge.Attr, uid))
+			if err != nil {
+				return err
+			}
+			if same, err := hasValue(data, txn.StartTs, val); err != nil {
+				return err
+			} else if same {
+				return errors.Errorf("Could not set %s of node %#x: node %#x already has the "+
+					"same value and the predicate is unique", x.ParseAttr(edge.Attr), edge.Entity,
+					uid)
+			}
+		}
+		txn.AddKeyConflict(key)
+	}
+	return nil
+}
+
+// hasValue returns whether one of the values of the posting list is equal to val.
+func hasValue(pl *posting.List, readTs uint64, val types.Val) (bool, error) {
+	var found bool
+	err := pl.Iterate(readTs, 0, func(p *pb.Posting) error {
+		stored, err := types.Convert(types.Val{Tid: types.TypeID(p.ValType), Value: p.Value},
+			val.Tid)
+		if err == nil && types.CompareVals("eq", stored, val) {
+			found = true
+			return posting.ErrStopIteration
+		}
+		return nil
+	})
+	return found, err
+}
+
+// checkDuplicates returns an error if two nodes have the same value of the predicate as of
+// readTs, so that @unique can't be added to a predicate already holding duplicates. The values
+// are read from the data keys, as the index might only be built along with the schema update.
+func checkDuplicates(su *pb.SchemaUpdate, readTs uint64) error {
+	tokenizer := uniqueTokenizer(su)
+	if tokenizer == nil {
+		return errors.Errorf("Predicate %s is unique but has no exact or hash index",
+			x.ParseAttr(su.Predicate))
+	}
+
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+	initKey := x.ParsedKey{Attr: su.Predicate}
+	prefix := initKey.DataPrefix()
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.PrefetchValues = false
+	itOpt.AllVersions = true
+	itOpt.Prefix = prefix
+	it := txn.NewIterator(itOpt)
+	defer it.Close()
+
+	type uniqueValue struct {
+		uid uint64
+		val types.Val
+	}
+	// The values are grouped by token, and only compared to the ones with the same token.
+	seen := make(map[string][]uniqueValue)
+	var prevKey []byte
+	for it.Seek(prefix); it.Valid(); {
+		item := it.Item()
+		if bytes.Equal(item.Key(), prevKey) {
+			it.Next()
+			continue
+		}
+		prevKey = append(prevKey[:0], item.Key()...)
+
+		// Parse the key upfront, otherwise ReadPostingList would advance the iterator.
+		pk, err := x.Parse(item.Key())
+		if err != nil {
+			return err
+		}
+		if pk.HasStartUid {
+			// Parts of split posting lists are read along with their main key.
+			continue
+		}
+		l, err := posting.ReadPostingList(item.KeyCopy(nil), it)
+		if err != nil {
+			return err
+		}
+		err = l.Iterate(readTs, 0, func(p *pb.Posting) error {
+			val, err := types.Convert(types.Val{Tid: types.TypeID(p.ValType), Value: p.Value},
+				types.TypeID(su.ValueType))
+			if err != nil {
+				return err
+			}
+			tokens, err := tok.BuildTokens(val.Value,
+				tok.GetLangTokenizer(tokenizer, string(p.LangTag)))
+			if err != nil {
+				return err
+			}
+			for _, token := range tokens {
+				for _, other := range seen[token] {
+					if other.uid != pk.Uid && types.CompareVals("eq", other.val, val) {
+						return errors.Errorf("Predicate %s can't be unique: nodes %#x and %#x "+
+							"have the same value", x.ParseAttr(su.Predicate), other.uid, pk.Uid)
+					}
+				}
+				seen[token] = append(seen[token], uniqueValue{uid: pk.Uid, val: val})
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // This is serialized with mutations, called after applied watermarks catch up
 // and further mutations are blocked until this is done.
 func runSchemaMutation(ctx context.Context, update *pb.SchemaUpdate, startTs uint64) error {
@@ -131,21 +334,60 @@ func runSchemaMutation(ctx context.Context, update *pb.SchemaUpdate, startTs uin
 		return err
 	}
 
+	if schema.State().IsIndexing(update.Predicate) {
+		// The schema is updated once the index has been built in the background.
+		return nil
+	}
 	return updateSchema(update)
 }
 
 func runSchemaMutationHelper(ctx context.Context, update *pb.SchemaUpdate, startTs uint64) error {
+	if schema.State().IsIndexing(update.Predicate) {
+		return errors.Errorf("The index of predicate %s is being built. Please retry later",
+			x.ParseAttr(update.Predicate))
+	}
+	if isMigrating(update.Predicate) {
+		return errors.Errorf("Predicate %s is being migrated. Please retry later",
+			x.ParseAttr(update.Predicate))
+	}
 	if tablet, err := groups().Tablet(update.Predicate); err != nil {
 		return err
 	} else if tablet.GetGroupId() != groups().groupId() {
 		return errors.Errorf("Tablet isn't being served by this group. Tablet: %+v", tablet)
 	}
+	// The condition of a partial index is checked while the mutations are applied, so its
+	// predicate must be served by the same group.
+	if where := update.Where; where != nil {
+		if tablet, err := groups().Tablet(where.Predicate); err != nil {
+			return err
+		} else if tablet.GetGroupId() != groups().groupId() {
+			return errors.Errorf("Predicate %s of the @where condition of %s must be served "+
+				"by the same group", x.ParseAttr(where.Predicate), x.ParseAttr(update.Predicate))
+		}
+	}
 
 	if err := checkSchema(update); err != nil {
 		return err
 	}
-	old, _ := schema.State().Get(update.Predicate)
+	old, ok := schema.State().Get(update.Predicate)
+	if update.Unique && !old.Unique {
+		if err := checkDuplicates(update, startTs); err != nil {
+			return err
+		}
+	}
 	current := *update
+	rebuild := posting.IndexRebuild{
+		Attr:          update.Predicate,
+		StartTs:       startTs,
+		OldSchema:     &old,
+		CurrentSchema: &current,
+	}
+	if tokenizers := rebuild.BackgroundTokenizers(); ok && len(tokenizers) > 0 &&
+		hasEdges(update.Predicate, startTs) {
+		// Only index entries have to be added, which is done without blocking the mutations.
+		return startIndexBuild(&current, startTs, tokenizers)
+	}
+
 	// Sets only in memory, we will update it on disk only after schema mutations
 	// are successful and  written to disk.
 	schema.State().Set(update.Predicate, current)
@@ -157,17 +399,11 @@ func runSchemaMutationHelper(ctx context.Context, update *pb.SchemaUpdate, start
 	// linearizable read requests. Only downside would be on system crash, stale edges
 	// might remain, which is ok.
 
-	// Indexing can't be done in background as it can cause race conditons with new
-	// index mutations (old set and new del)
+	// Other than adding tokenizers (see startIndexBuild), indexing can't be done in
+	// background as it can cause race conditons with new index mutations (old set and new del)
 	// We need watermark for index/reverse edge addition for linearizable reads.
 	// (both applied and synced watermarks).
 	defer glog.Infof("Done schema update %+v\n", update)
-	rebuild := posting.IndexRebuild{
-		Attr:          update.Predicate,
-		StartTs:       startTs,
-		OldSchema:     &old,
-		CurrentSchema: &current,
-	}
 	return rebuild.Run(ctx)
 }
 
@@ -175,6 +411,11 @@ func runSchemaMutationHelper(ctx context.Context, update *pb.SchemaUpdate, start
 // only during schema mutations or we see a new predicate.
 func updateSchema(s *pb.SchemaUpdate) error {
 	schema.State().Set(s.Predicate, *s)
+	return writeSchema(s)
+}
+
+// writeSchema stores the schema of the predicate on disk, without changing the schema in memory.
+func writeSchema(s *pb.SchemaUpdate) error {
 	txn := pstore.NewTransactionAt(1, true)
 	defer txn.Discard()
 	data, err := s.Marshal()
@@ -293,6 +534,16 @@ func checkSchema(s *pb.SchemaUpdate) error {
 			s.Predicate)
 	}
 
+	// Unique values are looked up in the exact or hash index.
+	if s.Unique && uniqueTokenizer(s) == nil {
+		return errors.Errorf("Index tokenizer exact or hash is mandatory for: [%s] when "+
+			"specifying @unique directive", s.Predicate)
+	}
+
+	if err := checkIndexCondition(s); err != nil {
+		return err
+	}
+
 	t, err := schema.State().TypeOf(s.Predicate)
 	if err != nil {
 		// No schema previously defined, so no need to do checks about schema conversions.
@@ -488,6 +739,21 @@ func populateMutationMap(src *pb.Mutations) (map[uint32]*pb.Mutations, error) {
 		mu.Schema = append(mu.Schema, schema)
 	}
 
+	// Migrations run on the group serving the migrated predicate.
+	if src.Migration != nil {
+		gid, err := groups().BelongsTo(src.Migration.From)
+		if err != nil {
+			return nil, err
+		}
+
+		mu := mm[gid]
+		if mu == nil {
+			mu = &pb.Mutations{GroupId: gid}
+			mm[gid] = mu
+		}
+		mu.Migration = src.Migration
+	}
+
 	if src.DropOp > 0 {
 		for _, gid := range groups().KnownGroups() {
 			mu := mm[gid]
@@ -530,6 +796,9 @@ func MutateOverNetwork(ctx context.Context, m *pb.Mutations) (*api.TxnContext, e
 	if err := verifyTypes(ctx, m); err != nil {
 		return tctx, err
 	}
+	if err := addCompositeIndexEdges(ctx, m); err != nil {
+		return tctx, err
+	}
 	mutationMap, err := populateMutationMap(m)
 	if err != nil {
 		return tctx, err
@@ -566,8 +835,10 @@ func MutateOverNetwork(ctx context.Context, m *pb.Mutations) (*api.TxnContext, e
 func verifyTypes(ctx context.Context, m *pb.Mutations) error {
 	// Create a set of all the predicates included in this schema request.
 	reqPredSet := make(map[string]struct{}, len(m.Schema))
+	reqPreds := make(map[string]*pb.SchemaUpdate, len(m.Schema))
 	for _, schemaUpdate := range m.Schema {
 		reqPredSet[schemaUpdate.Predicate] = struct{}{}
+		reqPreds[schemaUpdate.Predicate] = schemaUpdate
 	}
 
 	// Create a set of all the predicates already present in the schema.
@@ -599,8 +870,10 @@ func verifyTypes(ctx context.Context, m *pb.Mutations) error {
 		return errors.Wrapf(err, "cannot retrieve predicate information")
 	}
 	schemaSet := make(map[string]struct{})
+	schemaNodes := make(map[string]*pb.SchemaNode)
 	for _, schemaNode := range schemas {
 		schemaSet[schemaNode.Predicate] = struct{}{}
+		schemaNodes[schemaNode.Predicate] = schemaNode
 	}
 
 	for _, t := range m.Types {
@@ -620,6 +893,24 @@ func verifyTypes(ctx context.Context, m *pb.Mutations) error {
 					field.Predicate, t.TypeName)
 			}
 		}
+
+		// The predicates of the composite indexes must have a single scalar value.
+		for _, index := range t.Indexes {
+			for _, pred := range index.Predicates {
+				var typ types.TypeID
+				var list bool
+				if su, ok := reqPreds[pred]; ok {
+					typ, list = types.TypeID(su.ValueType), su.List
+				} else if node, ok := schemaNodes[pred]; ok {
+					typ, _ = types.TypeForName(node.Type)
+					list = node.List
+				}
+				if !typ.IsScalar() || typ == types.PasswordID || list {
+					return errors.Errorf("Predicate %s of index %s must be a scalar, "+
+						"non-list predicate", x.ParseAttr(pred), index.Name)
+				}
+			}
+		}
 	}
 
 	return nil
@@ -646,6 +937,22 @@ func typeSanityCheck(t *pb.TypeUpdate) error {
 		}
 	}
 
+	for _, index := range t.Indexes {
+		if len(index.Predicates) < 2 {
+			return errors.Errorf("Index %s must have at least two predicates", index.Name)
+		}
+		for _, pred := range index.Predicates {
+			found := false
+			for _, field := range t.Fields {
+				found = found || field.Predicate == pred
+			}
+			if !found {
+				return errors.Errorf("Predicate %s of index %s is not a field of type %s",
+					x.ParseAttr(pred), index.Name, x.ParseAttr(t.TypeName))
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -676,6 +983,53 @@ func CommitOverNetwork(ctx context.Context, tc *api.TxnContext) (uint64, error)
 	return tctx.CommitTs, nil
 }
 
+// TxnSubjects returns the nodes modified by the pending transaction started at startTs, across
+// all the groups. They're read from the groups, rather than from the transaction context which
+// travels through the client.
+func TxnSubjects(ctx context.Context, startTs uint64) ([]uint64, error) {
+	var uids []uint64
+	for _, gid := range groups().KnownGroups() {
+		if groups().ServesGroup(gid) {
+			list, err := txnSubjects(ctx, startTs)
+			if err != nil {
+				return nil, err
+			}
+			uids = append(uids, list.Uids...)
+			continue
+		}
+		reply, err := processWithBackupRequest(ctx, gid,
+			func(ctx context.Context, c pb.WorkerClient) (interface{}, error) {
+				return c.TxnSubjects(ctx, &api.TxnContext{StartTs: startTs})
+			})
+		if err != nil {
+			return nil, err
+		}
+		uids = append(uids, reply.(*pb.List).Uids...)
+	}
+	return uids, nil
+}
+
+// txnSubjects returns the nodes modified in this group by the pending transaction started at
+// startTs. It first waits for the mutations proposed to the group to be applied.
+func txnSubjects(ctx context.Context, startTs uint64) (*pb.List, error) {
+	if err := groups().Node.WaitLinearizableRead(ctx); err != nil {
+		return nil, err
+	}
+	txn := posting.Oracle().GetTxn(startTs)
+	if txn == nil {
+		return &pb.List{}, nil
+	}
+	return &pb.List{Uids: txn.Subjects()}, nil
+}
+
+// TxnSubjects returns the nodes modified in this group by a pending transaction.
+func (w *grpcWorker) TxnSubjects(ctx context.Context, tc *api.TxnContext) (*pb.List, error) {
+	if ctx.Err() != nil {
+		return &pb.List{}, ctx.Err()
+	}
+	return txnSubjects(ctx, tc.StartTs)
+}
+
 func (w *grpcWorker) proposeAndWait(ctx context.Context, txnCtx *api.TxnContext,
 	m *pb.Mutations) error {
 	if x.WorkerConfig.StrictMutations {
@@ -695,6 +1049,7 @@ func (w *grpcWorker) proposeAndWait(ctx context.Context, txnCtx *api.TxnContext,
 		return err
 	}
 
+	setExpiry(m.Edges, time.Now())
 	node := groups().Node
 	err := node.proposeAndWait(ctx, &pb.Proposal{Mutations: m})
 	fillTxnContext(txnCtx, m.StartTs)
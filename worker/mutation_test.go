@@ -17,9 +17,11 @@
 package worker
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
+	"github.com/dgraph-io/dgo/v2/protos/api"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dgraph-io/dgraph/posting"
@@ -215,6 +217,92 @@ func TestCheckSchema(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCheckSchemaUnique(t *testing.T) {
+	result, err := schema.Parse(`
+		email: string @index(term) @unique .
+		name: string @index(term, hash) @unique .
+	`)
+	require.NoError(t, err)
+	require.True(t, result.Preds[0].Unique)
+	err = checkSchema(result.Preds[0])
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Index tokenizer exact or hash is mandatory for: [email]")
+	require.NoError(t, checkSchema(result.Preds[1]))
+}
+
+func TestCheckUnique(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte("email: string @index(exact) @unique ."), 1))
+	set := func(uid uint64, email string, startTs uint64) (*posting.Txn, error) {
+		txn := posting.Oracle().RegisterStartTs(startTs)
+		edge := &pb.DirectedEdge{
+			Entity:    uid,
+			Attr:      "email",
+			Value:     []byte(email),
+			ValueType: pb.Posting_STRING,
+			Op:        pb.DirectedEdge_SET,
+		}
+		return txn, runMutation(context.Background(), edge, txn)
+	}
+
+	txn, err := set(1, "alice@dgraph.io", 1)
+	require.NoError(t, err)
+	txn.Update()
+	writer := posting.NewTxnWriter(pstore)
+	require.NoError(t, txn.CommitToDisk(writer, 2))
+	require.NoError(t, writer.Flush())
+
+	_, err = set(2, "alice@dgraph.io", 3)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "node 0x1 already has the same value")
+	_, err = set(1, "alice@dgraph.io", 4)
+	require.NoError(t, err)
+
+	// Concurrent transactions setting the same value conflict.
+	txn1, err := set(3, "bob@dgraph.io", 5)
+	require.NoError(t, err)
+	txn2, err := set(4, "bob@dgraph.io", 6)
+	require.NoError(t, err)
+	ctx1, ctx2 := &api.TxnContext{}, &api.TxnContext{}
+	txn1.FillContext(ctx1, 1)
+	txn2.FillContext(ctx2, 1)
+	var common []string
+	for _, key := range ctx1.Keys {
+		for _, other := range ctx2.Keys {
+			if key == other {
+				common = append(common, key)
+			}
+		}
+	}
+	require.NotEmpty(t, common)
+}
+
+func TestCheckDuplicates(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte("dup_email: string @index(hash) ."), 1))
+	writer := posting.NewTxnWriter(pstore)
+	for i, email := range []string{"alice@dgraph.io", "bob@dgraph.io", "alice@dgraph.io"} {
+		txn := posting.Oracle().RegisterStartTs(uint64(10 + 2*i))
+		edge := &pb.DirectedEdge{
+			Entity:    uint64(i + 1),
+			Attr:      "dup_email",
+			Value:     []byte(email),
+			ValueType: pb.Posting_STRING,
+			Op:        pb.DirectedEdge_SET,
+		}
+		require.NoError(t, runMutation(context.Background(), edge, txn))
+		txn.Update()
+		require.NoError(t, txn.CommitToDisk(writer, uint64(11+2*i)))
+	}
+	require.NoError(t, writer.Flush())
+
+	su, ok := schema.State().Get("dup_email")
+	require.True(t, ok)
+	su.Unique = true
+	require.NoError(t, checkDuplicates(&su, 13))
+	err := checkDuplicates(&su, 15)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "nodes 0x1 and 0x3 have the same value")
+}
+
 func TestTypeSanityCheck(t *testing.T) {
 	// Empty field name check.
 	typeDef := &pb.TypeUpdate{
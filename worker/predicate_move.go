@@ -202,6 +202,19 @@ func (w *grpcWorker) MovePredicate(ctx context.Context,
 	} else if gid != groups().groupId() {
 		return &emptyPayload, errUnservedTablet
 	}
+	if schema.State().IsIndexing(in.Predicate) {
+		return &emptyPayload, errors.Errorf("Index of predicate %s is being built",
+			in.Predicate)
+	}
+	if isMigrating(in.Predicate) {
+		return &emptyPayload, errors.Errorf("Predicate %s is being migrated", in.Predicate)
+	}
+	// A partial index is served along with the predicate of its condition.
+	if schema.State().IndexCondition(in.Predicate) != nil ||
+		len(schema.State().PartialIndexesOn(in.Predicate)) > 0 {
+		return &emptyPayload, errors.Errorf("Predicate %s is part of a partial index",
+			in.Predicate)
+	}
 
 	msg := fmt.Sprintf("Move predicate request: %+v", in)
 	glog.Info(msg)
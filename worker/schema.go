@@ -56,7 +56,7 @@ func getSchema(ctx context.Context, s *pb.SchemaRequest) (*pb.SchemaResult, erro
 		fields = s.Fields
 	} else {
 		fields = []string{"type", "index", "tokenizer", "reverse", "count", "list", "upsert",
-			"lang"}
+			"lang", "unique", "ttl", "where", "facet_index"}
 	}
 
 	for _, attr := range predicates {
@@ -105,6 +105,18 @@ func populateSchema(attr string, fields []string) *pb.SchemaNode {
 			schemaNode.Upsert = schema.State().HasUpsert(attr)
 		case "lang":
 			schemaNode.Lang = schema.State().HasLang(attr)
+		case "unique":
+			schemaNode.Unique = schema.State().IsUnique(attr)
+		case "where":
+			if where := schema.State().IndexCondition(attr); where != nil {
+				schemaNode.Where = schema.IndexConditionString(where)
+			}
+		case "ttl":
+			if ttl := schema.State().TTL(attr); ttl > 0 {
+				schemaNode.Ttl = ttl.String()
+			}
+		case "facet_index":
+			schemaNode.FacetIndex = schema.State().FacetIndexes(attr)
 		default:
 			//pass
 		}
@@ -200,6 +200,10 @@ func sortWithIndex(ctx context.Context, ts *pb.SortMessage) *sortresult {
 	if !schema.State().IsIndexed(order.Attr) {
 		return resultWithError(errors.Errorf("Attribute %s is not indexed.", order.Attr))
 	}
+	// A partial index misses the values of the nodes not matching its condition.
+	if schema.State().IndexCondition(order.Attr) != nil {
+		return resultWithError(errors.Errorf("Attribute %s has a partial index.", order.Attr))
+	}
 
 	tokenizers := schema.State().Tokenizer(order.Attr)
 	var tokenizer tok.Tokenizer
@@ -0,0 +1,243 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	farm "github.com/dgryski/go-farm"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const (
+	// statsMinSample is the number of index keys of every tokenizer whose posting lists are
+	// always read to estimate the number of uids per token.
+	statsMinSample = 64
+	// statsSampleRate is the rate (one in statsSampleRate) at which the posting lists of the
+	// remaining index keys are read.
+	statsSampleRate = 16
+	// statsInterval is the minimum time between two collections of the statistics of a
+	// predicate, as every collection scans all its data and index keys. Rollups happening in
+	// between send the statistics collected last.
+	statsInterval = 10 * time.Minute
+)
+
+type indexSample struct {
+	numKeys     uint64
+	sampledKeys uint64
+	sampledUids uint64
+}
+
+type predicateStats struct {
+	collected  time.Time
+	numUids    uint64
+	indexStats []*pb.IndexStats
+}
+
+// statsCache holds the statistics last collected for every predicate served by the group.
+var statsCache = struct {
+	sync.Mutex
+	preds map[string]*predicateStats
+}{preds: make(map[string]*predicateStats)}
+
+// collectStats fills the tablets with the number of uids having their predicate and with the
+// cardinality of their indexes as of readTs. The leader sends them to Zero along with the tablet
+// sizes, so every Alpha learns about them through the membership state. The statistics of a
+// predicate are collected again only after statsInterval.
+func collectStats(readTs uint64, tablets map[string]*pb.Tablet) error {
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	statsCache.Lock()
+	defer statsCache.Unlock()
+	for attr := range statsCache.preds {
+		if _, ok := tablets[attr]; !ok {
+			delete(statsCache.preds, attr)
+		}
+	}
+
+	for attr, tablet := range tablets {
+		if ps, ok := statsCache.preds[attr]; ok && time.Since(ps.collected) < statsInterval {
+			tablet.NumUids = ps.numUids
+			tablet.IndexStats = ps.indexStats
+			continue
+		}
+
+		pk := x.ParsedKey{Attr: attr}
+		numUids, err := sampleKeys(txn, pk.DataPrefix(), readTs, nil)
+		if err != nil {
+			return err
+		}
+		samples := make(map[byte]*indexSample)
+		if _, err := sampleKeys(txn, pk.IndexPrefix(), readTs, samples); err != nil {
+			return err
+		}
+
+		tablet.NumUids = numUids
+		tablet.IndexStats = tablet.IndexStats[:0]
+		for id, s := range samples {
+			tokenizer, ok := tok.GetTokenizerByID(id)
+			if !ok || s.sampledKeys == 0 {
+				continue
+			}
+			tablet.IndexStats = append(tablet.IndexStats, &pb.IndexStats{
+				Tokenizer: tokenizer.Name(),
+				NumKeys:   s.numKeys,
+				NumUids:   s.sampledUids * s.numKeys / s.sampledKeys,
+			})
+		}
+		sort.Slice(tablet.IndexStats, func(i, j int) bool {
+			return tablet.IndexStats[i].Tokenizer < tablet.IndexStats[j].Tokenizer
+		})
+		statsCache.preds[attr] = &predicateStats{
+			collected:  time.Now(),
+			numUids:    tablet.NumUids,
+			indexStats: tablet.IndexStats,
+		}
+	}
+	return nil
+}
+
+// sampleKeys returns the number of non-empty posting lists under the prefix. If samples isn't
+// nil, the keys are index keys and the number of uids of a sample of them is recorded for the
+// tokenizer of their token.
+func sampleKeys(txn *badger.Txn, prefix []byte, readTs uint64,
+	samples map[byte]*indexSample) (uint64, error) {
+
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.PrefetchValues = false
+	itOpt.AllVersions = true
+	itOpt.Prefix = prefix
+	it := txn.NewIterator(itOpt)
+	defer it.Close()
+
+	var count uint64
+	var prevKey []byte
+	for it.Seek(prefix); it.Valid(); {
+		item := it.Item()
+		if bytes.Equal(item.Key(), prevKey) {
+			it.Next()
+			continue
+		}
+		prevKey = append(prevKey[:0], item.Key()...)
+
+		// Parse the key upfront, otherwise ReadPostingList would advance the iterator.
+		pk, err := x.Parse(item.Key())
+		if err != nil {
+			return 0, err
+		}
+		if pk.HasStartUid || item.IsDeletedOrExpired() ||
+			item.UserMeta()&posting.BitEmptyPosting > 0 {
+			// Parts of split posting lists are counted along with their main key.
+			continue
+		}
+		count++
+		if samples == nil || len(pk.Term) == 0 {
+			continue
+		}
+
+		s, ok := samples[pk.Term[0]]
+		if !ok {
+			s = &indexSample{}
+			samples[pk.Term[0]] = s
+		}
+		s.numKeys++
+		if s.sampledKeys >= statsMinSample && farm.Fingerprint64(item.Key())%statsSampleRate != 0 {
+			continue
+		}
+		l, err := posting.ReadPostingList(item.KeyCopy(nil), it)
+		if err != nil {
+			return 0, err
+		}
+		s.sampledKeys++
+		s.sampledUids += uint64(l.Length(readTs, 0))
+	}
+	return count, nil
+}
+
+// EstimateUids returns the estimated number of uids matched by the function with the given
+// arguments on the predicate, based on the statistics reported by the group serving it. It
+// returns false if there are no statistics allowing an estimate.
+func EstimateUids(attr, fnName string, args []string) (uint64, bool) {
+	tablet := groups().knownTablet(attr)
+	if tablet == nil || tablet.NumUids == 0 {
+		return 0, false
+	}
+
+	fnName = strings.ToLower(fnName)
+	// numTokens returns the number of terms or words in the arguments. It's only an upper bound
+	// for full-text search, as stop words are not indexed.
+	numTokens := func() uint64 {
+		var n uint64
+		for _, arg := range args {
+			n += uint64(len(strings.Fields(arg)))
+		}
+		return n
+	}
+	switch fnName {
+	case "has":
+		return tablet.NumUids, true
+	case "le", "ge", "lt", "gt", "between":
+		// Without histograms, assume a range matches a third of the values.
+		return tablet.NumUids / 3, true
+	case "eq":
+		// Equality uses the most selective index, which has the most keys.
+		var best *pb.IndexStats
+		for _, is := range tablet.IndexStats {
+			if best == nil || is.NumKeys > best.NumKeys {
+				best = is
+			}
+		}
+		if best == nil {
+			return 0, false
+		}
+		return x.Min(uint64(len(args))*uidsPerToken(best), tablet.NumUids), true
+	case "anyofterms", "allofterms", "anyoftext", "alloftext":
+		name := "term"
+		if strings.HasSuffix(fnName, "text") {
+			name = "fulltext"
+		}
+		for _, is := range tablet.IndexStats {
+			if is.Tokenizer != name {
+				continue
+			}
+			if strings.HasPrefix(fnName, "all") {
+				// All the tokens must match, so the result is at most that of a single token.
+				return uidsPerToken(is), true
+			}
+			return x.Min(numTokens()*uidsPerToken(is), tablet.NumUids), true
+		}
+	}
+	return 0, false
+}
+
+func uidsPerToken(is *pb.IndexStats) uint64 {
+	if is.NumKeys == 0 {
+		return 0
+	}
+	// Round up, so tokens are never estimated to match nothing.
+	return (is.NumUids + is.NumKeys - 1) / is.NumKeys
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/codec"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestCollectStats(t *testing.T) {
+	const attr = "stats.city"
+	writer := posting.NewTxnWriter(pstore)
+	setList := func(key []byte, uids ...uint64) {
+		pl := &pb.PostingList{Pack: codec.Encode(uids, 256)}
+		data, err := pl.Marshal()
+		require.NoError(t, err)
+		require.NoError(t, writer.SetAt(key, data, posting.BitCompletePosting, 5))
+	}
+	// 10 uids spread over 2 exact tokens, and 3 term tokens matching 4 uids each.
+	for uid := uint64(1); uid <= 10; uid++ {
+		setList(x.DataKey(attr, uid), uid)
+	}
+	setList(x.IndexKey(attr, string([]byte{tok.IdentExact})+"paris"), 1, 2, 3, 4, 5, 6)
+	setList(x.IndexKey(attr, string([]byte{tok.IdentExact})+"rome"), 7, 8, 9, 10)
+	for i := 0; i < 3; i++ {
+		setList(x.IndexKey(attr, fmt.Sprintf("%c%d", tok.IdentTerm, i)), 1, 2, 3, uint64(i+4))
+	}
+	require.NoError(t, writer.Flush())
+
+	tablets := map[string]*pb.Tablet{attr: {GroupId: 1, Predicate: attr}}
+	require.NoError(t, collectStats(10, tablets))
+	require.Equal(t, &pb.Tablet{
+		GroupId:   1,
+		Predicate: attr,
+		NumUids:   10,
+		IndexStats: []*pb.IndexStats{
+			{Tokenizer: "exact", NumKeys: 2, NumUids: 10},
+			{Tokenizer: "term", NumKeys: 3, NumUids: 12},
+		},
+	}, tablets[attr])
+
+	// The statistics aren't collected again until statsInterval has passed.
+	tablets = map[string]*pb.Tablet{attr: {GroupId: 1, Predicate: attr}}
+	require.NoError(t, collectStats(4, tablets))
+	require.Equal(t, uint64(10), tablets[attr].NumUids)
+	require.Len(t, tablets[attr].IndexStats, 2)
+
+	// Nothing was written yet as of an earlier timestamp.
+	statsCache.Lock()
+	statsCache.preds[attr].collected = time.Now().Add(-statsInterval)
+	statsCache.Unlock()
+	tablets = map[string]*pb.Tablet{attr: {GroupId: 1, Predicate: attr}}
+	require.NoError(t, collectStats(4, tablets))
+	require.Zero(t, tablets[attr].NumUids)
+	require.Empty(t, tablets[attr].IndexStats)
+}
+
+func TestEstimateUids(t *testing.T) {
+	const attr = "stats.name"
+	gr.Lock()
+	gr.tablets[attr] = &pb.Tablet{
+		GroupId: 1,
+		NumUids: 900,
+		IndexStats: []*pb.IndexStats{
+			{Tokenizer: "exact", NumKeys: 300, NumUids: 900},
+			{Tokenizer: "term", NumKeys: 10, NumUids: 1000},
+		},
+	}
+	gr.Unlock()
+	defer func() {
+		gr.Lock()
+		delete(gr.tablets, attr)
+		gr.Unlock()
+	}()
+
+	tests := []struct {
+		fn       string
+		args     []string
+		estimate uint64
+		ok       bool
+	}{
+		{fn: "has", estimate: 900, ok: true},
+		{fn: "eq", args: []string{"alice", "bob"}, estimate: 6, ok: true},
+		{fn: "ge", args: []string{"m"}, estimate: 300, ok: true},
+		{fn: "anyofterms", args: []string{"alice bob"}, estimate: 200, ok: true},
+		{fn: "allofterms", args: []string{"alice bob"}, estimate: 100, ok: true},
+		{fn: "anyoftext", args: []string{"alice"}},
+		{fn: "regexp", args: []string{"/al/"}},
+	}
+	for _, tc := range tests {
+		estimate, ok := EstimateUids(attr, tc.fn, tc.args)
+		require.Equal(t, tc.ok, ok, tc.fn)
+		require.Equal(t, tc.estimate, estimate, tc.fn)
+	}
+
+	_, ok := EstimateUids("stats.unknown", "has", nil)
+	require.False(t, ok)
+}
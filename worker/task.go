@@ -218,6 +218,9 @@ const (
 	uidInFn
 	customIndexFn
 	matchFn
+	similarToFn
+	historyFn
+	facetIndexFn
 	standardFn = 100
 )
 
@@ -244,7 +247,8 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 	switch f {
 	case "le", "ge", "lt", "gt", "eq":
 		return compareAttrFn, f
-	case "min", "max", "sum", "avg":
+	case "min", "max", "sum", "avg", "median", "percentile", "stddev", "variance",
+		"countdistinct":
 		return aggregatorFn, f
 	case "checkpwd":
 		return passwordFn, f
@@ -260,6 +264,12 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 		return customIndexFn, f
 	case "match":
 		return matchFn, f
+	case "similar_to":
+		return similarToFn, f
+	case "history":
+		return historyFn, f
+	case "facet_eq", "facet_le", "facet_lt", "facet_ge", "facet_gt":
+		return facetIndexFn, f
 	default:
 		if types.IsGeoFunc(f) {
 			return geoFn, f
@@ -270,7 +280,7 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 
 func needsIndex(fnType FuncType) bool {
 	switch fnType {
-	case compareAttrFn, geoFn, fullTextSearchFn, standardFn, matchFn:
+	case compareAttrFn, geoFn, fullTextSearchFn, standardFn, matchFn, similarToFn:
 		return true
 	}
 	return false
@@ -284,6 +294,21 @@ func needsIntersect(fnName string) bool {
 	return strings.HasPrefix(fnName, "allof") || strings.HasSuffix(fnName, "allof")
 }
 
+// FuncUsesIndex returns whether a function is evaluated by looking up the index of its
+// predicate, and if so, whether the uids found for its tokens are intersected instead of
+// merged. It's used to explain how queries are executed.
+func FuncUsesIndex(fnName string, isCount bool) (usesIndex, intersect bool) {
+	fnType, fname := parseFuncType(&pb.SrcFunction{Name: fnName, IsCount: isCount})
+	if fnType == facetIndexFn {
+		// The facet index doesn't make the predicate indexed (see needsIndex).
+		return true, false
+	}
+	if !needsIndex(fnType) {
+		return false, false
+	}
+	return true, needsIntersect(fname)
+}
+
 type funcArgs struct {
 	q     *pb.Query
 	gid   uint32
@@ -301,7 +326,8 @@ func (srcFn *functionContext) needsValuePostings(typ types.TypeID) (bool, error)
 			return false, nil
 		}
 		return true, nil
-	case geoFn, regexFn, fullTextSearchFn, standardFn, hasFn, customIndexFn, matchFn:
+	case geoFn, regexFn, fullTextSearchFn, standardFn, hasFn, customIndexFn, matchFn,
+		similarToFn, facetIndexFn:
 		// All of these require an index, hence would require fetching uid postings.
 		return false, nil
 	case uidInFn, compareScalarFn:
@@ -625,7 +651,7 @@ func (qs *queryState) handleUidPostings(
 					key = x.DataKey(q.Attr, q.UidList.Uids[i])
 				}
 			case geoFn, regexFn, fullTextSearchFn, standardFn, customIndexFn, matchFn,
-				compareAttrFn:
+				compareAttrFn, facetIndexFn:
 				key = x.IndexKey(q.Attr, srcFn.tokens[i])
 			default:
 				return errors.Errorf("Unhandled function in handleUidPostings: %s", srcFn.fname)
@@ -825,6 +851,7 @@ func processTask(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result, erro
 	if err != nil {
 		return &pb.Result{}, err
 	}
+	out.GroupId = gid
 	return out, nil
 }
 
@@ -884,6 +911,14 @@ func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint
 	}
 
 	args := funcArgs{q, gid, srcFn, out}
+	if srcFn.fnType == historyFn {
+		span.Annotate(nil, "handleHistory")
+		if err := qs.handleHistory(ctx, args); err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+
 	needsValPostings, err := srcFn.needsValuePostings(typ)
 	if err != nil {
 		return nil, err
@@ -928,6 +963,13 @@ func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint
 		}
 	}
 
+	if srcFn.fnType == similarToFn {
+		span.Annotate(nil, "handleSimilarToFunction")
+		if err := qs.handleSimilarToFunction(ctx, funcArgs{q, gid, srcFn, out}); err != nil {
+			return nil, err
+		}
+	}
+
 	// We fetch the actual value for the uids, compare them to the value in the
 	// request and filter the uids only if the tokenizer IsLossy.
 	if srcFn.fnType == compareAttrFn && len(srcFn.tokens) > 0 {
@@ -1486,6 +1528,30 @@ func (qs *queryState) filterStringFunction(arg funcArgs) error {
 	return nil
 }
 
+// handleSimilarToFunction finds the k nodes with the vectors closest to the one of the function
+// using the hnsw index of the predicate. As a filter, only the nodes among the k closest ones are
+// kept.
+func (qs *queryState) handleSimilarToFunction(ctx context.Context, arg funcArgs) error {
+	span := otrace.FromContext(ctx)
+	stop := x.SpanTimer(span, "handleSimilarToFunction")
+	defer stop()
+
+	uids, err := posting.SearchVectorIndex(ctx, qs.cache, arg.q.Attr, arg.q.ReadTs,
+		arg.srcFn.vector, arg.srcFn.k)
+	if err != nil {
+		return err
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	result := &pb.List{Uids: uids}
+	if arg.q.UidList != nil && len(arg.q.UidList.Uids) > 0 {
+		filtered := &pb.List{}
+		algo.IntersectWith(result, arg.q.UidList, filtered)
+		result = filtered
+	}
+	arg.out.UidMatrix = append(arg.out.UidMatrix, result)
+	return nil
+}
+
 func matchRegex(value types.Val, regex *cregexp.Regexp) bool {
 	return len(value.Value.(string)) > 0 && regex.MatchString(value.Value.(string), true, true) > 0
 }
@@ -1506,6 +1572,9 @@ type functionContext struct {
 	isFuncAtRoot   bool
 	isStringFn     bool
 	atype          types.TypeID
+	// vector and k are the vector and the number of nodes to find of similar_to.
+	vector []float32
+	k      int
 }
 
 const (
@@ -1630,6 +1699,15 @@ func parseSrcFn(q *pb.Query) (*functionContext, error) {
 			return nil, err
 		}
 		fc.n = len(q.UidList.Uids)
+	case historyFn:
+		if err = ensureArgsCount(q.SrcFunc, 0); err != nil {
+			return nil, err
+		}
+		checkRoot(q, fc)
+		if fc.isFuncAtRoot {
+			return nil, errors.Errorf("history function not allowed at root")
+		}
+		fc.n = len(q.UidList.Uids)
 	case standardFn, fullTextSearchFn:
 		// srcfunc 0th val is func name and and [2:] are args.
 		// we tokenize the arguments of the query.
@@ -1667,6 +1745,41 @@ func parseSrcFn(q *pb.Query) (*functionContext, error) {
 		fc.threshold = int64(max)
 		fc.tokens = q.SrcFunc.Args
 		fc.n = len(fc.tokens)
+	case facetIndexFn:
+		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
+			return nil, err
+		}
+		key := q.SrcFunc.Args[0]
+		if q.Reverse || !schema.State().HasFacetIndex(attr, key) {
+			return nil, errors.Errorf("Facet %s of predicate %s is not indexed", key,
+				x.ParseAttr(attr))
+		}
+		if fc.tokens, err = getFacetTokens(q.ReadTs, attr, key,
+			strings.TrimPrefix(f, "facet_"), q.SrcFunc.Args[1]); err != nil {
+			return nil, err
+		}
+		fc.n = len(fc.tokens)
+	case similarToFn:
+		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
+			return nil, err
+		}
+		if !schema.State().HasTokenizer(tok.IdentHNSW, attr) {
+			return nil, errors.Errorf("Attribute %s is not indexed with type hnsw", attr)
+		}
+		if fc.k, err = strconv.Atoi(q.SrcFunc.Args[0]); err != nil || fc.k <= 0 {
+			return nil, errors.Errorf("Number of nodes of similar_to must be a positive int, "+
+				"got %v", q.SrcFunc.Args[0])
+		}
+		val, err := convertValue(attr, q.SrcFunc.Args[1])
+		if err != nil {
+			return nil, err
+		}
+		if val.Tid != types.VFloatID {
+			return nil, errors.Errorf("Attribute %s is not of type %s", attr,
+				types.VFloatID.Name())
+		}
+		fc.vector = val.Value.([]float32)
+		fc.n = 0
 	case customIndexFn:
 		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
 			return nil, err
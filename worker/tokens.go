@@ -24,6 +24,7 @@ import (
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/types/facets"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/pkg/errors"
 )
@@ -193,3 +194,48 @@ func getInequalityTokens(readTs uint64, attr, f string,
 	}
 	return out, ineqToken, nil
 }
+
+// getFacetTokens returns the index tokens of the values of the facet key of the predicate attr
+// which compare to the value given by f, one of eq, le, lt, ge and gt. Like when filtering by
+// the facets, the value is compared to the facet values of every type it can be converted to.
+func getFacetTokens(readTs uint64, attr, key, f, value string) ([]string, error) {
+	// As for the inequality tokens, the index keys of the ongoing transactions are not read.
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	src := types.Val{Tid: types.StringID, Value: []byte(value)}
+	var out []string
+	for _, typ := range facets.IndexTypes {
+		val, err := types.Convert(src, typ)
+		if err != nil {
+			continue
+		}
+		token, err := facets.IndexToken(key, val)
+		if err != nil {
+			return nil, err
+		}
+		if f == "eq" {
+			out = append(out, token)
+			continue
+		}
+
+		itOpt := badger.DefaultIteratorOptions
+		itOpt.PrefetchValues = false
+		itOpt.Reverse = f == "le" || f == "lt"
+		itOpt.Prefix = x.IndexKey(attr, facets.IndexTokenPrefix(key, typ))
+		itr := txn.NewIterator(itOpt)
+		for itr.Seek(x.IndexKey(attr, token)); itr.Valid(); itr.Next() {
+			k, err := x.Parse(itr.Item().Key())
+			if err != nil {
+				itr.Close()
+				return nil, err
+			}
+			if k.Term == token && (f == "lt" || f == "gt") {
+				continue
+			}
+			out = append(out, k.Term)
+		}
+		itr.Close()
+	}
+	return out, nil
+}
@@ -0,0 +1,189 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"bytes"
+	"sync/atomic"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// expiredEdgesPerTxn bounds the number of expired values deleted by a single
+// transaction. A sweep runs as many transactions as needed to delete all the expired values.
+const expiredEdgesPerTxn = 1000
+
+// sweepingExpired is set while the expired values are being deleted.
+var sweepingExpired int32
+
+// setExpiry stamps the values set for predicates with a ttl with the time at which they expire.
+// It's done before proposing the mutations, so all the replicas agree on it.
+func setExpiry(edges []*pb.DirectedEdge, now time.Time) {
+	for _, edge := range edges {
+		if edge.Op != pb.DirectedEdge_SET {
+			continue
+		}
+		if ttl := schema.State().TTL(edge.Attr); ttl > 0 {
+			edge.ExpireAt = now.Add(ttl).Unix()
+		}
+	}
+}
+
+// expiredEdges returns the edges deleting the postings which expired from the posting list of
+// the given data key.
+func expiredEdges(pk x.ParsedKey, expired []*pb.Posting) []*pb.DirectedEdge {
+	edges := make([]*pb.DirectedEdge, 0, len(expired))
+	for _, p := range expired {
+		edge := &pb.DirectedEdge{
+			Entity: pk.Uid,
+			Attr:   pk.Attr,
+			Lang:   string(p.LangTag),
+			Op:     pb.DirectedEdge_DEL,
+		}
+		if p.PostingType == pb.Posting_REF {
+			edge.ValueId = p.Uid
+			edge.ValueType = pb.Posting_UID
+		} else {
+			edge.Value = p.Value
+			edge.ValueType = p.ValType
+		}
+		edges = append(edges, edge)
+	}
+	return edges
+}
+
+// deleteExpired deletes the expired values of the predicates with a ttl served by this group. It's
+// run every minute by the leader of the group. Deleting them with transactions, like any other
+// mutation, also deletes their index, reverse and count entries. Until then, the expired values
+// are still read, so that the reads at a given timestamp always see the same values.
+func (n *node) deleteExpired() {
+	if !atomic.CompareAndSwapInt32(&sweepingExpired, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&sweepingExpired, 0)
+
+	for _, attr := range schema.State().Predicates() {
+		if schema.State().TTL(attr) == 0 {
+			continue
+		}
+		if serves, err := groups().ServesTabletReadOnly(attr); err != nil || !serves {
+			continue
+		}
+		if err := n.deleteExpiredValues(attr); err != nil {
+			glog.Errorf("While deleting the expired values of %s: %v", attr, err)
+		}
+	}
+}
+
+// deleteExpiredValues deletes the expired values of the predicate in batches of
+// expiredEdgesPerTxn values, until they're all deleted or the sweep times out.
+func (n *node) deleteExpiredValues(attr string) error {
+	ctx, cancel := context.WithTimeout(n.ctx, 5*time.Minute)
+	defer cancel()
+
+	pk := x.ParsedKey{Attr: attr}
+	seek := pk.DataPrefix()
+	for seek != nil {
+		var err error
+		if seek, err = deleteExpiredBatch(ctx, attr, seek); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteExpiredBatch deletes the expired values of the data keys of the predicate from seek on,
+// up to expiredEdgesPerTxn values. It returns the key to continue from, or nil if all the keys
+// have been read.
+func deleteExpiredBatch(ctx context.Context, attr string, seek []byte) ([]byte, error) {
+	ts, err := Timestamps(ctx, &pb.Num{Val: 1})
+	if err != nil {
+		return nil, err
+	}
+	startTs := ts.StartId
+	// Wait for the commits before startTs to be applied, so they're read from disk.
+	if err := posting.Oracle().WaitForTs(ctx, startTs); err != nil {
+		return nil, err
+	}
+
+	txn := pstore.NewTransactionAt(startTs, false)
+	defer txn.Discard()
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.AllVersions = true
+	itOpt.Prefix = x.ParsedKey{Attr: attr}.DataPrefix()
+	it := txn.NewIterator(itOpt)
+	defer it.Close()
+
+	now := time.Now()
+	var edges []*pb.DirectedEdge
+	var prevKey, next []byte
+	for it.Seek(seek); it.Valid(); {
+		item := it.Item()
+		if bytes.Equal(item.Key(), prevKey) {
+			it.Next()
+			continue
+		}
+		if len(edges) >= expiredEdgesPerTxn {
+			// The remaining expired values are deleted by the next batch.
+			next = item.KeyCopy(nil)
+			break
+		}
+		prevKey = append(prevKey[:0], item.Key()...)
+
+		pk, err := x.Parse(item.Key())
+		if err != nil {
+			return nil, err
+		}
+		if pk.HasStartUid {
+			// Parts of split posting lists are read along with their main key.
+			it.Next()
+			continue
+		}
+		l, err := posting.ReadPostingList(item.KeyCopy(nil), it)
+		if err != nil {
+			return nil, err
+		}
+		expired, err := l.ExpiredPostings(startTs, now)
+		if err != nil {
+			return nil, err
+		}
+		edges = append(edges, expiredEdges(pk, expired)...)
+	}
+	if len(edges) == 0 {
+		return next, nil
+	}
+
+	tctx, err := MutateOverNetwork(ctx, &pb.Mutations{StartTs: startTs, Edges: edges})
+	if err != nil {
+		tctx.Aborted = true
+		_, _ = CommitOverNetwork(ctx, tctx)
+		return nil, err
+	}
+	if _, err := CommitOverNetwork(ctx, tctx); err != nil {
+		return nil, err
+	}
+	glog.Infof("Deleted %d expired values of %s", len(edges), attr)
+	return next, nil
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestSetExpiry(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		session: string @ttl(1h) .
+		name: string .
+	`), 1))
+
+	now := time.Now()
+	edges := []*pb.DirectedEdge{
+		{Attr: "session", Entity: 1, Value: []byte("a"), Op: pb.DirectedEdge_SET},
+		{Attr: "name", Entity: 1, Value: []byte("b"), Op: pb.DirectedEdge_SET},
+		{Attr: "session", Entity: 2, Value: []byte("c"), Op: pb.DirectedEdge_DEL},
+	}
+	setExpiry(edges, now)
+	require.Equal(t, now.Add(time.Hour).Unix(), edges[0].ExpireAt)
+	require.Zero(t, edges[1].ExpireAt)
+	require.Zero(t, edges[2].ExpireAt)
+}
+
+func TestExpiredEdges(t *testing.T) {
+	pk := x.ParsedKey{Attr: "session", Uid: 7}
+	edges := expiredEdges(pk, []*pb.Posting{
+		{Uid: 9, PostingType: pb.Posting_REF},
+		{
+			Uid:         1,
+			Value:       []byte("hallo"),
+			ValType:     pb.Posting_STRING,
+			PostingType: pb.Posting_VALUE_LANG,
+			LangTag:     []byte("de"),
+		},
+	})
+	require.Equal(t, []*pb.DirectedEdge{
+		{
+			Entity:    7,
+			Attr:      "session",
+			ValueId:   9,
+			ValueType: pb.Posting_UID,
+			Op:        pb.DirectedEdge_DEL,
+		},
+		{
+			Entity:    7,
+			Attr:      "session",
+			Value:     []byte("hallo"),
+			ValueType: pb.Posting_STRING,
+			Lang:      "de",
+			Op:        pb.DirectedEdge_DEL,
+		},
+	}, edges)
+}
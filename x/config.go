@@ -74,6 +74,15 @@ type WorkerOptions struct {
 	// ProposedGroupId will be used if there's a file in the p directory called group_id with the
 	// proposed group ID for this server.
 	ProposedGroupId uint32
+	// CDC tells Dgraph to record the changes made by committed transactions, so they can be
+	// streamed to change data capture clients.
+	CDC bool
+	// CDCRetention is how long the entries of the change log are kept around. 0 keeps them
+	// forever.
+	CDCRetention time.Duration
+	// HistoryRetention is how long the older versions of the data are kept around, so they
+	// can be read by queries run as of an earlier time.
+	HistoryRetention time.Duration
 }
 
 // WorkerConfig stores the global instance of the worker package's options.
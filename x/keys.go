@@ -45,6 +45,9 @@ const (
 	DefaultPrefix = byte(0x00)
 	byteSchema    = byte(0x01)
 	byteType      = byte(0x02)
+	byteCDC       = byte(0x03)
+	byteTime      = byte(0x04)
+	byteMigration = byte(0x05)
 	// ByteSplit is a constant to specify a given key corresponds to a posting list split
 	// into multiple parts.
 	ByteSplit = byte(0x01)
@@ -65,6 +68,8 @@ func writeAttr(buf []byte, attr string) []byte {
 // genKey creates the key and writes the initial bytes (type byte, length of attribute,
 // and the attribute itself). It leaves the rest of the key empty for further processing
 // if necessary.
+// The attribute includes the namespace it belongs to (see NamespaceAttr), so the keys of
+// a predicate or type are different in every namespace.
 func generateKey(typeByte byte, attr string, totalLen int) []byte {
 	AssertTrue(totalLen >= 1+2+len(attr))
 
@@ -98,6 +103,46 @@ func TypeKey(attr string) []byte {
 	return generateKey(byteType, attr, 1+2+len(attr))
 }
 
+// CDCKey returns the key storing the changes made by the transaction committed at
+// commitTs, as recorded for change data capture. CDC keys have no attribute and are
+// ordered by their commit timestamp.
+// The structure of a CDC key is as follows:
+//
+// byte 0: key type prefix (set to byteCDC)
+// byte 1-2: length of attr (always zero)
+// next eight bytes: value of commitTs
+func CDCKey(commitTs uint64) []byte {
+	buf := generateKey(byteCDC, "", 1+2+8)
+	binary.BigEndian.PutUint64(buf[3:], commitTs)
+	return buf
+}
+
+// TimeKey returns the key mapping the given Unix time, in seconds, to the max assigned
+// timestamp known at that time. Time keys have no attribute and are ordered by time.
+// The structure of a time key is as follows:
+//
+// byte 0: key type prefix (set to byteTime)
+// byte 1-2: length of attr (always zero)
+// next eight bytes: value of unixTime
+func TimeKey(unixTime int64) []byte {
+	buf := generateKey(byteTime, "", 1+2+8)
+	binary.BigEndian.PutUint64(buf[3:], uint64(unixTime))
+	return buf
+}
+
+// MigrationKey returns the key storing the state of the migration with the given id. Migration
+// keys have no attribute and are ordered by the id of the migration.
+// The structure of a migration key is as follows:
+//
+// byte 0: key type prefix (set to byteMigration)
+// byte 1-2: length of attr (always zero)
+// next eight bytes: value of id
+func MigrationKey(id uint64) []byte {
+	buf := generateKey(byteMigration, "", 1+2+8)
+	binary.BigEndian.PutUint64(buf[3:], id)
+	return buf
+}
+
 // DataKey generates a data key with the given attribute and UID.
 // The structure of a data key is as follows:
 //
@@ -269,6 +314,21 @@ func (p ParsedKey) IsType() bool {
 	return p.bytePrefix == byteType
 }
 
+// IsCDC returns whether the key is a change data capture key.
+func (p ParsedKey) IsCDC() bool {
+	return p.bytePrefix == byteCDC
+}
+
+// IsTime returns whether the key is a time key.
+func (p ParsedKey) IsTime() bool {
+	return p.bytePrefix == byteTime
+}
+
+// IsMigration returns whether the key is a migration key.
+func (p ParsedKey) IsMigration() bool {
+	return p.bytePrefix == byteMigration
+}
+
 // IsOfType checks whether the key is of the given type.
 func (p ParsedKey) IsOfType(typ byte) bool {
 	switch typ {
@@ -437,6 +497,27 @@ func TypePrefix() []byte {
 	return buf[:]
 }
 
+// CDCPrefix returns the prefix for change data capture keys.
+func CDCPrefix() []byte {
+	var buf [1]byte
+	buf[0] = byteCDC
+	return buf[:]
+}
+
+// TimePrefix returns the prefix for time keys.
+func TimePrefix() []byte {
+	var buf [1]byte
+	buf[0] = byteTime
+	return buf[:]
+}
+
+// MigrationPrefix returns the prefix for migration keys.
+func MigrationPrefix() []byte {
+	var buf [1]byte
+	buf[0] = byteMigration
+	return buf[:]
+}
+
 // PredicatePrefix returns the prefix for all keys belonging to this predicate except schema key.
 func PredicatePrefix(predicate string) []byte {
 	buf := make([]byte, 1+2+len(predicate))
@@ -483,7 +564,7 @@ func Parse(key []byte) (ParsedKey, error) {
 	k = k[sz:]
 
 	switch p.bytePrefix {
-	case byteSchema, byteType:
+	case byteSchema, byteType, byteCDC, byteTime, byteMigration:
 		return p, nil
 	default:
 	}
@@ -566,27 +647,34 @@ var internalPredicateMap = map[string]struct{}{
 	"uid": {},
 }
 
-// IsReservedPredicate returns true if the predicate is in the reserved predicate list.
+// IsReservedPredicate returns true if the predicate is in the reserved predicate list, in
+// any namespace.
 func IsReservedPredicate(pred string) bool {
-	_, ok := reservedPredicateMap[strings.ToLower(pred)]
+	_, ok := reservedPredicateMap[strings.ToLower(ParseAttr(pred))]
 	return ok || IsAclPredicate(pred)
 }
 
 // IsAclPredicate returns true if the predicate is in the list of reserved
-// predicates for the ACL feature.
+// predicates for the ACL feature, in any namespace.
 func IsAclPredicate(pred string) bool {
-	_, ok := aclPredicateMap[strings.ToLower(pred)]
+	_, ok := aclPredicateMap[strings.ToLower(ParseAttr(pred))]
 	return ok
 }
 
 // ReservedPredicates returns the complete list of reserved predicates.
 func ReservedPredicates() []string {
+	return NamespaceReservedPredicates(GalaxyNamespace)
+}
+
+// NamespaceReservedPredicates returns the complete list of reserved predicates of the
+// namespace ns.
+func NamespaceReservedPredicates(ns uint64) []string {
 	var preds []string
 	for pred := range reservedPredicateMap {
-		preds = append(preds, pred)
+		preds = append(preds, NamespaceAttr(ns, pred))
 	}
 	for pred := range aclPredicateMap {
-		preds = append(preds, pred)
+		preds = append(preds, NamespaceAttr(ns, pred))
 	}
 	return preds
 }
@@ -17,6 +17,7 @@
 package x
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 	"sort"
@@ -194,3 +195,79 @@ func TestSchemaKey(t *testing.T) {
 		require.Equal(t, sattr, pk.Attr)
 	}
 }
+
+func TestCDCKey(t *testing.T) {
+	var prev []byte
+	for _, ts := range []uint64{1, 2, 255, 256, 1 << 40} {
+		key := CDCKey(ts)
+		pk, err := Parse(key)
+		require.NoError(t, err)
+
+		require.True(t, pk.IsCDC())
+		require.False(t, pk.IsData())
+		require.Equal(t, "", pk.Attr)
+		require.True(t, bytes.HasPrefix(key, CDCPrefix()))
+
+		// Keys must sort in the order of their commit timestamps.
+		require.True(t, bytes.Compare(prev, key) < 0)
+		prev = key
+	}
+}
+
+func TestTimeKey(t *testing.T) {
+	var prev []byte
+	for _, unixTime := range []int64{1, 59, 1573000000, 1573000001} {
+		key := TimeKey(unixTime)
+		pk, err := Parse(key)
+		require.NoError(t, err)
+
+		require.True(t, pk.IsTime())
+		require.False(t, pk.IsCDC())
+		require.True(t, bytes.HasPrefix(key, TimePrefix()))
+
+		require.True(t, bytes.Compare(prev, key) < 0)
+		prev = key
+	}
+}
+
+func TestMigrationKey(t *testing.T) {
+	for _, id := range []uint64{1, 256, 1 << 40} {
+		key := MigrationKey(id)
+		pk, err := Parse(key)
+		require.NoError(t, err)
+
+		require.True(t, pk.IsMigration())
+		require.False(t, pk.IsCDC())
+		require.Equal(t, "", pk.Attr)
+		require.True(t, bytes.HasPrefix(key, MigrationPrefix()))
+		require.False(t, bytes.HasPrefix(SchemaKey("name"), MigrationPrefix()))
+	}
+}
+
+func TestNamespaceAttr(t *testing.T) {
+	require.Equal(t, "name", NamespaceAttr(GalaxyNamespace, "name"))
+
+	attr := NamespaceAttr(5, "name")
+	require.NotEqual(t, "name", attr)
+	require.True(t, HasNamespaceMarker(attr))
+	ns, name := ParseNamespaceAttr(attr)
+	require.Equal(t, uint64(5), ns)
+	require.Equal(t, "name", name)
+	require.Equal(t, "name", ParseAttr(attr))
+	require.Equal(t, GalaxyNamespace, ParseNamespace("name"))
+	require.Equal(t, "~"+attr, NamespaceAttr(5, "~name"))
+	ns, name = ParseNamespaceAttr("~" + attr)
+	require.Equal(t, uint64(5), ns)
+	require.Equal(t, "~name", name)
+
+	// The keys of a predicate are different in every namespace.
+	require.NotEqual(t, DataKey("name", 1), DataKey(attr, 1))
+	pk, err := Parse(IndexKey(attr, "term"))
+	require.NoError(t, err)
+	require.Equal(t, attr, pk.Attr)
+	require.Equal(t, uint64(5), ParseNamespace(pk.Attr))
+
+	require.True(t, IsReservedPredicate(NamespaceAttr(5, "dgraph.type")))
+	require.True(t, IsAclPredicate(NamespaceAttr(5, "dgraph.xid")))
+	require.False(t, IsAclPredicate(attr))
+}
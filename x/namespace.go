@@ -0,0 +1,99 @@
+/*
+ * Copyright 2019 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package x
+
+import (
+	"context"
+	"encoding/binary"
+	"strings"
+)
+
+// GalaxyNamespace is the namespace of the predicates, types and users which haven't been
+// created within another namespace. Its attributes carry no namespace, so the data written
+// before namespaces existed belongs to it.
+const GalaxyNamespace = uint64(0)
+
+// The attributes of the other namespaces start with nsMarker followed by the namespace, as a
+// big endian uint64. Predicate and type names can't start with nsMarker, so the keys generated
+// for the attributes of a namespace (see DataKey, IndexKey, SchemaKey, TypeKey) never collide
+// with the keys of another one.
+const (
+	nsMarker    = byte(0x00)
+	nsPrefixLen = 1 + 8
+)
+
+// NamespaceAttr returns the attribute used to store the predicate or type attr of the
+// namespace ns. The ~ of a reverse predicate stays in front of the attribute.
+func NamespaceAttr(ns uint64, attr string) string {
+	if ns == GalaxyNamespace {
+		return attr
+	}
+	if strings.HasPrefix(attr, "~") {
+		return "~" + NamespaceAttr(ns, attr[1:])
+	}
+	buf := make([]byte, nsPrefixLen+len(attr))
+	buf[0] = nsMarker
+	binary.BigEndian.PutUint64(buf[1:nsPrefixLen], ns)
+	copy(buf[nsPrefixLen:], attr)
+	return string(buf)
+}
+
+// ParseNamespaceAttr returns the namespace an attribute belongs to along with the name of the
+// predicate or type it stores.
+func ParseNamespaceAttr(attr string) (uint64, string) {
+	if strings.HasPrefix(attr, "~") {
+		ns, name := ParseNamespaceAttr(attr[1:])
+		return ns, "~" + name
+	}
+	if len(attr) < nsPrefixLen || attr[0] != nsMarker {
+		return GalaxyNamespace, attr
+	}
+	return binary.BigEndian.Uint64([]byte(attr[1:nsPrefixLen])), attr[nsPrefixLen:]
+}
+
+// ParseNamespace returns the namespace the attribute belongs to.
+func ParseNamespace(attr string) uint64 {
+	ns, _ := ParseNamespaceAttr(attr)
+	return ns
+}
+
+// ParseAttr returns the name of the predicate or type stored by the attribute, without its
+// namespace.
+func ParseAttr(attr string) string {
+	_, name := ParseNamespaceAttr(attr)
+	return name
+}
+
+// HasNamespaceMarker returns true if the name starts like the attributes of a namespace, which
+// makes it unusable as the name of a predicate or a type.
+func HasNamespaceMarker(name string) bool {
+	return len(name) > 0 && name[0] == nsMarker
+}
+
+type namespaceKey struct{}
+
+// AttachNamespace returns a context for the requests made within the namespace ns.
+func AttachNamespace(ctx context.Context, ns uint64) context.Context {
+	return context.WithValue(ctx, namespaceKey{}, ns)
+}
+
+// ExtractNamespace returns the namespace attached to the context, the galaxy namespace if
+// there's none.
+func ExtractNamespace(ctx context.Context) uint64 {
+	ns, _ := ctx.Value(namespaceKey{}).(uint64)
+	return ns
+}